@@ -13,11 +13,14 @@ import (
 	"github.com/noble-assets/forwarding/x/forwarding"
 	forwardingkeeper "github.com/noble-assets/forwarding/x/forwarding/keeper"
 	feeante "github.com/noble-assets/noble/v5/x/globalfee/ante"
+	"github.com/noble-assets/noble/v5/x/tokenfactory"
+	tokenfactorykeeper "github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
 )
 
 type HandlerOptions struct {
 	ante.HandlerOptions
 	cdc                    codec.Codec
+	tokenFactoryKeeper     *tokenfactorykeeper.Keeper
 	fiatTokenFactoryKeeper *fiattokenfactorykeeper.Keeper
 	IBCKeeper              *ibckeeper.Keeper
 	GlobalFeeSubspace      paramtypes.Subspace
@@ -53,6 +56,10 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewRejectExtensionOptionsDecorator(),
+		tokenfactory.NewIsBlacklistedDecorator(options.tokenFactoryKeeper),
+		tokenfactory.NewIsPausedDecorator(options.cdc, options.tokenFactoryKeeper),
+		tokenfactory.NewIsAllowlistedDecorator(options.tokenFactoryKeeper),
+		tokenfactory.NewIsFrozenDecorator(options.tokenFactoryKeeper),
 		fiattokenfactory.NewIsBlacklistedDecorator(options.fiatTokenFactoryKeeper),
 		fiattokenfactory.NewIsPausedDecorator(options.cdc, options.fiatTokenFactoryKeeper),
 		forwarding.NewAnteDecorator(options.ForwardingKeeper, options.AccountKeeper),
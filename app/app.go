@@ -326,13 +326,17 @@ func New(
 		app.MsgServiceRouter(),
 	)
 
-	app.BankKeeper = bankkeeper.NewBaseKeeper(
+	bankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
 		app.AccountKeeper,
 		app.GetSubspace(banktypes.ModuleName),
 		app.BlockedModuleAccountAddrs(),
 	)
+	// every module but the tokenfactory gets a bank keeper that can not send frozen amounts,
+	// the tokenfactory keeper it reads them from is set once it is created
+	frozenBankKeeper := tokenfactorymodulekeeper.NewFrozenBankKeeper(bankKeeper)
+	app.BankKeeper = frozenBankKeeper
 
 	app.StakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
@@ -460,9 +464,11 @@ func New(
 		keys[tokenfactorymoduletypes.StoreKey],
 		app.GetSubspace(tokenfactorymoduletypes.ModuleName),
 
-		app.BankKeeper,
+		bankKeeper,
+		app.UpgradeKeeper,
 	)
-	tokenfactoryModule := tokenfactorymodule.NewAppModule(appCodec, app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper)
+	frozenBankKeeper.SetTokenFactoryKeeper(app.TokenFactoryKeeper)
+	tokenfactoryModule := tokenfactorymodule.NewAppModule(appCodec, app.TokenFactoryKeeper, app.AccountKeeper, bankKeeper)
 
 	app.FiatTokenFactoryKeeper = fiattokenfactorymodulekeeper.NewKeeper(
 		appCodec,
@@ -502,6 +508,7 @@ func New(
 		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
 	)
 	transferStack = blockibc.NewIBCMiddleware(transferStack, app.FiatTokenFactoryKeeper)
+	transferStack = tokenfactorymodule.NewIBCMiddleware(transferStack, app.TokenFactoryKeeper)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
@@ -528,7 +535,7 @@ func New(
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		newBankModule(appCodec, app.BankKeeper, bankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
@@ -681,6 +688,7 @@ func New(
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
 			cdc:                    appCodec,
+			tokenFactoryKeeper:     app.TokenFactoryKeeper,
 			fiatTokenFactoryKeeper: app.FiatTokenFactoryKeeper,
 
 			IBCKeeper:         app.IBCKeeper,
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// bankModule is the bank module with its messages handled by a keeper that wraps its base keeper,
+// which the bank module itself can not do as its store migrations require the base keeper.
+type bankModule struct {
+	bank.AppModule
+	keeper     bankkeeper.Keeper
+	baseKeeper bankkeeper.BaseKeeper
+}
+
+func newBankModule(cdc codec.Codec, keeper bankkeeper.Keeper, baseKeeper bankkeeper.BaseKeeper, accountKeeper banktypes.AccountKeeper) bankModule {
+	return bankModule{
+		AppModule:  bank.NewAppModule(cdc, baseKeeper, accountKeeper),
+		keeper:     keeper,
+		baseKeeper: baseKeeper,
+	}
+}
+
+// RegisterServices registers the bank services like bank.AppModule does, but with the messages
+// handled by the wrapping keeper.
+func (am bankModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.baseKeeper)
+
+	m := bankkeeper.NewMigrator(am.baseKeeper)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", banktypes.ModuleName, err))
+	}
+}
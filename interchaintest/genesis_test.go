@@ -112,6 +112,16 @@ type TokenFactoryDenom struct {
 	Denom string `json:"denom"`
 }
 
+type TokenFactoryDenomAddress struct {
+	Address string `json:"address"`
+	Denom   string `json:"denom"`
+}
+
+type TokenFactoryDenomPaused struct {
+	Paused bool   `json:"paused"`
+	Denom  string `json:"denom"`
+}
+
 type DistributionEntity struct {
 	Address string `json:"address"`
 	Share   string `json:"share"`
@@ -434,7 +444,7 @@ func modifyGenesisAll(gw *genesisWrapper, minSetupTf, minSetupFiatTf bool) func(
 			return nil, fmt.Errorf("failed to unmarshal genesis file: %w", err)
 		}
 
-		if err := modifyGenesisTokenfactory(g, "tokenfactory", denomMetadataFrienzies, gw.tfRoles, minSetupTf); err != nil {
+		if err := modifyGenesisTokenfactoryDenom(g, denomMetadataFrienzies, gw.tfRoles, minSetupTf); err != nil {
 			return nil, err
 		}
 
@@ -496,6 +506,38 @@ func modifyGenesisTokenfactory(g map[string]interface{}, tokenfactoryModName str
 	return nil
 }
 
+// Modifies tokenfactory genesis accounts of a single minting denom.
+// If minSetup = true, only the owner address, paused state, and denom is setup in genesis.
+// These are minimum requirements to start the chain. Otherwise all tokenfactory accounts are created.
+func modifyGenesisTokenfactoryDenom(g map[string]interface{}, denomMetadata DenomMetadata, roles NobleRoles, minSetup bool) error {
+	denom := denomMetadata.Base
+	if err := dyno.Set(g, []TokenFactoryDenomAddress{{roles.Owner.FormattedAddress(), denom}}, "app_state", "tokenfactory", "ownerList"); err != nil {
+		return fmt.Errorf("failed to set owner address in genesis json: %w", err)
+	}
+	if err := dyno.Set(g, []TokenFactoryDenomPaused{{false, denom}}, "app_state", "tokenfactory", "pausedList"); err != nil {
+		return fmt.Errorf("failed to set paused in genesis json: %w", err)
+	}
+	if err := dyno.Set(g, []TokenFactoryDenom{{denom}}, "app_state", "tokenfactory", "mintingDenomList"); err != nil {
+		return fmt.Errorf("failed to set minting denom in genesis json: %w", err)
+	}
+	if err := dyno.Append(g, denomMetadata, "app_state", "bank", "denom_metadata"); err != nil {
+		return fmt.Errorf("failed to set denom metadata in genesis json: %w", err)
+	}
+	if minSetup {
+		return nil
+	}
+	if err := dyno.Set(g, []TokenFactoryDenomAddress{{roles.MasterMinter.FormattedAddress(), denom}}, "app_state", "tokenfactory", "masterMinterList"); err != nil {
+		return fmt.Errorf("failed to set master minter address in genesis json: %w", err)
+	}
+	if err := dyno.Set(g, []TokenFactoryDenomAddress{{roles.Blacklister.FormattedAddress(), denom}}, "app_state", "tokenfactory", "blacklisterList"); err != nil {
+		return fmt.Errorf("failed to set blacklister address in genesis json: %w", err)
+	}
+	if err := dyno.Set(g, []TokenFactoryDenomAddress{{roles.Pauser.FormattedAddress(), denom}}, "app_state", "tokenfactory", "pauserList"); err != nil {
+		return fmt.Errorf("failed to set pauser address in genesis json: %w", err)
+	}
+	return nil
+}
+
 func modifyGenesisParamAuthority(genbz map[string]interface{}, authorityAddress string) error {
 	if err := dyno.Set(genbz, authorityAddress, "app_state", "params", "params", "authority"); err != nil {
 		return fmt.Errorf("failed to set params authority in genesis json: %w", err)
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -25,3 +27,100 @@ func (MockBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr s
 func (MockBankKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool) {
 	return banktypes.Metadata{}, true
 }
+func (MockBankKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata) {}
+func (MockBankKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, sdk.ZeroInt())
+}
+
+// MockMetadataBankKeeper is a MockBankKeeper that keeps track of the denom metadata that has been set.
+type MockMetadataBankKeeper struct {
+	MockBankKeeper
+	metadata map[string]banktypes.Metadata
+}
+
+func NewMockMetadataBankKeeper() *MockMetadataBankKeeper {
+	return &MockMetadataBankKeeper{metadata: make(map[string]banktypes.Metadata)}
+}
+
+func (k *MockMetadataBankKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool) {
+	metadata, found := k.metadata[denom]
+	return metadata, found
+}
+
+func (k *MockMetadataBankKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata) {
+	k.metadata[denomMetaData.Base] = denomMetaData
+}
+
+// DeleteDenomMetaData removes the denom metadata of a denom, which the bank module itself never does.
+func (k *MockMetadataBankKeeper) DeleteDenomMetaData(denom string) {
+	delete(k.metadata, denom)
+}
+
+// MockSupplyBankKeeper is a MockBankKeeper that keeps track of the total supply of minted and burned coins,
+// as well as the balances of accounts and module accounts that coins are sent to and from.
+type MockSupplyBankKeeper struct {
+	MockBankKeeper
+	supply   sdk.Coins
+	balances map[string]sdk.Coins
+}
+
+func NewMockSupplyBankKeeper() *MockSupplyBankKeeper {
+	return &MockSupplyBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (k *MockSupplyBankKeeper) SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return k.balances[addr.String()]
+}
+
+func (k *MockSupplyBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.subBalance(authtypes.NewModuleAddress(senderModule), amt); err != nil {
+		return err
+	}
+	k.balances[recipientAddr.String()] = k.balances[recipientAddr.String()].Add(amt...)
+	return nil
+}
+
+func (k *MockSupplyBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.subBalance(senderAddr, amt); err != nil {
+		return err
+	}
+	moduleAddr := authtypes.NewModuleAddress(recipientModule)
+	k.balances[moduleAddr.String()] = k.balances[moduleAddr.String()].Add(amt...)
+	return nil
+}
+
+func (k *MockSupplyBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.subBalance(fromAddr, amt); err != nil {
+		return err
+	}
+	k.balances[toAddr.String()] = k.balances[toAddr.String()].Add(amt...)
+	return nil
+}
+
+func (k *MockSupplyBankKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	moduleAddr := authtypes.NewModuleAddress(moduleName)
+	k.balances[moduleAddr.String()] = k.balances[moduleAddr.String()].Add(amt...)
+	k.supply = k.supply.Add(amt...)
+	return nil
+}
+
+func (k *MockSupplyBankKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	if err := k.subBalance(authtypes.NewModuleAddress(moduleName), amt); err != nil {
+		return err
+	}
+	k.supply = k.supply.Sub(amt)
+	return nil
+}
+
+func (k *MockSupplyBankKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, k.supply.AmountOf(denom))
+}
+
+func (k *MockSupplyBankKeeper) subBalance(addr sdk.AccAddress, amt sdk.Coins) error {
+	balance, negative := k.balances[addr.String()].SafeSub(amt)
+	if negative {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", k.balances[addr.String()], amt)
+	}
+	k.balances[addr.String()] = balance
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
@@ -17,28 +18,48 @@ import (
 	tmdb "github.com/tendermint/tm-db"
 )
 
+// MockAuthorityKeeper always returns the same authority address.
+type MockAuthorityKeeper struct {
+	Authority string
+}
+
+func (k MockAuthorityKeeper) GetAuthority(ctx sdk.Context) string {
+	return k.Authority
+}
+
+// TokenfactoryAuthority is the authority of the keeper returned by TokenfactoryKeeper.
+var TokenfactoryAuthority = authtypes.NewModuleAddress("authority").String()
+
 func TokenfactoryKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+	return TokenfactoryKeeperWithBankKeeper(t, MockBankKeeper{})
+}
+
+func TokenfactoryKeeperWithBankKeeper(t testing.TB, bankKeeper types.BankKeeper) (*keeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("t_" + types.StoreKey)
 
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
 	cdc := codec.NewProtoCodec(registry)
 
 	paramsSubspace := typesparams.NewSubspace(cdc,
 		codec.NewLegacyAmino(),
 		storeKey,
-		nil,
+		tStoreKey,
 		"TokenfactoryParams",
 	)
 	k := keeper.NewKeeper(
 		cdc,
 		storeKey,
 		paramsSubspace,
-		MockBankKeeper{},
+		bankKeeper,
+		MockAuthorityKeeper{Authority: TokenfactoryAuthority},
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
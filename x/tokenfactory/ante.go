@@ -0,0 +1,391 @@
+package tokenfactory
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+var (
+	_ sdk.AnteDecorator = IsPausedDecorator{}
+	_ sdk.AnteDecorator = IsBlacklistedDecorator{}
+	_ sdk.AnteDecorator = IsAllowlistedDecorator{}
+	_ sdk.AnteDecorator = IsFrozenDecorator{}
+)
+
+// IsPausedDecorator rejects transfers and IBC transfers of a tokenfactory minting denom while the
+// corresponding operation of that denom is paused.
+type IsPausedDecorator struct {
+	cdc          codec.Codec
+	tokenFactory *keeper.Keeper
+}
+
+func NewIsPausedDecorator(cdc codec.Codec, tf *keeper.Keeper) IsPausedDecorator {
+	return IsPausedDecorator{
+		cdc:          cdc,
+		tokenFactory: tf,
+	}
+}
+
+func (ad IsPausedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	msgs := tx.GetMsgs()
+
+	err = ad.CheckMessages(ctx, msgs)
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (ad IsPausedDecorator) CheckMessages(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *authz.MsgExec:
+			nestedMsgs, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := ad.CheckMessages(ctx, nestedMsgs); err != nil {
+				return err
+			}
+		case *authz.MsgGrant:
+			var authorization authz.Authorization
+			err := ad.cdc.UnpackAny(m.Grant.Authorization, &authorization)
+			if err != nil {
+				return err
+			}
+
+			if grant, ok := authorization.(*banktypes.SendAuthorization); ok {
+				for _, coin := range grant.SpendLimit {
+					if checkPausedState(ctx, coin, ad.tokenFactory, types.PauseTransfer) {
+						return sdkerrors.Wrapf(types.ErrPaused, "can not perform token authorizations")
+					}
+				}
+			}
+		case *banktypes.MsgSend:
+			for _, c := range m.Amount {
+				if checkPausedState(ctx, c, ad.tokenFactory, types.PauseTransfer) {
+					return sdkerrors.Wrapf(types.ErrPaused, "can not perform token transfers")
+				}
+			}
+		case *banktypes.MsgMultiSend:
+			for _, i := range m.Inputs {
+				for _, c := range i.Coins {
+					if checkPausedState(ctx, c, ad.tokenFactory, types.PauseTransfer) {
+						return sdkerrors.Wrapf(types.ErrPaused, "can not perform token transfers")
+					}
+				}
+			}
+		case *transfertypes.MsgTransfer:
+			if checkPausedState(ctx, m.Token, ad.tokenFactory, types.PauseIBCOutbound) {
+				return sdkerrors.Wrapf(types.ErrPaused, "can not perform ibc token transfers")
+			}
+		default:
+			continue
+		}
+	}
+
+	return nil
+}
+
+// checkPausedState returns true if the coin is a tokenfactory minting denom for which the operation is
+// currently paused.
+func checkPausedState(ctx sdk.Context, c sdk.Coin, tf *keeper.Keeper, op types.PauseOperation) bool {
+	if !tf.MintingDenomSet(ctx, c.Denom) {
+		return false
+	}
+
+	return tf.GetPaused(ctx, c.Denom).IsPaused(op)
+}
+
+// IsBlacklistedDecorator rejects any transfer of a tokenfactory minting denom to or from a blacklisted address.
+type IsBlacklistedDecorator struct {
+	tokenFactory *keeper.Keeper
+}
+
+func NewIsBlacklistedDecorator(tf *keeper.Keeper) IsBlacklistedDecorator {
+	return IsBlacklistedDecorator{
+		tokenFactory: tf,
+	}
+}
+
+func (ad IsBlacklistedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	msgs := tx.GetMsgs()
+
+	err = ad.CheckMessages(ctx, msgs, nil)
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (ad IsBlacklistedDecorator) CheckMessages(ctx sdk.Context, msgs []sdk.Msg, grantee *string) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *authz.MsgExec:
+			nestedMsgs, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := ad.CheckMessages(ctx, nestedMsgs, &m.Grantee); err != nil {
+				return err
+			}
+		case *banktypes.MsgSend:
+			for _, c := range m.Amount {
+				if err := ad.checkGrantee(ctx, grantee, c); err != nil {
+					return err
+				}
+				if err := ad.checkAddress(ctx, m.ToAddress, c, "can not receive tokens"); err != nil {
+					return err
+				}
+				if err := ad.checkAddress(ctx, m.FromAddress, c, "can not send tokens"); err != nil {
+					return err
+				}
+			}
+		case *banktypes.MsgMultiSend:
+			for _, i := range m.Inputs {
+				for _, c := range i.Coins {
+					if err := ad.checkGrantee(ctx, grantee, c); err != nil {
+						return err
+					}
+					if err := ad.checkAddress(ctx, i.Address, c, "can not send tokens"); err != nil {
+						return err
+					}
+				}
+			}
+			for _, o := range m.Outputs {
+				for _, c := range o.Coins {
+					if err := ad.checkGrantee(ctx, grantee, c); err != nil {
+						return err
+					}
+					if err := ad.checkAddress(ctx, o.Address, c, "can not receive tokens"); err != nil {
+						return err
+					}
+				}
+			}
+		case *transfertypes.MsgTransfer:
+			if err := ad.checkGrantee(ctx, grantee, m.Token); err != nil {
+				return err
+			}
+			if err := ad.checkAddress(ctx, m.Sender, m.Token, "can not send tokens"); err != nil {
+				return err
+			}
+			// the receiver lives on the counterparty chain, so it is compared by its raw address bytes
+			if err := ad.checkAddress(ctx, m.Receiver, m.Token, "can not receive tokens"); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+	}
+
+	return nil
+}
+
+// checkGrantee ensures that the executor of an authz.MsgExec is not blacklisted.
+func (ad IsBlacklistedDecorator) checkGrantee(ctx sdk.Context, grantee *string, c sdk.Coin) error {
+	if grantee == nil {
+		return nil
+	}
+
+	return ad.checkAddress(ctx, *grantee, c, "can not execute token transfers")
+}
+
+// checkAddress wraps the result of checkForBlacklistedAddress with a human readable error.
+func (ad IsBlacklistedDecorator) checkAddress(ctx sdk.Context, address string, c sdk.Coin, action string) error {
+	err := checkForBlacklistedAddress(ctx, address, c, ad.tokenFactory)
+	if errors.Is(err, types.ErrUnauthorized) {
+		return sdkerrors.Wrapf(err, "an address (%s) is blacklisted and %s", address, action)
+	} else if err != nil {
+		return sdkerrors.Wrapf(err, "error decoding address (%s)", address)
+	}
+
+	return nil
+}
+
+// checkForBlacklistedAddress first checks if the denom being transacted is a tokenfactory minting denom,
+// if it is, it checks if the address involved in the tx is blacklisted for that denom.
+func checkForBlacklistedAddress(ctx sdk.Context, address string, c sdk.Coin, tf *keeper.Keeper) error {
+	if !tf.MintingDenomSet(ctx, c.Denom) {
+		return nil
+	}
+
+	_, addressBz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return err
+	}
+
+	_, found := tf.GetBlacklisted(ctx, c.Denom, addressBz)
+	if found {
+		return types.ErrUnauthorized
+	}
+
+	return nil
+}
+
+// IsAllowlistedDecorator rejects any transfer of a tokenfactory minting denom with its allowlist mode
+// enabled to an address that is not allowlisted.
+type IsAllowlistedDecorator struct {
+	tokenFactory *keeper.Keeper
+}
+
+func NewIsAllowlistedDecorator(tf *keeper.Keeper) IsAllowlistedDecorator {
+	return IsAllowlistedDecorator{
+		tokenFactory: tf,
+	}
+}
+
+func (ad IsAllowlistedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	msgs := tx.GetMsgs()
+
+	err = ad.CheckMessages(ctx, msgs)
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (ad IsAllowlistedDecorator) CheckMessages(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *authz.MsgExec:
+			nestedMsgs, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := ad.CheckMessages(ctx, nestedMsgs); err != nil {
+				return err
+			}
+		case *banktypes.MsgSend:
+			for _, c := range m.Amount {
+				if err := ad.tokenFactory.CheckAllowlisted(ctx, c.Denom, m.ToAddress); err != nil {
+					return err
+				}
+			}
+		case *banktypes.MsgMultiSend:
+			for _, o := range m.Outputs {
+				for _, c := range o.Coins {
+					if err := ad.tokenFactory.CheckAllowlisted(ctx, c.Denom, o.Address); err != nil {
+						return err
+					}
+				}
+			}
+		default:
+			continue
+		}
+	}
+
+	return nil
+}
+
+// IsFrozenDecorator rejects any transaction that would bring the balance of a sender below the amount
+// of a tokenfactory minting denom frozen on it. Amounts sent by the same address across the messages
+// of a transaction are added up before they are checked, along with the fee paid by that address,
+// since the decorator runs before the fee is deducted.
+type IsFrozenDecorator struct {
+	tokenFactory *keeper.Keeper
+}
+
+func NewIsFrozenDecorator(tf *keeper.Keeper) IsFrozenDecorator {
+	return IsFrozenDecorator{
+		tokenFactory: tf,
+	}
+}
+
+func (ad IsFrozenDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	outgoing := newOutgoingAmounts()
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		payer := feeTx.FeePayer()
+		if granter := feeTx.FeeGranter(); granter != nil {
+			payer = granter
+		}
+		outgoing.add(payer.String(), feeTx.GetFee()...)
+	}
+
+	err = ad.checkOutgoing(ctx, outgoing, tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (ad IsFrozenDecorator) CheckMessages(ctx sdk.Context, msgs []sdk.Msg) error {
+	return ad.checkOutgoing(ctx, newOutgoingAmounts(), msgs)
+}
+
+func (ad IsFrozenDecorator) checkOutgoing(ctx sdk.Context, outgoing *outgoingAmounts, msgs []sdk.Msg) error {
+	if err := outgoing.collect(msgs); err != nil {
+		return err
+	}
+
+	for _, sender := range outgoing.senders {
+		for _, c := range outgoing.amounts[sender] {
+			if err := ad.tokenFactory.CheckFrozen(ctx, sender, c); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// outgoingAmounts adds up the coins sent by each address, keeping the senders in the order they
+// were first seen.
+type outgoingAmounts struct {
+	senders []string
+	amounts map[string]sdk.Coins
+}
+
+func newOutgoingAmounts() *outgoingAmounts {
+	return &outgoingAmounts{amounts: make(map[string]sdk.Coins)}
+}
+
+func (o *outgoingAmounts) add(sender string, coins ...sdk.Coin) {
+	if _, ok := o.amounts[sender]; !ok {
+		o.senders = append(o.senders, sender)
+	}
+	o.amounts[sender] = o.amounts[sender].Add(coins...)
+}
+
+func (o *outgoingAmounts) collect(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *authz.MsgExec:
+			nestedMsgs, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := o.collect(nestedMsgs); err != nil {
+				return err
+			}
+		case *banktypes.MsgSend:
+			o.add(m.FromAddress, m.Amount...)
+		case *banktypes.MsgMultiSend:
+			for _, i := range m.Inputs {
+				o.add(i.Address, i.Coins...)
+			}
+		case *transfertypes.MsgTransfer:
+			o.add(m.Sender, m.Token)
+		default:
+			continue
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,376 @@
+package tokenfactory_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+const anteTestDenom = "ufrienzies"
+
+func TestIsBlacklistedDecorator(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: anteTestDenom})
+	k.SetPaused(ctx, types.Paused{Paused: false, Denom: anteTestDenom})
+
+	blacklisted := sample.TestAccount()
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklisted.AddressBz, Denom: anteTestDenom})
+	user := sample.AccAddress()
+
+	mintingCoins := sdk.NewCoins(sdk.NewCoin(anteTestDenom, sdk.NewInt(1)))
+	otherCoins := sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewInt(1)))
+
+	ad := tokenfactory.NewIsBlacklistedDecorator(k)
+
+	for _, tc := range []struct {
+		desc string
+		msgs []sdk.Msg
+		err  error
+	}{
+		{
+			desc: "send from blacklisted",
+			msgs: []sdk.Msg{banktypes.NewMsgSend(blacklisted.AddressBz, sdk.MustAccAddressFromBech32(user), mintingCoins)},
+			err:  types.ErrUnauthorized,
+		},
+		{
+			desc: "send to blacklisted",
+			msgs: []sdk.Msg{banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(user), blacklisted.AddressBz, mintingCoins)},
+			err:  types.ErrUnauthorized,
+		},
+		{
+			desc: "send other denom from blacklisted",
+			msgs: []sdk.Msg{banktypes.NewMsgSend(blacklisted.AddressBz, sdk.MustAccAddressFromBech32(user), otherCoins)},
+		},
+		{
+			desc: "multi send to blacklisted",
+			msgs: []sdk.Msg{banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(sdk.MustAccAddressFromBech32(user), mintingCoins)},
+				[]banktypes.Output{banktypes.NewOutput(blacklisted.AddressBz, mintingCoins)},
+			)},
+			err: types.ErrUnauthorized,
+		},
+		{
+			desc: "ibc transfer from blacklisted",
+			msgs: []sdk.Msg{transfertypes.NewMsgTransfer("transfer", "channel-0", mintingCoins[0], blacklisted.Address, user, clienttypes.ZeroHeight(), 1)},
+			err:  types.ErrUnauthorized,
+		},
+		{
+			desc: "authz exec by blacklisted grantee",
+			msgs: []sdk.Msg{newMsgExec(t, blacklisted.AddressBz, banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(user), sdk.MustAccAddressFromBech32(sample.AccAddress()), mintingCoins))},
+			err:  types.ErrUnauthorized,
+		},
+		{
+			desc: "authz exec after an unrelated message",
+			msgs: []sdk.Msg{
+				banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(user), sdk.MustAccAddressFromBech32(sample.AccAddress()), otherCoins),
+				newMsgExec(t, sdk.MustAccAddressFromBech32(user), banktypes.NewMsgSend(blacklisted.AddressBz, sdk.MustAccAddressFromBech32(user), mintingCoins)),
+			},
+			err: types.ErrUnauthorized,
+		},
+		{
+			desc: "valid send",
+			msgs: []sdk.Msg{banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(user), sdk.MustAccAddressFromBech32(sample.AccAddress()), mintingCoins)},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := ad.CheckMessages(ctx, tc.msgs, nil)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsPausedDecorator(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: anteTestDenom})
+	paused := types.Paused{Denom: anteTestDenom}
+	paused.SetOperations(nil, true)
+	k.SetPaused(ctx, paused)
+
+	from := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	to := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	mintingCoins := sdk.NewCoins(sdk.NewCoin(anteTestDenom, sdk.NewInt(1)))
+	otherCoins := sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewInt(1)))
+
+	ad := tokenfactory.NewIsPausedDecorator(nil, k)
+
+	err := ad.CheckMessages(ctx, []sdk.Msg{banktypes.NewMsgSend(from, to, mintingCoins)})
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	err = ad.CheckMessages(ctx, []sdk.Msg{newMsgExec(t, to, banktypes.NewMsgSend(from, to, mintingCoins))})
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	err = ad.CheckMessages(ctx, []sdk.Msg{transfertypes.NewMsgTransfer("transfer", "channel-0", mintingCoins[0], from.String(), to.String(), clienttypes.ZeroHeight(), 1)})
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	err = ad.CheckMessages(ctx, []sdk.Msg{banktypes.NewMsgSend(from, to, otherCoins)})
+	require.NoError(t, err)
+
+	msgTransfer := transfertypes.NewMsgTransfer("transfer", "channel-0", mintingCoins[0], from.String(), to.String(), clienttypes.ZeroHeight(), 1)
+
+	// only ibc transfers remain paused
+	paused.SetOperations([]types.PauseOperation{types.PauseTransfer}, false)
+	k.SetPaused(ctx, paused)
+
+	err = ad.CheckMessages(ctx, []sdk.Msg{banktypes.NewMsgSend(from, to, mintingCoins)})
+	require.NoError(t, err)
+
+	err = ad.CheckMessages(ctx, []sdk.Msg{msgTransfer})
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	// only transfers are paused
+	paused.SetOperations([]types.PauseOperation{types.PauseTransfer}, true)
+	paused.SetOperations([]types.PauseOperation{types.PauseIBCOutbound}, false)
+	k.SetPaused(ctx, paused)
+
+	err = ad.CheckMessages(ctx, []sdk.Msg{banktypes.NewMsgSend(from, to, mintingCoins)})
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	err = ad.CheckMessages(ctx, []sdk.Msg{msgTransfer})
+	require.NoError(t, err)
+
+	k.SetPaused(ctx, types.Paused{Paused: false, Denom: anteTestDenom})
+
+	err = ad.CheckMessages(ctx, []sdk.Msg{banktypes.NewMsgSend(from, to, mintingCoins)})
+	require.NoError(t, err)
+}
+
+func newMsgExec(t *testing.T, grantee sdk.AccAddress, msgs ...sdk.Msg) *authz.MsgExec {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys[i] = any
+	}
+
+	return &authz.MsgExec{Grantee: grantee.String(), Msgs: anys}
+}
+
+func TestIsAllowlistedDecorator(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: anteTestDenom})
+	k.SetAllowlistMode(ctx, types.AllowlistMode{Denom: anteTestDenom, Enabled: true})
+
+	allowlisted := sample.TestAccount()
+	k.SetAllowlisted(ctx, types.Allowlisted{AddressBz: allowlisted.AddressBz, Denom: anteTestDenom})
+	user := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	mintingCoins := sdk.NewCoins(sdk.NewCoin(anteTestDenom, sdk.NewInt(1)))
+	otherCoins := sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewInt(1)))
+
+	ad := tokenfactory.NewIsAllowlistedDecorator(k)
+
+	for _, tc := range []struct {
+		desc string
+		msgs []sdk.Msg
+		err  error
+	}{
+		{
+			desc: "send to allowlisted",
+			msgs: []sdk.Msg{banktypes.NewMsgSend(user, allowlisted.AddressBz, mintingCoins)},
+		},
+		{
+			desc: "send to address that is not allowlisted",
+			msgs: []sdk.Msg{banktypes.NewMsgSend(allowlisted.AddressBz, user, mintingCoins)},
+			err:  types.ErrNotAllowlisted,
+		},
+		{
+			desc: "send other denom to address that is not allowlisted",
+			msgs: []sdk.Msg{banktypes.NewMsgSend(allowlisted.AddressBz, user, otherCoins)},
+		},
+		{
+			desc: "multi send to address that is not allowlisted",
+			msgs: []sdk.Msg{banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(allowlisted.AddressBz, mintingCoins.Add(mintingCoins...))},
+				[]banktypes.Output{banktypes.NewOutput(allowlisted.AddressBz, mintingCoins), banktypes.NewOutput(user, mintingCoins)},
+			)},
+			err: types.ErrNotAllowlisted,
+		},
+		{
+			desc: "authz exec of a send to address that is not allowlisted",
+			msgs: []sdk.Msg{newMsgExec(t, allowlisted.AddressBz, banktypes.NewMsgSend(allowlisted.AddressBz, user, mintingCoins))},
+			err:  types.ErrNotAllowlisted,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := ad.CheckMessages(ctx, tc.msgs)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// nothing is checked once the allowlist mode is disabled
+	k.SetAllowlistMode(ctx, types.AllowlistMode{Denom: anteTestDenom, Enabled: false})
+	require.NoError(t, ad.CheckMessages(ctx, []sdk.Msg{banktypes.NewMsgSend(allowlisted.AddressBz, user, mintingCoins)}))
+}
+
+func TestIsFrozenDecorator(t *testing.T) {
+	bankKeeper := keepertest.NewMockSupplyBankKeeper()
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: anteTestDenom})
+
+	frozen := sample.TestAccount()
+	user := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	balance := sdk.NewCoins(sdk.NewCoin(anteTestDenom, sdk.NewInt(100)), sdk.NewCoin("uusdc", sdk.NewInt(100)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, balance))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, frozen.AddressBz, balance))
+
+	k.SetFrozenAmount(ctx, types.FrozenAmount{AddressBz: frozen.AddressBz, Amount: sdk.NewCoin(anteTestDenom, sdk.NewInt(60))})
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(anteTestDenom, sdk.NewInt(amount)))
+	}
+
+	ad := tokenfactory.NewIsFrozenDecorator(k)
+
+	for _, tc := range []struct {
+		desc string
+		msgs []sdk.Msg
+		err  error
+	}{
+		{
+			desc: "send the amount that is not frozen",
+			msgs: []sdk.Msg{banktypes.NewMsgSend(frozen.AddressBz, user, coins(40))},
+		},
+		{
+			desc: "send part of the frozen amount",
+			msgs: []sdk.Msg{banktypes.NewMsgSend(frozen.AddressBz, user, coins(41))},
+			err:  types.ErrFrozenAmount,
+		},
+		{
+			desc: "send other denom",
+			msgs: []sdk.Msg{banktypes.NewMsgSend(frozen.AddressBz, user, sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewInt(100))))},
+		},
+		{
+			desc: "sends that only exceed the amount that is not frozen together",
+			msgs: []sdk.Msg{
+				banktypes.NewMsgSend(frozen.AddressBz, user, coins(30)),
+				banktypes.NewMsgSend(frozen.AddressBz, user, coins(30)),
+			},
+			err: types.ErrFrozenAmount,
+		},
+		{
+			desc: "multi send of part of the frozen amount",
+			msgs: []sdk.Msg{banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(frozen.AddressBz, coins(50))},
+				[]banktypes.Output{banktypes.NewOutput(user, coins(50))},
+			)},
+			err: types.ErrFrozenAmount,
+		},
+		{
+			desc: "ibc transfer of part of the frozen amount",
+			msgs: []sdk.Msg{transfertypes.NewMsgTransfer(
+				"transfer", "channel-0", sdk.NewCoin(anteTestDenom, sdk.NewInt(50)), frozen.Address, "cosmos1", clienttypes.ZeroHeight(), 1,
+			)},
+			err: types.ErrFrozenAmount,
+		},
+		{
+			desc: "authz exec of a send of part of the frozen amount",
+			msgs: []sdk.Msg{newMsgExec(t, user, banktypes.NewMsgSend(frozen.AddressBz, user, coins(50)))},
+			err:  types.ErrFrozenAmount,
+		},
+		{
+			desc: "send from an address without a frozen amount",
+			msgs: []sdk.Msg{banktypes.NewMsgSend(user, frozen.AddressBz, coins(50))},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := ad.CheckMessages(ctx, tc.msgs)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// feeTx is a transaction that only carries messages and a fee.
+type feeTx struct {
+	msgs    []sdk.Msg
+	fee     sdk.Coins
+	payer   sdk.AccAddress
+	granter sdk.AccAddress
+}
+
+func (tx feeTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx feeTx) ValidateBasic() error       { return nil }
+func (tx feeTx) GetGas() uint64             { return 200000 }
+func (tx feeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx feeTx) FeePayer() sdk.AccAddress   { return tx.payer }
+func (tx feeTx) FeeGranter() sdk.AccAddress { return tx.granter }
+
+func TestIsFrozenDecoratorFee(t *testing.T) {
+	bankKeeper := keepertest.NewMockSupplyBankKeeper()
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: anteTestDenom})
+
+	frozen := sample.TestAccount()
+	user := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	balance := sdk.NewCoins(sdk.NewCoin(anteTestDenom, sdk.NewInt(100)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, balance))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, frozen.AddressBz, balance))
+
+	k.SetFrozenAmount(ctx, types.FrozenAmount{AddressBz: frozen.AddressBz, Amount: sdk.NewCoin(anteTestDenom, sdk.NewInt(60))})
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(anteTestDenom, sdk.NewInt(amount)))
+	}
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	ad := tokenfactory.NewIsFrozenDecorator(k)
+
+	for _, tc := range []struct {
+		desc string
+		tx   feeTx
+		err  error
+	}{
+		{
+			desc: "fee and send within the amount that is not frozen",
+			tx:   feeTx{msgs: []sdk.Msg{banktypes.NewMsgSend(frozen.AddressBz, user, coins(30))}, fee: coins(10), payer: frozen.AddressBz},
+		},
+		{
+			desc: "fee and send that only exceed the amount that is not frozen together",
+			tx:   feeTx{msgs: []sdk.Msg{banktypes.NewMsgSend(frozen.AddressBz, user, coins(30))}, fee: coins(11), payer: frozen.AddressBz},
+			err:  types.ErrFrozenAmount,
+		},
+		{
+			desc: "fee of part of the frozen amount without any send",
+			tx:   feeTx{fee: coins(41), payer: frozen.AddressBz},
+			err:  types.ErrFrozenAmount,
+		},
+		{
+			desc: "fee paid by a granter",
+			tx:   feeTx{msgs: []sdk.Msg{banktypes.NewMsgSend(frozen.AddressBz, user, coins(40))}, fee: coins(41), payer: frozen.AddressBz, granter: user},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := ad.AnteHandle(ctx, tc.tx, false, next)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
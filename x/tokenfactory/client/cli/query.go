@@ -27,10 +27,39 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListMinters())
 	cmd.AddCommand(CmdShowMinters())
 	cmd.AddCommand(CmdShowPauser())
+	cmd.AddCommand(CmdShowAttestor())
+	cmd.AddCommand(CmdShowAllowlister())
+	cmd.AddCommand(CmdListAllowlisted())
+	cmd.AddCommand(CmdShowAllowlisted())
+	cmd.AddCommand(CmdShowAllowlistMode())
+	cmd.AddCommand(CmdListFrozenAmount())
+	cmd.AddCommand(CmdShowFrozenAmount())
+	cmd.AddCommand(CmdShowTotalFrozen())
 	cmd.AddCommand(CmdShowBlacklister())
 	cmd.AddCommand(CmdShowOwner())
 	cmd.AddCommand(CmdListMinterController())
 	cmd.AddCommand(CmdShowMinterController())
+	cmd.AddCommand(CmdListMintersByController())
+	cmd.AddCommand(CmdShowControllerByMinter())
+	cmd.AddCommand(CmdListMintRateLimit())
+	cmd.AddCommand(CmdShowMintRateLimit())
+	cmd.AddCommand(CmdShowSupplyCap())
+	cmd.AddCommand(CmdShowProcessedReference())
+	cmd.AddCommand(CmdListRedemption())
+	cmd.AddCommand(CmdShowRedemption())
+	cmd.AddCommand(CmdShowMinterStats())
+	cmd.AddCommand(CmdShowModuleStats())
+	cmd.AddCommand(CmdListReserveAttestation())
+	cmd.AddCommand(CmdShowReserveAttestation())
+	cmd.AddCommand(CmdShowCollateralizationRatio())
+	cmd.AddCommand(CmdListPauseSchedule())
+	cmd.AddCommand(CmdShowPauseSchedule())
+	cmd.AddCommand(CmdShowAdminQuorum())
+	cmd.AddCommand(CmdListAdminProposal())
+	cmd.AddCommand(CmdShowAdminProposal())
+	cmd.AddCommand(CmdListAuditLogEntry())
+	cmd.AddCommand(CmdShowAuditLogEntry())
+	cmd.AddCommand(CmdListMintingDenom())
 	cmd.AddCommand(CmdShowMintingDenom())
 	// this line is used by starport scaffolding # 1
 
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowAdminQuorum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-admin-quorum [denom]",
+		Short: "shows the approvers and threshold of a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetAdminQuorumRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.AdminQuorum(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListAdminProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-admin-proposal",
+		Short: "list all pending admin-proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllAdminProposalRequest{
+				Pagination: pageReq,
+				Denom:      denom,
+			}
+
+			res, err := queryClient.AdminProposalAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "only list proposals of this denom")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowAdminProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-admin-proposal [denom] [id]",
+		Short: "shows a pending admin-proposal",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argDenom := args[0]
+			argId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetAdminProposalRequest{
+				Denom: argDenom,
+				Id:    argId,
+			}
+
+			res, err := queryClient.AdminProposal(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,125 @@
+package cli_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/noble-assets/noble/v5/testutil/network"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/client/cli"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func networkWithAdminProposalObjects(t *testing.T) (*network.Network, types.AdminQuorum, []types.AdminProposal) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	addTestDenom(t, cfg, &state)
+
+	approvers := []string{sample.AccAddress(), sample.AccAddress()}
+	quorum := types.AdminQuorum{
+		Denom:        testDenom,
+		Approvers:    approvers,
+		Threshold:    2,
+		VotingPeriod: time.Hour,
+	}
+	state.AdminQuorumList = append(state.AdminQuorumList, quorum)
+
+	submitTime := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, msg := range []types.AdminMsg{
+		types.NewMsgUpdatePauser(sample.AccAddress(), sample.AccAddress(), testDenom),
+		&types.MsgUnpause{From: sample.AccAddress(), Denom: testDenom},
+	} {
+		any, err := cdctypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+
+		state.AdminProposalList = append(state.AdminProposalList, types.AdminProposal{
+			Denom:      testDenom,
+			Id:         uint64(i),
+			Msg:        any,
+			Proposer:   approvers[i],
+			Approvals:  []string{approvers[i]},
+			SubmitTime: submitTime,
+			Expiry:     submitTime.Add(time.Hour),
+		})
+	}
+	state.AdminProposalCount = 2
+
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), quorum, state.AdminProposalList
+}
+
+func TestShowAdminProposal(t *testing.T) {
+	net, quorum, objs := networkWithAdminProposalObjects(t)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	t.Run("quorum", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowAdminQuorum(), append([]string{testDenom}, common...))
+		require.NoError(t, err)
+		var resp types.QueryGetAdminQuorumResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, quorum, resp.AdminQuorum)
+	})
+
+	for _, tc := range []struct {
+		desc string
+		id   string
+		err  error
+		obj  types.AdminProposal
+	}{
+		{
+			desc: "found",
+			id:   "0",
+			obj:  objs[0],
+		},
+		{
+			desc: "not found",
+			id:   strconv.Itoa(100),
+			err:  status.Error(codes.NotFound, "not found"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowAdminProposal(), append([]string{testDenom, tc.id}, common...))
+			if tc.err != nil {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			} else {
+				require.NoError(t, err)
+				var resp types.QueryGetAdminProposalResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				// the Any of a decoded message keeps the JSON it was decoded from, so proposals are compared by their JSON
+				require.JSONEq(t,
+					string(net.Config.Codec.MustMarshalJSON(&tc.obj)),
+					string(net.Config.Codec.MustMarshalJSON(&resp.AdminProposal)),
+				)
+			}
+		})
+	}
+
+	t.Run("list", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListAdminProposal(), append([]string{fmt.Sprintf("--%s=%s", cli.FlagDenom, testDenom)}, common...))
+		require.NoError(t, err)
+		var resp types.QueryAllAdminProposalResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.JSONEq(t,
+			string(net.Config.Codec.MustMarshalJSON(&types.QueryAllAdminProposalResponse{AdminProposal: objs})),
+			string(net.Config.Codec.MustMarshalJSON(&types.QueryAllAdminProposalResponse{AdminProposal: resp.AdminProposal})),
+		)
+	})
+}
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListAllowlisted() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-allowlisted [denom]",
+		Short: "list all allowlisted",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllAllowlistedRequest{
+				Pagination: pageReq,
+				Denom:      args[0],
+			}
+
+			res, err := queryClient.AllowlistedAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowAllowlisted() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-allowlisted [denom] [address]",
+		Short: "shows an allowlisted",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argDenom := args[0]
+			argAddress := args[1]
+
+			params := &types.QueryGetAllowlistedRequest{
+				Denom:   argDenom,
+				Address: argAddress,
+			}
+
+			res, err := queryClient.Allowlisted(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowAllowlistMode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-allowlist-mode [denom]",
+		Short: "shows whether the allowlist mode of a denom is enabled",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetAllowlistModeRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.AllowlistMode(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,186 @@
+package cli_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/noble-assets/noble/v5/testutil/network"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/client/cli"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func networkWithAllowlistedObjects(t *testing.T, n int) (*network.Network, []types.Allowlisted, []sample.Account) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	addTestDenom(t, cfg, &state)
+	state.AllowlistModeList = append(state.AllowlistModeList, types.AllowlistMode{Denom: testDenom, Enabled: true})
+
+	accounts := make([]sample.Account, n)
+	for i := 0; i < n; i++ {
+		account := sample.TestAccount()
+		allowlisted := types.Allowlisted{
+			AddressBz:     account.AddressBz,
+			Denom:         testDenom,
+			AllowlistedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+		state.AllowlistedList = append(state.AllowlistedList, allowlisted)
+		accounts[i] = account
+	}
+
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), state.AllowlistedList, accounts
+}
+
+func TestShowAllowlisted(t *testing.T) {
+	net, _, objs := networkWithAllowlistedObjects(t, 2)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc    string
+		address string
+
+		args []string
+		err  error
+		obj  sample.Account
+	}{
+		{
+			desc:    "found",
+			address: objs[0].Address,
+
+			args: common,
+			obj:  objs[0],
+		},
+		{
+			desc:    "not found",
+			address: sample.TestAccount().Address,
+
+			args: common,
+			err:  status.Error(codes.NotFound, "not found"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			args := []string{
+				testDenom,
+				tc.address,
+			}
+			args = append(args, tc.args...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowAllowlisted(), args)
+			if tc.err != nil {
+				stat, ok := status.FromError(tc.err)
+				require.True(t, ok)
+				require.ErrorIs(t, stat.Err(), tc.err)
+			} else {
+				require.NoError(t, err)
+				var resp types.QueryGetAllowlistedResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.NotNil(t, resp.Allowlisted)
+				require.Equal(t,
+					nullify.Fill(&tc.obj.AddressBz),
+					nullify.Fill(&resp.Allowlisted.AddressBz),
+				)
+			}
+		})
+	}
+}
+
+func TestListAllowlisted(t *testing.T) {
+	net, objs, _ := networkWithAllowlistedObjects(t, 5)
+
+	ctx := net.Validators[0].ClientCtx
+	request := func(next []byte, offset, limit uint64, total bool) []string {
+		args := []string{
+			testDenom,
+			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		}
+		if next == nil {
+			args = append(args, fmt.Sprintf("--%s=%d", flags.FlagOffset, offset))
+		} else {
+			args = append(args, fmt.Sprintf("--%s=%s", flags.FlagPageKey, next))
+		}
+		args = append(args, fmt.Sprintf("--%s=%d", flags.FlagLimit, limit))
+		if total {
+			args = append(args, fmt.Sprintf("--%s", flags.FlagCountTotal))
+		}
+		return args
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(objs); i += step {
+			args := request(nil, uint64(i), uint64(step), false)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListAllowlisted(), args)
+			require.NoError(t, err)
+			var resp types.QueryAllAllowlistedResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.LessOrEqual(t, len(resp.Allowlisted), step)
+			require.Subset(t,
+				nullify.Fill(objs),
+				nullify.Fill(resp.Allowlisted),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(objs); i += step {
+			args := request(next, 0, uint64(step), false)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListAllowlisted(), args)
+			require.NoError(t, err)
+			var resp types.QueryAllAllowlistedResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.LessOrEqual(t, len(resp.Allowlisted), step)
+			require.Subset(t,
+				nullify.Fill(objs),
+				nullify.Fill(resp.Allowlisted),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		args := request(nil, 0, uint64(len(objs)), true)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListAllowlisted(), args)
+		require.NoError(t, err)
+		var resp types.QueryAllAllowlistedResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.NoError(t, err)
+		require.Equal(t, len(objs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(objs),
+			nullify.Fill(resp.Allowlisted),
+		)
+	})
+}
+
+func TestShowAllowlistMode(t *testing.T) {
+	net, _, _ := networkWithAllowlistedObjects(t, 1)
+
+	ctx := net.Validators[0].ClientCtx
+	args := []string{testDenom, fmt.Sprintf("--%s=json", tmcli.OutputFlag)}
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowAllowlistMode(), args)
+	require.NoError(t, err)
+
+	var resp types.QueryGetAllowlistModeResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Equal(t, types.AllowlistMode{Denom: testDenom, Enabled: true}, resp.AllowlistMode)
+}
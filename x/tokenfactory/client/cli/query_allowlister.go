@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowAllowlister() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-allowlister [denom]",
+		Short: "shows allowlister",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetAllowlisterRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.Allowlister(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
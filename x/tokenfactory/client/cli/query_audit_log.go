@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagDenom     = "denom"
+	FlagAction    = "action"
+	FlagActor     = "actor"
+	FlagMinHeight = "min-height"
+	FlagMaxHeight = "max-height"
+)
+
+func CmdListAuditLogEntry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-audit-log",
+		Short: "list the audit log of administrative actions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			action, err := cmd.Flags().GetString(FlagAction)
+			if err != nil {
+				return err
+			}
+			actor, err := cmd.Flags().GetString(FlagActor)
+			if err != nil {
+				return err
+			}
+			minHeight, err := cmd.Flags().GetInt64(FlagMinHeight)
+			if err != nil {
+				return err
+			}
+			maxHeight, err := cmd.Flags().GetInt64(FlagMaxHeight)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllAuditLogEntryRequest{
+				Pagination: pageReq,
+				Denom:      denom,
+				Actor:      actor,
+				MinHeight:  minHeight,
+				MaxHeight:  maxHeight,
+			}
+
+			if action != "" {
+				params.Action, err = types.ParseAuditAction(action)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AuditLogEntryAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "only list entries of this denom")
+	cmd.Flags().String(FlagAction, "", "only list entries of this action, e.g. update-owner, configure-minter, blacklist or pause")
+	cmd.Flags().String(FlagActor, "", "only list entries of actions taken by this address")
+	cmd.Flags().Int64(FlagMinHeight, 0, "only list entries recorded at or after this height")
+	cmd.Flags().Int64(FlagMaxHeight, 0, "only list entries recorded at or before this height")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowAuditLogEntry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-audit-log [id]",
+		Short: "shows an audit log entry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetAuditLogEntryRequest{
+				Id: argId,
+			}
+
+			res, err := queryClient.AuditLogEntry(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
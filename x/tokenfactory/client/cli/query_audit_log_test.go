@@ -0,0 +1,147 @@
+package cli_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/noble-assets/noble/v5/testutil/network"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/client/cli"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func networkWithAuditLogObjects(t *testing.T) (*network.Network, []types.AuditLogEntry) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	addTestDenom(t, cfg, &state)
+
+	owner := sample.AccAddress()
+	pauser := sample.AccAddress()
+	recordedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	state.AuditLogList = append(state.AuditLogList,
+		types.AuditLogEntry{
+			Id:       0,
+			Denom:    testDenom,
+			Action:   types.AuditActionUpdatePauser,
+			Actor:    owner,
+			Target:   pauser,
+			NewValue: pauser,
+			Height:   1,
+			Time:     recordedAt,
+		},
+		types.AuditLogEntry{
+			Id:       1,
+			Denom:    testDenom,
+			Action:   types.AuditActionPause,
+			Actor:    pauser,
+			NewValue: types.PauseMint.String(),
+			Height:   2,
+			Time:     recordedAt.Add(time.Minute),
+		},
+		types.AuditLogEntry{
+			Id:       2,
+			Denom:    testDenom,
+			Action:   types.AuditActionUnpause,
+			Actor:    pauser,
+			OldValue: types.PauseMint.String(),
+			Height:   3,
+			Time:     recordedAt.Add(2 * time.Minute),
+		},
+	)
+	state.AuditLogCount = 3
+
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), state.AuditLogList
+}
+
+func TestAuditLog(t *testing.T) {
+	net, objs := networkWithAuditLogObjects(t)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc string
+		id   string
+		err  error
+		obj  types.AuditLogEntry
+	}{
+		{
+			desc: "found",
+			id:   "1",
+			obj:  objs[1],
+		},
+		{
+			desc: "not found",
+			id:   strconv.Itoa(100),
+			err:  status.Error(codes.NotFound, "not found"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowAuditLogEntry(), append([]string{tc.id}, common...))
+			if tc.err != nil {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			} else {
+				require.NoError(t, err)
+				var resp types.QueryGetAuditLogEntryResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.Equal(t,
+					nullify.Fill(&tc.obj),
+					nullify.Fill(&resp.AuditLogEntry),
+				)
+			}
+		})
+	}
+
+	for _, tc := range []struct {
+		desc string
+		args []string
+		objs []types.AuditLogEntry
+	}{
+		{
+			desc: "list",
+			objs: objs,
+		},
+		{
+			desc: "by action",
+			args: []string{fmt.Sprintf("--%s=pause", cli.FlagAction)},
+			objs: objs[1:2],
+		},
+		{
+			desc: "by actor and height range",
+			args: []string{
+				fmt.Sprintf("--%s=%s", cli.FlagActor, objs[1].Actor),
+				fmt.Sprintf("--%s=3", cli.FlagMinHeight),
+				fmt.Sprintf("--%s=%s", cli.FlagDenom, testDenom),
+			},
+			objs: objs[2:],
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListAuditLogEntry(), append(tc.args, common...))
+			require.NoError(t, err)
+			var resp types.QueryAllAuditLogEntryResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.Equal(t,
+				nullify.Fill(tc.objs),
+				nullify.Fill(resp.AuditLogEntry),
+			)
+		})
+	}
+}
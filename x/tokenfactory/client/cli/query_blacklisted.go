@@ -11,8 +11,9 @@ import (
 
 func CmdListBlacklisted() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "list-blacklisted",
+		Use:   "list-blacklisted [denom]",
 		Short: "list all blacklisted",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
@@ -23,8 +24,15 @@ func CmdListBlacklisted() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
 			params := &types.QueryAllBlacklistedRequest{
 				Pagination: pageReq,
+				Denom:      args[0],
+				Reason:     reason,
 			}
 
 			res, err := queryClient.BlacklistedAll(context.Background(), params)
@@ -36,6 +44,7 @@ func CmdListBlacklisted() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagReason, "", "only list addresses blacklisted for this reason")
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -44,17 +53,19 @@ func CmdListBlacklisted() *cobra.Command {
 
 func CmdShowBlacklisted() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-blacklisted [address]",
+		Use:   "show-blacklisted [denom] [address]",
 		Short: "shows a blacklisted",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			argAddress := args[0]
+			argDenom := args[0]
+			argAddress := args[1]
 
 			params := &types.QueryGetBlacklistedRequest{
+				Denom:   argDenom,
 				Address: argAddress,
 			}
 
@@ -28,11 +28,16 @@ func networkWithBlacklistedObjects(t *testing.T, n int) (*network.Network, []typ
 	state := types.GenesisState{}
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
+	addTestDenom(t, cfg, &state)
+
 	accounts := make([]sample.Account, n)
 	for i := 0; i < n; i++ {
 		account := sample.TestAccount()
 		blacklisted := types.Blacklisted{
 			AddressBz: account.AddressBz,
+			Denom:     testDenom,
+			Reason:    []string{"sanctions", "fraud"}[i%2],
+			CaseID:    strconv.Itoa(i),
 		}
 		state.BlacklistedList = append(state.BlacklistedList, blacklisted)
 		accounts[i] = account
@@ -76,6 +81,7 @@ func TestShowBlacklisted(t *testing.T) {
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			args := []string{
+				testDenom,
 				tc.address,
 			}
 			args = append(args, tc.args...)
@@ -104,6 +110,7 @@ func TestListBlacklisted(t *testing.T) {
 	ctx := net.Validators[0].ClientCtx
 	request := func(next []byte, offset, limit uint64, total bool) []string {
 		args := []string{
+			testDenom,
 			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 		}
 		if next == nil {
@@ -162,4 +169,17 @@ func TestListBlacklisted(t *testing.T) {
 			nullify.Fill(resp.Blacklisted),
 		)
 	})
+	t.Run("ByReason", func(t *testing.T) {
+		args := request(nil, 0, uint64(len(objs)), true)
+		args = append(args, fmt.Sprintf("--%s=fraud", cli.FlagReason))
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListBlacklisted(), args)
+		require.NoError(t, err)
+		var resp types.QueryAllBlacklistedResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, 2, int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill([]types.Blacklisted{objs[1], objs[3]}),
+			nullify.Fill(resp.Blacklisted),
+		)
+	})
 }
@@ -11,15 +11,17 @@ import (
 
 func CmdShowBlacklister() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-blacklister",
+		Use:   "show-blacklister [denom]",
 		Short: "shows blacklister",
-		Args:  cobra.NoArgs,
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetBlacklisterRequest{}
+			params := &types.QueryGetBlacklisterRequest{
+				Denom: args[0],
+			}
 
 			res, err := queryClient.Blacklister(context.Background(), params)
 			if err != nil {
@@ -21,13 +21,15 @@ func networkWithBlacklisterObjects(t *testing.T) (*network.Network, types.Blackl
 	state := types.GenesisState{}
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
-	blacklister := &types.Blacklister{}
+	addTestDenom(t, cfg, &state)
+
+	blacklister := types.Blacklister{Denom: testDenom}
 	nullify.Fill(&blacklister)
-	state.Blacklister = blacklister
+	state.BlacklisterList = append(state.BlacklisterList, blacklister)
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
-	return network.New(t, cfg), *state.Blacklister
+	return network.New(t, cfg), blacklister
 }
 
 func TestShowBlacklister(t *testing.T) {
@@ -50,7 +52,9 @@ func TestShowBlacklister(t *testing.T) {
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			var args []string
+			args := []string{
+				testDenom,
+			}
 			args = append(args, tc.args...)
 			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowBlacklister(), args)
 			if tc.err != nil {
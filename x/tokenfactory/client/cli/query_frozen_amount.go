@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListFrozenAmount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-frozen-amount [denom]",
+		Short: "list all frozen amounts of a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllFrozenAmountRequest{
+				Pagination: pageReq,
+				Denom:      args[0],
+			}
+
+			res, err := queryClient.FrozenAmountAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowFrozenAmount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-frozen-amount [denom] [address]",
+		Short: "shows the amount of a denom frozen on an address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argDenom := args[0]
+			argAddress := args[1]
+
+			params := &types.QueryGetFrozenAmountRequest{
+				Denom:   argDenom,
+				Address: argAddress,
+			}
+
+			res, err := queryClient.FrozenAmount(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowTotalFrozen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-total-frozen [denom]",
+		Short: "shows the amount of a denom frozen across all addresses",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryTotalFrozenRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.TotalFrozen(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,150 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/noble-assets/noble/v5/testutil/network"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/client/cli"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func networkWithFrozenAmountObjects(t *testing.T, n int) (*network.Network, []types.FrozenAmount, []sample.Account) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	addTestDenom(t, cfg, &state)
+
+	accounts := make([]sample.Account, n)
+	for i := 0; i < n; i++ {
+		account := sample.TestAccount()
+		frozenAmount := types.FrozenAmount{
+			AddressBz: account.AddressBz,
+			Amount:    sdk.NewInt64Coin(testDenom, int64(i+1)),
+		}
+		state.FrozenAmountList = append(state.FrozenAmountList, frozenAmount)
+		accounts[i] = account
+	}
+
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), state.FrozenAmountList, accounts
+}
+
+func TestShowFrozenAmount(t *testing.T) {
+	net, objs, accounts := networkWithFrozenAmountObjects(t, 2)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc    string
+		address sample.Account
+
+		args []string
+		obj  types.FrozenAmount
+	}{
+		{
+			desc:    "found",
+			address: accounts[1],
+
+			args: common,
+			obj:  objs[1],
+		},
+		{
+			desc:    "nothing frozen",
+			address: sample.TestAccount(),
+
+			args: common,
+			obj:  types.FrozenAmount{Amount: sdk.NewInt64Coin(testDenom, 0)},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			args := []string{
+				testDenom,
+				tc.address.Address,
+			}
+			args = append(args, tc.args...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowFrozenAmount(), args)
+			require.NoError(t, err)
+			var resp types.QueryGetFrozenAmountResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.Equal(t, tc.address.AddressBz, resp.FrozenAmount.AddressBz)
+			require.Equal(t, tc.obj.Amount, resp.FrozenAmount.Amount)
+		})
+	}
+}
+
+func TestListFrozenAmount(t *testing.T) {
+	net, objs, _ := networkWithFrozenAmountObjects(t, 5)
+
+	ctx := net.Validators[0].ClientCtx
+	request := func(next []byte, offset, limit uint64, total bool) []string {
+		args := []string{
+			testDenom,
+			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		}
+		if next == nil {
+			args = append(args, fmt.Sprintf("--%s=%d", flags.FlagOffset, offset))
+		} else {
+			args = append(args, fmt.Sprintf("--%s=%s", flags.FlagPageKey, next))
+		}
+		args = append(args, fmt.Sprintf("--%s=%d", flags.FlagLimit, limit))
+		if total {
+			args = append(args, fmt.Sprintf("--%s", flags.FlagCountTotal))
+		}
+		return args
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(objs); i += step {
+			args := request(nil, uint64(i), uint64(step), false)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListFrozenAmount(), args)
+			require.NoError(t, err)
+			var resp types.QueryAllFrozenAmountResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.LessOrEqual(t, len(resp.FrozenAmount), step)
+			require.Subset(t,
+				nullify.Fill(objs),
+				nullify.Fill(resp.FrozenAmount),
+			)
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		args := request(nil, 0, uint64(len(objs)), true)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListFrozenAmount(), args)
+		require.NoError(t, err)
+		var resp types.QueryAllFrozenAmountResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, len(objs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(objs),
+			nullify.Fill(resp.FrozenAmount),
+		)
+	})
+}
+
+func TestShowTotalFrozen(t *testing.T) {
+	net, _, _ := networkWithFrozenAmountObjects(t, 3)
+
+	ctx := net.Validators[0].ClientCtx
+	args := []string{testDenom, fmt.Sprintf("--%s=json", tmcli.OutputFlag)}
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowTotalFrozen(), args)
+	require.NoError(t, err)
+
+	var resp types.QueryTotalFrozenResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Equal(t, sdk.NewInt64Coin(testDenom, 6), resp.Amount)
+}
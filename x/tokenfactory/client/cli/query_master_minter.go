@@ -11,15 +11,17 @@ import (
 
 func CmdShowMasterMinter() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-master-minter",
+		Use:   "show-master-minter [denom]",
 		Short: "shows master-minter",
-		Args:  cobra.NoArgs,
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetMasterMinterRequest{}
+			params := &types.QueryGetMasterMinterRequest{
+				Denom: args[0],
+			}
 
 			res, err := queryClient.MasterMinter(context.Background(), params)
 			if err != nil {
@@ -21,13 +21,15 @@ func networkWithMasterMinterObjects(t *testing.T) (*network.Network, types.Maste
 	state := types.GenesisState{}
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
-	masterMinter := &types.MasterMinter{}
+	addTestDenom(t, cfg, &state)
+
+	masterMinter := types.MasterMinter{Denom: testDenom}
 	nullify.Fill(&masterMinter)
-	state.MasterMinter = masterMinter
+	state.MasterMinterList = append(state.MasterMinterList, masterMinter)
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
-	return network.New(t, cfg), *state.MasterMinter
+	return network.New(t, cfg), masterMinter
 }
 
 func TestShowMasterMinter(t *testing.T) {
@@ -50,7 +52,9 @@ func TestShowMasterMinter(t *testing.T) {
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			var args []string
+			args := []string{
+				testDenom,
+			}
 			args = append(args, tc.args...)
 			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowMasterMinter(), args)
 			if tc.err != nil {
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdListMintRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-mint-rate-limit [denom]",
+		Short: "list all mint-rate-limit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllMintRateLimitRequest{
+				Pagination: pageReq,
+				Denom:      args[0],
+			}
+
+			res, err := queryClient.MintRateLimitAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowMintRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-mint-rate-limit [denom] [minter-address]",
+		Short: "shows a mint-rate-limit and its remaining headroom",
+		Long:  "Shows the mint rate limit of the given minter, or the limit across all minters if no minter is given, along with the amount that can still be minted at the current block time",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argDenom := args[0]
+
+			var argMinter string
+			if len(args) > 1 {
+				argMinter = args[1]
+			}
+
+			params := &types.QueryGetMintRateLimitRequest{
+				Denom:  argDenom,
+				Minter: argMinter,
+			}
+
+			res, err := queryClient.MintRateLimit(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
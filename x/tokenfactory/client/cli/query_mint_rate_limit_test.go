@@ -0,0 +1,109 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/noble-assets/noble/v5/testutil/network"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/client/cli"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func networkWithMintRateLimitObjects(t *testing.T) (*network.Network, []types.MintRateLimit) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	addTestDenom(t, cfg, &state)
+
+	state.MintRateLimitList = append(state.MintRateLimitList,
+		types.MintRateLimit{
+			Denom:  testDenom,
+			Minter: sample.AccAddress(),
+			Window: 24 * time.Hour,
+			Limit:  sdk.NewCoin(testDenom, sdk.NewInt(50)),
+		},
+		types.MintRateLimit{
+			Denom:  testDenom,
+			Window: 24 * time.Hour,
+			Limit:  sdk.NewCoin(testDenom, sdk.NewInt(200)),
+		},
+	)
+
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), state.MintRateLimitList
+}
+
+func TestShowMintRateLimit(t *testing.T) {
+	net, objs := networkWithMintRateLimitObjects(t)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc string
+		args []string
+		err  error
+		obj  types.MintRateLimit
+	}{
+		{
+			desc: "minter",
+			args: append([]string{testDenom, objs[0].Minter}, common...),
+			obj:  objs[0],
+		},
+		{
+			desc: "all minters",
+			args: append([]string{testDenom}, common...),
+			obj:  objs[1],
+		},
+		{
+			desc: "not found",
+			args: append([]string{testDenom, sample.AccAddress()}, common...),
+			err:  status.Error(codes.NotFound, "not found"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowMintRateLimit(), tc.args)
+			if tc.err != nil {
+				stat, ok := status.FromError(tc.err)
+				require.True(t, ok)
+				require.ErrorIs(t, stat.Err(), tc.err)
+			} else {
+				require.NoError(t, err)
+				var resp types.QueryGetMintRateLimitResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.Equal(t, tc.obj.Limit, resp.Remaining)
+				require.Nil(t, resp.ResetTime)
+				require.Equal(t,
+					nullify.Fill(&tc.obj),
+					nullify.Fill(&resp.MintRateLimit),
+				)
+			}
+		})
+	}
+
+	t.Run("list", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListMintRateLimit(), append([]string{testDenom}, common...))
+		require.NoError(t, err)
+		var resp types.QueryAllMintRateLimitResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.ElementsMatch(t,
+			nullify.Fill(objs),
+			nullify.Fill(resp.MintRateLimit),
+		)
+	})
+}
@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	FlagStartDate = "start-date"
+	FlagEndDate   = "end-date"
+)
+
+func CmdShowMinterStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-minter-stats [denom] [minter]",
+		Short: "shows the amounts a minter minted and burned, over its lifetime and per day",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			startDate, endDate, err := readDateRange(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryMinterStatsRequest{
+				Pagination: pageReq,
+				Denom:      args[0],
+				Minter:     args[1],
+				StartDate:  startDate,
+				EndDate:    endDate,
+			}
+
+			res, err := queryClient.MinterStats(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addDateRangeFlags(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowModuleStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-module-stats [denom]",
+		Short: "shows the amounts minted and burned across all minters of a denom, over its lifetime and per day",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			startDate, endDate, err := readDateRange(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryModuleStatsRequest{
+				Pagination: pageReq,
+				Denom:      args[0],
+				StartDate:  startDate,
+				EndDate:    endDate,
+			}
+
+			res, err := queryClient.ModuleStats(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addDateRangeFlags(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addDateRangeFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagStartDate, "", "first day (YYYY-MM-DD) of the daily counters to show")
+	cmd.Flags().String(FlagEndDate, "", "last day (YYYY-MM-DD) of the daily counters to show")
+}
+
+func readDateRange(flagSet *pflag.FlagSet) (string, string, error) {
+	startDate, err := flagSet.GetString(FlagStartDate)
+	if err != nil {
+		return "", "", err
+	}
+
+	endDate, err := flagSet.GetString(FlagEndDate)
+	if err != nil {
+		return "", "", err
+	}
+
+	return startDate, endDate, nil
+}
@@ -0,0 +1,78 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/noble-assets/noble/v5/testutil/network"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/client/cli"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestShowMintStats(t *testing.T) {
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	addTestDenom(t, cfg, &state)
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(testDenom, amount)
+	}
+
+	minter := sample.AccAddress()
+	for _, address := range []string{minter, ""} {
+		state.MintStatsList = append(state.MintStatsList, types.MintStats{Denom: testDenom, Minter: address, Minted: coin(30), Burned: coin(10)})
+		state.DailyMintStatsList = append(state.DailyMintStatsList,
+			types.DailyMintStats{Denom: testDenom, Minter: address, Date: "2024-01-01", Minted: coin(20), Burned: coin(0)},
+			types.DailyMintStats{Denom: testDenom, Minter: address, Date: "2024-01-02", Minted: coin(10), Burned: coin(10)},
+		)
+	}
+
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	net := network.New(t, cfg)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	t.Run("minter", func(t *testing.T) {
+		args := []string{testDenom, minter, fmt.Sprintf("--%s=2024-01-02", cli.FlagStartDate)}
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowMinterStats(), append(args, common...))
+		require.NoError(t, err)
+		var resp types.QueryMinterStatsResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, state.MintStatsList[0], resp.Lifetime)
+		require.Equal(t, state.DailyMintStatsList[1:2], resp.Daily)
+		require.Equal(t, coin(10), resp.Minted)
+		require.Equal(t, coin(10), resp.Burned)
+	})
+	t.Run("module", func(t *testing.T) {
+		args := []string{testDenom, fmt.Sprintf("--%s=2024-01-01", cli.FlagEndDate)}
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowModuleStats(), append(args, common...))
+		require.NoError(t, err)
+		var resp types.QueryModuleStatsResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, state.MintStatsList[1], resp.Lifetime)
+		require.Equal(t, state.DailyMintStatsList[2:3], resp.Daily)
+		require.Equal(t, coin(20), resp.Minted)
+		require.Equal(t, coin(0), resp.Burned)
+	})
+	t.Run("invalid date", func(t *testing.T) {
+		args := []string{testDenom, fmt.Sprintf("--%s=yesterday", cli.FlagStartDate)}
+		_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowModuleStats(), append(args, common...))
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -12,8 +12,9 @@ import (
 
 func CmdListMinterController() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "list-minter-controller",
+		Use:   "list-minter-controller [denom]",
 		Short: "list all minter-controller",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
@@ -26,6 +27,7 @@ func CmdListMinterController() *cobra.Command {
 
 			params := &types.QueryAllMinterControllerRequest{
 				Pagination: pageReq,
+				Denom:      args[0],
 			}
 
 			res, err := queryClient.MinterControllerAll(context.Background(), params)
@@ -45,18 +47,22 @@ func CmdListMinterController() *cobra.Command {
 
 func CmdShowMinterController() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-minter-controller [minter-address]",
+		Use:   "show-minter-controller [denom] [controller-address] [minter-address]",
 		Short: "shows a minter-controller",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			argControllerAddress := args[0]
+			argDenom := args[0]
+			argControllerAddress := args[1]
+			argMinterAddress := args[2]
 
 			params := &types.QueryGetMinterControllerRequest{
+				Denom:             argDenom,
 				ControllerAddress: argControllerAddress,
+				MinterAddress:     argMinterAddress,
 			}
 
 			res, err := queryClient.MinterController(context.Background(), params)
@@ -72,3 +78,68 @@ func CmdShowMinterController() *cobra.Command {
 
 	return cmd
 }
+
+func CmdListMintersByController() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-minters-by-controller [denom] [controller-address]",
+		Short: "list all minters of a minter-controller",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryMintersByControllerRequest{
+				Pagination:        pageReq,
+				Denom:             args[0],
+				ControllerAddress: args[1],
+			}
+
+			res, err := queryClient.MintersByController(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowControllerByMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-controller-by-minter [denom] [minter-address]",
+		Short: "shows the minter-controller of a minter",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryControllerByMinterRequest{
+				Denom:         args[0],
+				MinterAddress: args[1],
+			}
+
+			res, err := queryClient.ControllerByMinter(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -27,9 +27,13 @@ func networkWithMinterControllerObjects(t *testing.T, n int) (*network.Network,
 	state := types.GenesisState{}
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
+	addTestDenom(t, cfg, &state)
+
 	for i := 0; i < n; i++ {
 		minterController := types.MinterController{
 			Controller: strconv.Itoa(i),
+			Minter:     strconv.Itoa(i),
+			Denom:      testDenom,
 		}
 		nullify.Fill(&minterController)
 		state.MinterControllerList = append(state.MinterControllerList, minterController)
@@ -48,23 +52,26 @@ func TestShowMinterController(t *testing.T) {
 		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 	}
 	for _, tc := range []struct {
-		desc            string
-		idMinterAddress string
+		desc                string
+		idControllerAddress string
+		idMinterAddress     string
 
 		args []string
 		err  error
 		obj  types.MinterController
 	}{
 		{
-			desc:            "found",
-			idMinterAddress: objs[0].Controller,
+			desc:                "found",
+			idControllerAddress: objs[0].Controller,
+			idMinterAddress:     objs[0].Minter,
 
 			args: common,
 			obj:  objs[0],
 		},
 		{
-			desc:            "not found",
-			idMinterAddress: strconv.Itoa(100000),
+			desc:                "not found",
+			idControllerAddress: strconv.Itoa(100000),
+			idMinterAddress:     strconv.Itoa(100000),
 
 			args: common,
 			err:  status.Error(codes.NotFound, "not found"),
@@ -72,6 +79,8 @@ func TestShowMinterController(t *testing.T) {
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			args := []string{
+				testDenom,
+				tc.idControllerAddress,
 				tc.idMinterAddress,
 			}
 			args = append(args, tc.args...)
@@ -100,6 +109,7 @@ func TestListMinterController(t *testing.T) {
 	ctx := net.Validators[0].ClientCtx
 	request := func(next []byte, offset, limit uint64, total bool) []string {
 		args := []string{
+			testDenom,
 			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 		}
 		if next == nil {
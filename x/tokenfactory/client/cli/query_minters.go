@@ -11,8 +11,9 @@ import (
 
 func CmdListMinters() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "list-minters",
+		Use:   "list-minters [denom]",
 		Short: "list all minters",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
@@ -25,6 +26,7 @@ func CmdListMinters() *cobra.Command {
 
 			params := &types.QueryAllMintersRequest{
 				Pagination: pageReq,
+				Denom:      args[0],
 			}
 
 			res, err := queryClient.MintersAll(context.Background(), params)
@@ -44,17 +46,19 @@ func CmdListMinters() *cobra.Command {
 
 func CmdShowMinters() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-minters [address]",
+		Use:   "show-minters [denom] [address]",
 		Short: "shows a minters",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			argAddress := args[0]
+			argDenom := args[0]
+			argAddress := args[1]
 
 			params := &types.QueryGetMintersRequest{
+				Denom:   argDenom,
 				Address: argAddress,
 			}
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
@@ -27,9 +28,16 @@ func networkWithMintersObjects(t *testing.T, n int) (*network.Network, []types.M
 	state := types.GenesisState{}
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
+	addTestDenom(t, cfg, &state)
+
 	for i := 0; i < n; i++ {
 		minters := types.Minters{
 			Address: strconv.Itoa(i),
+			Denom:   testDenom,
+		}
+		if i%2 == 0 {
+			expiry := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+			minters.Expiry = &expiry
 		}
 		nullify.Fill(&minters)
 		state.MintersList = append(state.MintersList, minters)
@@ -72,6 +80,7 @@ func TestShowMinters(t *testing.T) {
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			args := []string{
+				testDenom,
 				tc.idAddress,
 			}
 			args = append(args, tc.args...)
@@ -100,6 +109,7 @@ func TestListMinters(t *testing.T) {
 	ctx := net.Validators[0].ClientCtx
 	request := func(next []byte, offset, limit uint64, total bool) []string {
 		args := []string{
+			testDenom,
 			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 		}
 		if next == nil {
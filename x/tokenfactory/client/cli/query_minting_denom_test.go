@@ -8,6 +8,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/noble-assets/noble/v5/testutil/network"
@@ -16,32 +17,40 @@ import (
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 )
 
-func networkWithMintingDenomObjects(t *testing.T) (*network.Network, types.MintingDenom) {
-	t.Helper()
-	cfg := network.DefaultConfig()
-	state := types.GenesisState{}
-	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+const testDenom = "test"
 
-	testDenom := "test"
+// addTestDenom registers testDenom as a minting denom in the tokenfactory genesis state and its metadata in the bank genesis.
+func addTestDenom(t *testing.T, cfg network.Config, state *types.GenesisState) {
+	t.Helper()
 
-	state.MintingDenom = &types.MintingDenom{
+	state.MintingDenomList = append(state.MintingDenomList, types.MintingDenom{
 		Denom: testDenom,
-	}
+	})
 
-	bankState := banktypes.DefaultGenesisState()
-	bankState.DenomMetadata = []banktypes.Metadata{{
+	bankState := banktypes.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[banktypes.ModuleName], &bankState))
+	bankState.DenomMetadata = append(bankState.DenomMetadata, banktypes.Metadata{
 		Base: testDenom,
-	}}
+	})
 
-	buf, err := cfg.Codec.MarshalJSON(bankState)
+	buf, err := cfg.Codec.MarshalJSON(&bankState)
 	require.NoError(t, err)
 	cfg.GenesisState[banktypes.ModuleName] = buf
+}
 
-	buf, err = cfg.Codec.MarshalJSON(&state)
+func networkWithMintingDenomObjects(t *testing.T) (*network.Network, types.MintingDenom) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	addTestDenom(t, cfg, &state)
+
+	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
 
-	return network.New(t, cfg), *state.MintingDenom
+	return network.New(t, cfg), state.MintingDenomList[0]
 }
 
 func TestShowMintingDenom(t *testing.T) {
@@ -52,19 +61,29 @@ func TestShowMintingDenom(t *testing.T) {
 		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 	}
 	for _, tc := range []struct {
-		desc string
-		args []string
-		err  error
-		obj  types.MintingDenom
+		desc  string
+		denom string
+		args  []string
+		err   error
+		obj   types.MintingDenom
 	}{
 		{
-			desc: "get",
-			args: common,
-			obj:  obj,
+			desc:  "get",
+			denom: obj.Denom,
+			args:  common,
+			obj:   obj,
+		},
+		{
+			desc:  "not found",
+			denom: "unknown",
+			args:  common,
+			err:   status.Error(codes.NotFound, "not found"),
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			var args []string
+			args := []string{
+				tc.denom,
+			}
 			args = append(args, tc.args...)
 			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowMintingDenom(), args)
 			if tc.err != nil {
@@ -83,4 +102,12 @@ func TestShowMintingDenom(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("list", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListMintingDenom(), common)
+		require.NoError(t, err)
+		var resp types.QueryAllMintingDenomResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, []types.MintingDenom{obj}, resp.MintingDenom)
+	})
 }
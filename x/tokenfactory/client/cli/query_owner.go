@@ -11,15 +11,17 @@ import (
 
 func CmdShowOwner() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-owner",
+		Use:   "show-owner [denom]",
 		Short: "shows owner",
-		Args:  cobra.NoArgs,
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetOwnerRequest{}
+			params := &types.QueryGetOwnerRequest{
+				Denom: args[0],
+			}
 
 			res, err := queryClient.Owner(context.Background(), params)
 			if err != nil {
@@ -21,13 +21,15 @@ func networkWithOwnerObjects(t *testing.T) (*network.Network, types.Owner) {
 	state := types.GenesisState{}
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
-	owner := &types.Owner{}
+	addTestDenom(t, cfg, &state)
+
+	owner := types.Owner{Denom: testDenom}
 	nullify.Fill(&owner)
-	state.Owner = owner
+	state.OwnerList = append(state.OwnerList, owner)
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
-	return network.New(t, cfg), *state.Owner
+	return network.New(t, cfg), owner
 }
 
 func TestShowOwner(t *testing.T) {
@@ -50,7 +52,9 @@ func TestShowOwner(t *testing.T) {
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			var args []string
+			args := []string{
+				testDenom,
+			}
 			args = append(args, tc.args...)
 			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowOwner(), args)
 			if tc.err != nil {
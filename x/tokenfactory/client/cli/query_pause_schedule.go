@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdListPauseSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pause-schedule [denom]",
+		Short: "list all pending and active pause-schedule of a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllPauseScheduleRequest{
+				Pagination: pageReq,
+				Denom:      args[0],
+			}
+
+			res, err := queryClient.PauseScheduleAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowPauseSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-pause-schedule [denom] [id]",
+		Short: "shows a pause-schedule",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argDenom := args[0]
+			argId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetPauseScheduleRequest{
+				Denom: argDenom,
+				Id:    argId,
+			}
+
+			res, err := queryClient.PauseSchedule(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
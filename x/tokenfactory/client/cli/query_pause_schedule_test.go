@@ -0,0 +1,110 @@
+package cli_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/noble-assets/noble/v5/testutil/network"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/client/cli"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func networkWithPauseScheduleObjects(t *testing.T) (*network.Network, []types.PauseSchedule) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	addTestDenom(t, cfg, &state)
+
+	startTime := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(time.Hour)
+
+	state.PauseScheduleList = append(state.PauseScheduleList,
+		types.PauseSchedule{
+			Denom:       testDenom,
+			Id:          0,
+			Operations:  []types.PauseOperation{types.PauseMint},
+			StartHeight: 1_000_000,
+			EndHeight:   2_000_000,
+		},
+		types.PauseSchedule{
+			Denom:     testDenom,
+			Id:        1,
+			StartTime: &startTime,
+			EndTime:   &endTime,
+		},
+	)
+	state.PauseScheduleCount = 2
+
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), state.PauseScheduleList
+}
+
+func TestShowPauseSchedule(t *testing.T) {
+	net, objs := networkWithPauseScheduleObjects(t)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc string
+		id   string
+		err  error
+		obj  types.PauseSchedule
+	}{
+		{
+			desc: "by height",
+			id:   "0",
+			obj:  objs[0],
+		},
+		{
+			desc: "by time",
+			id:   "1",
+			obj:  objs[1],
+		},
+		{
+			desc: "not found",
+			id:   strconv.Itoa(100),
+			err:  status.Error(codes.NotFound, "not found"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowPauseSchedule(), append([]string{testDenom, tc.id}, common...))
+			if tc.err != nil {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			} else {
+				require.NoError(t, err)
+				var resp types.QueryGetPauseScheduleResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.Equal(t,
+					nullify.Fill(&tc.obj),
+					nullify.Fill(&resp.PauseSchedule),
+				)
+			}
+		})
+	}
+
+	t.Run("list", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListPauseSchedule(), append([]string{testDenom}, common...))
+		require.NoError(t, err)
+		var resp types.QueryAllPauseScheduleResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t,
+			nullify.Fill(objs),
+			nullify.Fill(resp.PauseSchedule),
+		)
+	})
+}
@@ -11,15 +11,17 @@ import (
 
 func CmdShowPaused() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-paused",
+		Use:   "show-paused [denom]",
 		Short: "shows paused",
-		Args:  cobra.NoArgs,
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetPausedRequest{}
+			params := &types.QueryGetPausedRequest{
+				Denom: args[0],
+			}
 
 			res, err := queryClient.Paused(context.Background(), params)
 			if err != nil {
@@ -21,13 +21,15 @@ func networkWithPausedObjects(t *testing.T) (*network.Network, types.Paused) {
 	state := types.GenesisState{}
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
-	paused := &types.Paused{}
+	addTestDenom(t, cfg, &state)
+
+	paused := types.Paused{Denom: testDenom}
 	nullify.Fill(&paused)
-	state.Paused = paused
+	state.PausedList = append(state.PausedList, paused)
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
-	return network.New(t, cfg), *state.Paused
+	return network.New(t, cfg), paused
 }
 
 func TestShowPaused(t *testing.T) {
@@ -50,7 +52,9 @@ func TestShowPaused(t *testing.T) {
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			var args []string
+			args := []string{
+				testDenom,
+			}
 			args = append(args, tc.args...)
 			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowPaused(), args)
 			if tc.err != nil {
@@ -11,15 +11,17 @@ import (
 
 func CmdShowPauser() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-pauser",
+		Use:   "show-pauser [denom]",
 		Short: "shows pauser",
-		Args:  cobra.NoArgs,
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetPauserRequest{}
+			params := &types.QueryGetPauserRequest{
+				Denom: args[0],
+			}
 
 			res, err := queryClient.Pauser(context.Background(), params)
 			if err != nil {
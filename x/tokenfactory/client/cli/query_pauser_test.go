@@ -21,13 +21,15 @@ func networkWithPauserObjects(t *testing.T) (*network.Network, types.Pauser) {
 	state := types.GenesisState{}
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
-	pauser := &types.Pauser{}
+	addTestDenom(t, cfg, &state)
+
+	pauser := types.Pauser{Denom: testDenom}
 	nullify.Fill(&pauser)
-	state.Pauser = pauser
+	state.PauserList = append(state.PauserList, pauser)
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
-	return network.New(t, cfg), *state.Pauser
+	return network.New(t, cfg), pauser
 }
 
 func TestShowPauser(t *testing.T) {
@@ -50,7 +52,9 @@ func TestShowPauser(t *testing.T) {
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			var args []string
+			args := []string{
+				testDenom,
+			}
 			args = append(args, tc.args...)
 			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowPauser(), args)
 			if tc.err != nil {
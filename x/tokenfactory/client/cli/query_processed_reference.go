@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowProcessedReference() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-processed-reference [denom] [address] [mint|burn] [reference-id]",
+		Short: "shows the height and time at which a minter processed a mint or burn with a reference id",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			operation, err := types.ParseReferenceOperation(args[2])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetProcessedReferenceRequest{
+				Denom:       args[0],
+				Address:     args[1],
+				Operation:   operation,
+				ReferenceId: args[3],
+			}
+
+			res, err := queryClient.ProcessedReference(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,62 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/noble-assets/noble/v5/testutil/network"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/client/cli"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestShowProcessedReference(t *testing.T) {
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	addTestDenom(t, cfg, &state)
+
+	// the reference is recent so that it is not pruned while the network runs
+	reference := types.ProcessedReference{
+		Denom:       testDenom,
+		Address:     sample.AccAddress(),
+		ReferenceID: "deposit-1",
+		Height:      1,
+		Time:        time.Now().UTC().Truncate(time.Second),
+		Operation:   types.ReferenceBurn,
+	}
+	state.ProcessedReferenceList = append(state.ProcessedReferenceList, reference)
+
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	net := network.New(t, cfg)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	t.Run("found", func(t *testing.T) {
+		args := []string{reference.Denom, reference.Address, "burn", reference.ReferenceID}
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowProcessedReference(), append(args, common...))
+		require.NoError(t, err)
+		var resp types.QueryGetProcessedReferenceResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, reference, resp.ProcessedReference)
+	})
+	t.Run("not found", func(t *testing.T) {
+		args := []string{reference.Denom, reference.Address, "mint", reference.ReferenceID}
+		_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowProcessedReference(), append(args, common...))
+		require.Error(t, err)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
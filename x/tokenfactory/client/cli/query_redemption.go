@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagRequester = "requester"
+	FlagStatus    = "status"
+)
+
+func CmdListRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-redemption",
+		Short: "list redemption requests",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			requester, err := cmd.Flags().GetString(FlagRequester)
+			if err != nil {
+				return err
+			}
+			status, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllRedemptionRequest{
+				Pagination: pageReq,
+				Requester:  requester,
+			}
+
+			if status != "" {
+				params.Status, err = types.ParseRedemptionStatus(status)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RedemptionAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagRequester, "", "only list redemptions of this requester")
+	cmd.Flags().String(FlagStatus, "", "only list redemptions with this status, e.g. pending, held, fulfilled, rejected or expired")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-redemption [id]",
+		Short: "shows a redemption request",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetRedemptionRequest{
+				Id: argId,
+			}
+
+			res, err := queryClient.Redemption(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,142 @@
+package cli_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/noble-assets/noble/v5/testutil/network"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/client/cli"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func networkWithRedemptionObjects(t *testing.T) (*network.Network, []types.Redemption) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	addTestDenom(t, cfg, &state)
+
+	// resolved redemptions do not escrow tokens, which the module account would have to hold
+	requestTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	requester, minter := sample.AccAddress(), sample.AccAddress()
+	for i, status := range []types.RedemptionStatus{
+		types.RedemptionStatusFulfilled,
+		types.RedemptionStatusRejected,
+		types.RedemptionStatusFulfilled,
+	} {
+		state.RedemptionList = append(state.RedemptionList, types.Redemption{
+			Id:                 uint64(i),
+			Requester:          requester,
+			Amount:             sdk.NewInt64Coin(testDenom, int64(i+1)),
+			PayoutInstructions: "iban",
+			Status:             status,
+			RequestTime:        requestTime,
+			Resolver:           minter,
+			ResolveTime:        &requestTime,
+		})
+	}
+	state.RedemptionList[2].Requester = sample.AccAddress()
+	state.RedemptionCount = 3
+	// keep the resolved redemptions, which were resolved long before the retention of the network
+	state.Params.RedemptionRetention = 0
+
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), state.RedemptionList
+}
+
+func TestShowRedemption(t *testing.T) {
+	net, objs := networkWithRedemptionObjects(t)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc string
+		id   string
+		err  error
+		obj  types.Redemption
+	}{
+		{
+			desc: "found",
+			id:   "1",
+			obj:  objs[1],
+		},
+		{
+			desc: "not found",
+			id:   strconv.Itoa(100),
+			err:  status.Error(codes.NotFound, "not found"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowRedemption(), append([]string{tc.id}, common...))
+			if tc.err != nil {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			} else {
+				require.NoError(t, err)
+				var resp types.QueryGetRedemptionResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.Equal(t,
+					nullify.Fill(&tc.obj),
+					nullify.Fill(&resp.Redemption),
+				)
+			}
+		})
+	}
+
+	for _, tc := range []struct {
+		desc string
+		args []string
+		objs []types.Redemption
+	}{
+		{
+			desc: "all",
+			objs: objs,
+		},
+		{
+			desc: "requester",
+			args: []string{fmt.Sprintf("--%s=%s", cli.FlagRequester, objs[0].Requester)},
+			objs: objs[:2],
+		},
+		{
+			desc: "status",
+			args: []string{fmt.Sprintf("--%s=fulfilled", cli.FlagStatus)},
+			objs: []types.Redemption{objs[0], objs[2]},
+		},
+		{
+			desc: "requester and status",
+			args: []string{fmt.Sprintf("--%s=%s", cli.FlagRequester, objs[0].Requester), fmt.Sprintf("--%s=rejected", cli.FlagStatus)},
+			objs: objs[1:2],
+		},
+	} {
+		t.Run("list "+tc.desc, func(t *testing.T) {
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListRedemption(), append(tc.args, common...))
+			require.NoError(t, err)
+			var resp types.QueryAllRedemptionResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.ElementsMatch(t,
+				nullify.Fill(tc.objs),
+				nullify.Fill(resp.Redemption),
+			)
+		})
+	}
+
+	t.Run("list unknown status", func(t *testing.T) {
+		_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListRedemption(), append([]string{fmt.Sprintf("--%s=unknown", cli.FlagStatus)}, common...))
+		require.Error(t, err)
+	})
+}
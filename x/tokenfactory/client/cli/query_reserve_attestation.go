@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdListReserveAttestation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-reserve-attestation [denom]",
+		Short: "list the reserve attestations of a denom, oldest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllReserveAttestationRequest{
+				Pagination: pageReq,
+				Denom:      args[0],
+			}
+
+			res, err := queryClient.ReserveAttestationAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowReserveAttestation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-reserve-attestation [denom] [id]",
+		Short: "shows a reserve attestation",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetReserveAttestationRequest{
+				Denom: args[0],
+				Id:    id,
+			}
+
+			res, err := queryClient.ReserveAttestation(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowCollateralizationRatio() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-collateralization-ratio [denom]",
+		Short: "shows the latest attested reserves of a denom against its current supply",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryCollateralizationRatioRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.CollateralizationRatio(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
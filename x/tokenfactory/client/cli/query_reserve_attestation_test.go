@@ -0,0 +1,80 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/noble-assets/noble/v5/testutil/network"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/client/cli"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestReserveAttestation(t *testing.T) {
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	addTestDenom(t, cfg, &state)
+
+	attestor := sample.AccAddress()
+	timestamp := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 2; i++ {
+		state.ReserveAttestationList = append(state.ReserveAttestationList, types.ReserveAttestation{
+			Id:         uint64(i),
+			Attestor:   attestor,
+			Reserves:   sdk.NewInt64Coin(testDenom, int64(100*(i+1))),
+			Timestamp:  timestamp.AddDate(0, i, 0),
+			Height:     1,
+			SubmitTime: timestamp.AddDate(0, i, 0),
+		})
+	}
+	state.ReserveAttestationCount = 2
+
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	net := network.New(t, cfg)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	t.Run("list", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListReserveAttestation(), append([]string{testDenom}, common...))
+		require.NoError(t, err)
+		var resp types.QueryAllReserveAttestationResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, state.ReserveAttestationList, resp.ReserveAttestation)
+	})
+	t.Run("show", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowReserveAttestation(), append([]string{testDenom, "1"}, common...))
+		require.NoError(t, err)
+		var resp types.QueryGetReserveAttestationResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, state.ReserveAttestationList[1], resp.ReserveAttestation)
+	})
+	t.Run("show not found", func(t *testing.T) {
+		_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowReserveAttestation(), append([]string{testDenom, "2"}, common...))
+		require.Error(t, err)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("collateralization ratio", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowCollateralizationRatio(), append([]string{testDenom}, common...))
+		require.NoError(t, err)
+		var resp types.QueryCollateralizationRatioResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, state.ReserveAttestationList[1], resp.ReserveAttestation)
+		require.Equal(t, sdk.NewInt64Coin(testDenom, 0), resp.Supply)
+		require.True(t, resp.Ratio.IsZero())
+	})
+}
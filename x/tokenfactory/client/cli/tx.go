@@ -18,21 +18,48 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdCreateDenom())
 	cmd.AddCommand(CmdUpdateMasterMinter())
 	cmd.AddCommand(CmdUpdatePauser())
+	cmd.AddCommand(CmdUpdateAttestor())
+	cmd.AddCommand(CmdUpdateAllowlister())
+	cmd.AddCommand(CmdSetAllowlistMode())
+	cmd.AddCommand(CmdAllow())
+	cmd.AddCommand(CmdDisallow())
 	cmd.AddCommand(CmdUpdateBlacklister())
 	cmd.AddCommand(CmdUpdateOwner())
 	cmd.AddCommand(CmdAcceptOwner())
 	cmd.AddCommand(CmdConfigureMinter())
+	cmd.AddCommand(CmdIncreaseMinterAllowance())
+	cmd.AddCommand(CmdDecreaseMinterAllowance())
 	cmd.AddCommand(CmdRemoveMinter())
 	cmd.AddCommand(CmdMint())
+	cmd.AddCommand(CmdGrantMintAuthorization())
 	cmd.AddCommand(CmdBurn())
 	cmd.AddCommand(CmdBlacklist())
 	cmd.AddCommand(CmdUnblacklist())
+	cmd.AddCommand(CmdFreezeAmount())
+	cmd.AddCommand(CmdUnfreezeAmount())
+	cmd.AddCommand(CmdBlacklistFromFile())
+	cmd.AddCommand(CmdWipeBlacklistedBalance())
 	cmd.AddCommand(CmdPause())
 	cmd.AddCommand(CmdUnpause())
+	cmd.AddCommand(CmdSchedulePause())
+	cmd.AddCommand(CmdCancelPauseSchedule())
 	cmd.AddCommand(CmdConfigureMinterController())
 	cmd.AddCommand(CmdRemoveMinterController())
+	cmd.AddCommand(CmdSetMintRateLimit())
+	cmd.AddCommand(CmdRemoveMintRateLimit())
+	cmd.AddCommand(CmdSetMaxSupply())
+	cmd.AddCommand(CmdSetAdminQuorum())
+	cmd.AddCommand(CmdSubmitAdminProposal())
+	cmd.AddCommand(CmdApproveAdminProposal())
+	cmd.AddCommand(CmdRejectAdminProposal())
+	cmd.AddCommand(CmdRequestRedemption())
+	cmd.AddCommand(CmdFulfillRedemption())
+	cmd.AddCommand(CmdRejectRedemption())
+	cmd.AddCommand(CmdAttestReserves())
+	cmd.AddCommand(CmdUpdateDenomMetadata())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -14,10 +14,11 @@ var _ = strconv.Itoa(0)
 
 func CmdAcceptOwner() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "accept-owner [address]",
+		Use:   "accept-owner [denom]",
 		Short: "Broadcast message accept-owner",
-		Args:  cobra.NoArgs,
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -26,6 +27,7 @@ func CmdAcceptOwner() *cobra.Command {
 
 			msg := types.NewMsgAcceptOwner(
 				clientCtx.GetFromAddress().String(),
+				argDenom,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
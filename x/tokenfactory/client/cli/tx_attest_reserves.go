@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdAttestReserves() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-reserves [reserves] [timestamp]",
+		Short: "Broadcast message attest-reserves",
+		Long:  "Posts the fiat reserves backing a minting denom, in units of the denom, as attested at an RFC3339 timestamp",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argReserves, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			argTimestamp, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAttestReserves(
+				clientCtx.GetFromAddress().String(),
+				argReserves,
+				argTimestamp,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -10,15 +11,44 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagReason = "reason"
+	FlagCaseID = "case-id"
+	FlagExpiry = "expiry"
+)
+
 var _ = strconv.Itoa(0)
 
 func CmdBlacklist() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "blacklist [address]",
+		Use:   "blacklist [denom] [address]",
 		Short: "Broadcast message blacklist",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			argAddress := args[0]
+			argDenom := args[0]
+			argAddress := args[1]
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+			caseID, err := cmd.Flags().GetString(FlagCaseID)
+			if err != nil {
+				return err
+			}
+			expiry, err := cmd.Flags().GetString(FlagExpiry)
+			if err != nil {
+				return err
+			}
+
+			var argExpiry *time.Time
+			if expiry != "" {
+				t, err := time.Parse(time.RFC3339, expiry)
+				if err != nil {
+					return err
+				}
+				argExpiry = &t
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -28,12 +58,19 @@ func CmdBlacklist() *cobra.Command {
 			msg := types.NewMsgBlacklist(
 				clientCtx.GetFromAddress().String(),
 				argAddress,
+				argDenom,
+				reason,
+				caseID,
+				argExpiry,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReason, "", "reason the address is blacklisted, e.g. sanctions, court_order or fraud")
+	cmd.Flags().String(FlagCaseID, "", "compliance case that requires the address to be blacklisted")
+	cmd.Flags().String(FlagExpiry, "", "RFC3339 time after which the address is automatically unblacklisted")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
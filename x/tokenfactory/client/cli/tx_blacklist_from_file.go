@@ -0,0 +1,371 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagIdempotent  = "idempotent"
+	FlagBatchSize   = "batch-size"
+	FlagMaxBatchGas = "max-batch-gas"
+
+	defaultBatchSize = 100
+)
+
+func CmdBlacklistFromFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blacklist-from-file [denom] [file]",
+		Short: "Blacklist every address listed in a JSON or CSV file",
+		Long: "Reads a list of addresses from a JSON file, holding an array of addresses or of objects with an address field, " +
+			"or from a CSV file, holding an address in its first column, and blacklists them in transactions of at most " +
+			"--batch-size addresses each. Every batch is simulated before it is broadcast, and shrunk until its gas estimate " +
+			"stays under --max-batch-gas, which defaults to the block gas limit. Batches are broadcast one after the other, " +
+			"and the command stops at the first batch that fails. With --generate-only, batches are only sized by --batch-size",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+
+			addresses, err := readAddressFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+			caseID, err := cmd.Flags().GetString(FlagCaseID)
+			if err != nil {
+				return err
+			}
+			expiry, err := cmd.Flags().GetString(FlagExpiry)
+			if err != nil {
+				return err
+			}
+			idempotent, err := cmd.Flags().GetBool(FlagIdempotent)
+			if err != nil {
+				return err
+			}
+			batchSize, err := cmd.Flags().GetInt(FlagBatchSize)
+			if err != nil {
+				return err
+			}
+			if batchSize <= 0 || batchSize > types.MaxBatchSize {
+				return fmt.Errorf("batch size must be between 1 and %d", types.MaxBatchSize)
+			}
+			maxBatchGas, err := cmd.Flags().GetUint64(FlagMaxBatchGas)
+			if err != nil {
+				return err
+			}
+
+			var argExpiry *time.Time
+			if expiry != "" {
+				t, err := time.Parse(time.RFC3339, expiry)
+				if err != nil {
+					return err
+				}
+				argExpiry = &t
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			newBatch := func(batch []string) (*types.MsgBlacklistBatch, error) {
+				msg := types.NewMsgBlacklistBatch(
+					clientCtx.GetFromAddress().String(),
+					batch,
+					argDenom,
+					reason,
+					caseID,
+					argExpiry,
+					idempotent,
+				)
+				return msg, msg.ValidateBasic()
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+
+			// generated transactions are signed and broadcast later, so there is no node to size them against
+			if clientCtx.GenerateOnly {
+				for start := 0; start < len(addresses); start += batchSize {
+					end := start + batchSize
+					if end > len(addresses) {
+						end = len(addresses)
+					}
+
+					msg, err := newBatch(addresses[start:end])
+					if err != nil {
+						return err
+					}
+					if err := tx.GenerateTx(clientCtx, txf, msg); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			}
+
+			// a failed batch can not be detected without waiting for at least its CheckTx result
+			if clientCtx.BroadcastMode == flags.BroadcastAsync {
+				return fmt.Errorf("%s broadcast mode is not supported, use %s or %s", flags.BroadcastAsync, flags.BroadcastSync, flags.BroadcastBlock)
+			}
+
+			if maxBatchGas == 0 {
+				maxBatchGas, err = blockMaxGas(clientCtx)
+				if err != nil {
+					return err
+				}
+			}
+
+			txf, err = txf.Prepare(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			for start, batch := 0, 1; start < len(addresses); batch++ {
+				end := start + batchSize
+				if end > len(addresses) {
+					end = len(addresses)
+				}
+
+				msg, gas, err := sizeBatch(clientCtx, txf, addresses, start, end, maxBatchGas, newBatch)
+				if err != nil {
+					return fmt.Errorf("failed to simulate batch %d: %w", batch, err)
+				}
+
+				if clientCtx.Simulate {
+					_, _ = fmt.Fprintf(os.Stderr, "batch %d: %d addresses, %s\n", batch, len(msg.Addresses), tx.GasEstimateResponse{GasEstimate: gas})
+					start += len(msg.Addresses)
+					continue
+				}
+
+				batchTxf := txf
+				if txf.SimulateAndExecute() {
+					batchTxf = txf.WithGas(gas)
+				}
+
+				res, err := signAndBroadcast(clientCtx, batchTxf, msg)
+				if err != nil {
+					return fmt.Errorf("failed to submit batch %d: %w", batch, err)
+				}
+				if res.Code != 0 {
+					return fmt.Errorf("batch %d failed with code %d, %d of %d addresses were submitted: %s", batch, res.Code, start, len(addresses), res.RawLog)
+				}
+
+				// the next batch is signed with the next sequence, which the node expects once this
+				// batch passed CheckTx, so that it does not have to wait for the batch to be included
+				txf = txf.WithSequence(txf.Sequence() + 1)
+				start += len(msg.Addresses)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "reason the addresses are blacklisted, e.g. sanctions, court_order or fraud")
+	cmd.Flags().String(FlagCaseID, "", "compliance case that requires the addresses to be blacklisted")
+	cmd.Flags().String(FlagExpiry, "", "RFC3339 time after which the addresses are automatically unblacklisted")
+	cmd.Flags().Bool(FlagIdempotent, false, "skip addresses that are already blacklisted instead of failing the batch")
+	cmd.Flags().Int(FlagBatchSize, defaultBatchSize, "maximum number of addresses per transaction")
+	cmd.Flags().Uint64(FlagMaxBatchGas, 0, "maximum estimated gas per transaction, the block gas limit if zero")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// sizeBatch simulates a batch of the addresses from start to end, and shrinks it in proportion to
+// its gas estimate until the estimate, including the gas adjustment, is at most maxGas. No limit
+// applies if maxGas is zero. It returns the batch and its gas estimate.
+func sizeBatch(
+	clientCtx client.Context,
+	txf tx.Factory,
+	addresses []string,
+	start, end int,
+	maxGas uint64,
+	newBatch func([]string) (*types.MsgBlacklistBatch, error),
+) (*types.MsgBlacklistBatch, uint64, error) {
+	for {
+		msg, err := newBatch(addresses[start:end])
+		if err != nil {
+			return nil, 0, err
+		}
+
+		_, gas, err := tx.CalculateGas(clientCtx, txf, msg)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		size := uint64(end - start)
+		if maxGas == 0 || gas <= maxGas {
+			return msg, gas, nil
+		}
+		if size == 1 {
+			return nil, 0, fmt.Errorf("blacklisting %s alone needs %d gas, more than the maximum of %d", addresses[start], gas, maxGas)
+		}
+
+		shrunk := size * maxGas / gas
+		if shrunk >= size {
+			shrunk = size - 1
+		}
+		if shrunk == 0 {
+			shrunk = 1
+		}
+		end = start + int(shrunk)
+	}
+}
+
+// blockMaxGas returns the block gas limit of the node, which is zero if there is no limit.
+func blockMaxGas(clientCtx client.Context) (uint64, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := node.ConsensusParams(context.Background(), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if res.ConsensusParams.Block.MaxGas <= 0 {
+		return 0, nil
+	}
+
+	return uint64(res.ConsensusParams.Block.MaxGas), nil
+}
+
+// signAndBroadcast signs and broadcasts a transaction like tx.BroadcastTx, using the gas of the
+// factory as is, but returns the response so that a failed CheckTx can be detected.
+func signAndBroadcast(clientCtx client.Context, txf tx.Factory, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	unsignedTx, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !clientCtx.SkipConfirm {
+		out, err := clientCtx.TxConfig.TxJSONEncoder()(unsignedTx.GetTx())
+		if err != nil {
+			return nil, err
+		}
+
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
+
+		buf := bufio.NewReader(os.Stdin)
+		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf, os.Stderr)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errors.New("cancelled transaction")
+		}
+	}
+
+	unsignedTx.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+	if err := tx.Sign(txf, clientCtx.GetFromName(), unsignedTx, true); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(unsignedTx.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, clientCtx.PrintProto(res)
+}
+
+// readAddressFile reads the addresses listed in a JSON or CSV file, depending on its extension.
+func readAddressFile(path string) ([]string, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []string
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		addresses, err = parseAddressJSON(bz)
+	case ".csv":
+		addresses, err = parseAddressCSV(bz)
+	default:
+		return nil, fmt.Errorf("unsupported file extension %q, expected .json or .csv", filepath.Ext(path))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("no addresses found in %s", path)
+	}
+
+	return addresses, nil
+}
+
+// parseAddressJSON parses an array of addresses, or an array of objects with an address field.
+func parseAddressJSON(bz []byte) ([]string, error) {
+	var addresses []string
+	if err := json.Unmarshal(bz, &addresses); err == nil {
+		return addresses, nil
+	}
+
+	var entries []struct {
+		Address string `json:"address"`
+	}
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return nil, fmt.Errorf("expected an array of addresses or of objects with an address field: %w", err)
+	}
+
+	for _, entry := range entries {
+		addresses = append(addresses, entry.Address)
+	}
+
+	return addresses, nil
+}
+
+// parseAddressCSV parses the first column of every row, skipping an optional header row.
+func parseAddressCSV(bz []byte) ([]string, error) {
+	reader := csv.NewReader(strings.NewReader(string(bz)))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []string
+	for i, record := range records {
+		if len(record) == 0 || record[0] == "" {
+			continue
+		}
+		if i == 0 && strings.EqualFold(record[0], "address") {
+			continue
+		}
+
+		addresses = append(addresses, strings.TrimSpace(record[0]))
+	}
+
+	return addresses, nil
+}
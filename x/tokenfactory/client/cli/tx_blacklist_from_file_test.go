@@ -0,0 +1,79 @@
+package cli_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/noble/v5/testutil/network"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/client/cli"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestBlacklistFromFile(t *testing.T) {
+	net := network.New(t, network.DefaultConfig())
+	ctx := net.Validators[0].ClientCtx
+	dir := t.TempDir()
+
+	addresses := make([]string, 5)
+	for i := range addresses {
+		addresses[i] = sample.AccAddress()
+	}
+
+	jsonFile := filepath.Join(dir, "addresses.json")
+	require.NoError(t, os.WriteFile(jsonFile, []byte(`["`+strings.Join(addresses, `","`)+`"]`), 0o600))
+
+	csvFile := filepath.Join(dir, "addresses.csv")
+	require.NoError(t, os.WriteFile(csvFile, []byte("address,reason\n"+strings.Join(addresses, ",sanctions\n")+",sanctions\n"), 0o600))
+
+	for _, file := range []string{jsonFile, csvFile} {
+		t.Run(filepath.Ext(file), func(t *testing.T) {
+			args := []string{
+				testDenom,
+				file,
+				fmt.Sprintf("--%s=2", cli.FlagBatchSize),
+				fmt.Sprintf("--%s", cli.FlagIdempotent),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, net.Validators[0].Address),
+				fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+			}
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdBlacklistFromFile(), args)
+			require.NoError(t, err)
+
+			var batched []string
+			lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+			require.Len(t, lines, 3)
+			for _, line := range lines {
+				tx, err := ctx.TxConfig.TxJSONDecoder()([]byte(line))
+				require.NoError(t, err)
+				require.Len(t, tx.GetMsgs(), 1)
+
+				msg, ok := tx.GetMsgs()[0].(*types.MsgBlacklistBatch)
+				require.True(t, ok)
+				require.True(t, msg.Idempotent)
+				batched = append(batched, msg.Addresses...)
+			}
+			require.Equal(t, addresses, batched)
+		})
+	}
+
+	t.Run("unsupported extension", func(t *testing.T) {
+		file := filepath.Join(dir, "addresses.txt")
+		require.NoError(t, os.WriteFile(file, []byte(addresses[0]), 0o600))
+
+		args := []string{
+			testDenom,
+			file,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, net.Validators[0].Address),
+			fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+		}
+		_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdBlacklistFromFile(), args)
+		require.Error(t, err)
+	})
+}
@@ -24,6 +24,11 @@ func CmdBurn() *cobra.Command {
 				return err
 			}
 
+			referenceID, err := cmd.Flags().GetString(FlagReferenceID)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -33,11 +38,13 @@ func CmdBurn() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				argAmount,
 			)
+			msg.ReferenceID = referenceID
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReferenceID, "", "off-chain reference, e.g. of a redemption, that makes a retry of the burn fail instead of burning twice")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
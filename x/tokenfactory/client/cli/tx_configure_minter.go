@@ -2,6 +2,7 @@ package cli
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -25,6 +26,20 @@ func CmdConfigureMinter() *cobra.Command {
 				return err
 			}
 
+			expiry, err := cmd.Flags().GetString(FlagExpiry)
+			if err != nil {
+				return err
+			}
+
+			var argExpiry *time.Time
+			if expiry != "" {
+				t, err := time.Parse(time.RFC3339, expiry)
+				if err != nil {
+					return err
+				}
+				argExpiry = &t
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -34,12 +49,14 @@ func CmdConfigureMinter() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				argAddress,
 				argAllowance,
+				argExpiry,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagExpiry, "", "RFC3339 time after which the allowance is treated as zero")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+const FlagPayoutReference = "payout-reference"
+
+func CmdFulfillRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fulfill-redemption [id]",
+		Short: "Broadcast message fulfill-redemption",
+		Long:  "Burns the escrowed tokens of a pending redemption once it has been paid out off-chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			payoutReference, err := cmd.Flags().GetString(FlagPayoutReference)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFulfillRedemption(
+				clientCtx.GetFromAddress().String(),
+				argId,
+				payoutReference,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPayoutReference, "", "off-chain reference of the payout, e.g. of a wire transfer")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+const FlagAllowedRecipients = "allowed-recipients"
+
+func CmdGrantMintAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-mint-authorization [grantee] [spend-limit]",
+		Short: "Grant a mint authorization through x/authz",
+		Long: "Allows the grantee to mint up to spend-limit on behalf of the minter signing the transaction, by executing " +
+			"MsgMint through x/authz. Every mint is still checked against the minter allowance of the granter. Without " +
+			"--expiry the grant expires in one year",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			argSpendLimit, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			allowedRecipients, err := cmd.Flags().GetStringSlice(FlagAllowedRecipients)
+			if err != nil {
+				return err
+			}
+			expiry, err := cmd.Flags().GetString(FlagExpiry)
+			if err != nil {
+				return err
+			}
+
+			// the grant itself must expire, which is when the authorization does or one year from now
+			expiration := time.Now().AddDate(1, 0, 0)
+			var argExpiry *time.Time
+			if expiry != "" {
+				t, err := time.Parse(time.RFC3339, expiry)
+				if err != nil {
+					return err
+				}
+				argExpiry = &t
+				expiration = t
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authorization := types.NewMintAuthorization(argSpendLimit, allowedRecipients, argExpiry)
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAllowedRecipients, nil, "comma separated addresses that can be minted to, any address if not set")
+	cmd.Flags().String(FlagExpiry, "", "RFC3339 time after which the authorization can no longer be used")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -13,6 +13,8 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const FlagReferenceID = "reference-id"
+
 func CmdMint() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "mint [address] [amount]",
@@ -25,6 +27,11 @@ func CmdMint() *cobra.Command {
 				return err
 			}
 
+			referenceID, err := cmd.Flags().GetString(FlagReferenceID)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -35,11 +42,13 @@ func CmdMint() *cobra.Command {
 				argAddress,
 				argAmount,
 			)
+			msg.ReferenceID = referenceID
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReferenceID, "", "off-chain reference, e.g. of a deposit, that makes a retry of the mint fail instead of minting twice")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -14,10 +15,20 @@ var _ = strconv.Itoa(0)
 
 func CmdPause() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "pause",
+		Use:   "pause [denom] [operations]",
 		Short: "Broadcast message pause",
-		Args:  cobra.ExactArgs(0),
+		Long:  "Pauses a comma separated list of operations (mint, burn, transfer, ibc-outbound), or every operation if none are given",
+		Args:  cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+
+			var argOperations []types.PauseOperation
+			if len(args) > 1 {
+				argOperations, err = parsePauseOperations(args[1])
+				if err != nil {
+					return err
+				}
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -26,6 +37,8 @@ func CmdPause() *cobra.Command {
 
 			msg := types.NewMsgPause(
 				clientCtx.GetFromAddress().String(),
+				argDenom,
+				argOperations,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -36,3 +49,17 @@ func CmdPause() *cobra.Command {
 
 	return cmd
 }
+
+// parsePauseOperations parses a comma separated list of pause operations.
+func parsePauseOperations(arg string) ([]types.PauseOperation, error) {
+	var operations []types.PauseOperation
+	for _, s := range strings.Split(arg, ",") {
+		op, err := types.ParsePauseOperation(s)
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, op)
+	}
+
+	return operations, nil
+}
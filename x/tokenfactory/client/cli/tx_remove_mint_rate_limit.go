@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdRemoveMintRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-mint-rate-limit [denom] [minter]",
+		Short: "Broadcast message remove-mint-rate-limit",
+		Long:  "Removes the mint rate limit of the given minter, or the limit across all minters if no minter is given",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+
+			var argMinter string
+			if len(args) > 1 {
+				argMinter = args[1]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveMintRateLimit(
+				clientCtx.GetFromAddress().String(),
+				argMinter,
+				argDenom,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -14,11 +14,12 @@ var _ = strconv.Itoa(0)
 
 func CmdRemoveMinter() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "remove-minter [address]",
+		Use:   "remove-minter [denom] [address]",
 		Short: "Broadcast message remove-minter",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			argAddress := args[0]
+			argDenom := args[0]
+			argAddress := args[1]
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -28,6 +29,7 @@ func CmdRemoveMinter() *cobra.Command {
 			msg := types.NewMsgRemoveMinter(
 				clientCtx.GetFromAddress().String(),
 				argAddress,
+				argDenom,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
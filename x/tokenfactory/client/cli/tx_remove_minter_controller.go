@@ -15,11 +15,18 @@ var _ = strconv.Itoa(0)
 
 func CmdRemoveMinterController() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "remove-minter-controller [controller]",
+		Use:   "remove-minter-controller [denom] [controller] [minter]",
 		Short: "Broadcast message remove-minter-controller",
-		Args:  cobra.ExactArgs(1),
+		Long:  "Removes a controller from the given minter, or from all of its minters if no minter is given",
+		Args:  cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			argAddress := args[0]
+			argDenom := args[0]
+			argAddress := args[1]
+
+			var argMinter string
+			if len(args) > 2 {
+				argMinter = args[2]
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -29,6 +36,8 @@ func CmdRemoveMinterController() *cobra.Command {
 			msg := types.NewMsgRemoveMinterController(
 				clientCtx.GetFromAddress().String(),
 				argAddress,
+				argDenom,
+				argMinter,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
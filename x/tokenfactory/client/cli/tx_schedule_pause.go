@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSchedulePause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-pause [denom] [start] [end] [operations]",
+		Short: "Broadcast message schedule-pause",
+		Long: "Schedules a window during which a comma separated list of operations (mint, burn, transfer, ibc-outbound), " +
+			"or every operation if none are given, is paused. The start and end of the window are each either a block height " +
+			"or an RFC3339 timestamp (e.g. 2024-01-02T15:04:05Z). Scheduling a pause of a denom with an admin quorum requires an admin proposal",
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+
+			argStartHeight, argStartTime, err := parseHeightOrTime(args[1])
+			if err != nil {
+				return err
+			}
+			argEndHeight, argEndTime, err := parseHeightOrTime(args[2])
+			if err != nil {
+				return err
+			}
+
+			var argOperations []types.PauseOperation
+			if len(args) > 3 {
+				argOperations, err = parsePauseOperations(args[3])
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSchedulePause(
+				clientCtx.GetFromAddress().String(),
+				argDenom,
+				argOperations,
+				argStartHeight,
+				argStartTime,
+				argEndHeight,
+				argEndTime,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseHeightOrTime parses an argument that is either a block height or an RFC3339 timestamp.
+func parseHeightOrTime(arg string) (int64, *time.Time, error) {
+	if height, err := strconv.ParseInt(arg, 10, 64); err == nil {
+		return height, nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, arg)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return 0, &t, nil
+}
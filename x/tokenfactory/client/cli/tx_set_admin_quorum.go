@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetAdminQuorum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-admin-quorum [denom] [threshold] [voting-period] [approver...]",
+		Short: "Broadcast message set-admin-quorum",
+		Long: "Sets the approvers of a denom and the number of them that must approve role rotations, minter controller " +
+			"changes and unpauses before they are executed. A zero threshold and voting period without approvers removes the quorum",
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+			argThreshold, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+			argVotingPeriod, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+			argApprovers := args[3:]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAdminQuorum(
+				clientCtx.GetFromAddress().String(),
+				argDenom,
+				argApprovers,
+				uint32(argThreshold),
+				argVotingPeriod,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetMintRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-rate-limit [window] [limit] [minter]",
+		Short: "Broadcast message set-mint-rate-limit",
+		Long:  "Sets the maximum amount that can be minted within any rolling window (e.g. 24h) of block time by the given minter, or across all minters if no minter is given",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argWindow, err := time.ParseDuration(args[0])
+			if err != nil {
+				return err
+			}
+			argLimit, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			var argMinter string
+			if len(args) > 2 {
+				argMinter = args[2]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMintRateLimit(
+				clientCtx.GetFromAddress().String(),
+				argMinter,
+				argWindow,
+				argLimit,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
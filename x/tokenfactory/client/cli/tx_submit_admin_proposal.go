@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSubmitAdminProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-admin-proposal [denom] [msg-file]",
+		Short: "Broadcast message submit-admin-proposal",
+		Long: "Proposes to execute the administrative message in a JSON file, including its @type, once the admin quorum of " +
+			"the denom approves it. The message must be signed by the holder of the role it requires, e.g.\n" +
+			`{"@type":"/noble.tokenfactory.MsgUpdatePauser","from":"noble1...","address":"noble1...","denom":"utoken"}`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var adminMsg sdk.Msg
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &adminMsg); err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitAdminProposal(
+				clientCtx.GetFromAddress().String(),
+				argDenom,
+				adminMsg,
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -14,10 +14,20 @@ var _ = strconv.Itoa(0)
 
 func CmdUnpause() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "unpause",
+		Use:   "unpause [denom] [operations]",
 		Short: "Broadcast message unpause",
-		Args:  cobra.ExactArgs(0),
+		Long:  "Unpauses a comma separated list of operations (mint, burn, transfer, ibc-outbound), or every operation if none are given",
+		Args:  cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+
+			var argOperations []types.PauseOperation
+			if len(args) > 1 {
+				argOperations, err = parsePauseOperations(args[1])
+				if err != nil {
+					return err
+				}
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -26,6 +36,8 @@ func CmdUnpause() *cobra.Command {
 
 			msg := types.NewMsgUnpause(
 				clientCtx.GetFromAddress().String(),
+				argDenom,
+				argOperations,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdWipeBlacklistedBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wipe-blacklisted-balance [denom] [address] [case-reference]",
+		Short: "Broadcast message wipe-blacklisted-balance",
+		Long:  "Burns the balance of a blacklisted address, recording the case that required it",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+			argAddress := args[1]
+			argCaseReference := args[2]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWipeBlacklistedBalance(
+				clientCtx.GetFromAddress().String(),
+				argAddress,
+				argDenom,
+				argCaseReference,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -10,83 +10,157 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k *keeper.Keeper, bankKeeper types.BankKeeper, genState types.GenesisState) {
+	for _, elem := range genState.MintingDenomList {
+		_, found := bankKeeper.GetDenomMetaData(ctx, elem.Denom)
+		if !found {
+			panic(sdkerrors.Wrapf(types.ErrDenomNotRegistered, "tokenfactory minting denom %s is not registered in bank module denom_metadata", elem.Denom))
+		}
+		k.SetMintingDenom(ctx, elem)
+		// a denom without an explicit paused state in genesis starts unpaused
+		k.SetPaused(ctx, types.Paused{Paused: false, Denom: elem.Denom})
+	}
+
 	for _, elem := range genState.BlacklistedList {
 		k.SetBlacklisted(ctx, elem)
 	}
 
-	if genState.Paused != nil {
-		k.SetPaused(ctx, *genState.Paused)
+	for _, elem := range genState.PausedList {
+		k.SetPaused(ctx, elem)
 	}
 
-	if genState.MasterMinter != nil {
-		k.SetMasterMinter(ctx, *genState.MasterMinter)
+	for _, elem := range genState.MasterMinterList {
+		k.SetMasterMinter(ctx, elem)
 	}
 
 	for _, elem := range genState.MintersList {
 		k.SetMinters(ctx, elem)
 	}
 
-	if genState.Pauser != nil {
-		k.SetPauser(ctx, *genState.Pauser)
+	for _, elem := range genState.PauserList {
+		k.SetPauser(ctx, elem)
+	}
+
+	for _, elem := range genState.BlacklisterList {
+		k.SetBlacklister(ctx, elem)
+	}
+
+	for _, elem := range genState.AttestorList {
+		k.SetAttestor(ctx, elem)
+	}
+
+	for _, elem := range genState.AllowlisterList {
+		k.SetAllowlister(ctx, elem)
+	}
+
+	for _, elem := range genState.AllowlistedList {
+		k.SetAllowlisted(ctx, elem)
+	}
+
+	for _, elem := range genState.AllowlistModeList {
+		k.SetAllowlistMode(ctx, elem)
 	}
 
-	if genState.Blacklister != nil {
-		k.SetBlacklister(ctx, *genState.Blacklister)
+	// setting the frozen amounts also restores the total frozen amount of each denom
+	for _, elem := range genState.FrozenAmountList {
+		k.SetFrozenAmount(ctx, elem)
 	}
 
-	if genState.Owner != nil {
-		k.SetOwner(ctx, *genState.Owner)
+	for _, elem := range genState.OwnerList {
+		k.SetOwner(ctx, elem)
 	}
 
 	for _, elem := range genState.MinterControllerList {
 		k.SetMinterController(ctx, elem)
 	}
 
-	if genState.MintingDenom != nil {
-		_, found := bankKeeper.GetDenomMetaData(ctx, genState.MintingDenom.Denom)
-		if !found {
-			panic(sdkerrors.Wrapf(types.ErrDenomNotRegistered, "tokenfactory minting denom %s is not registered in bank module denom_metadata", genState.MintingDenom.Denom))
-		}
-		k.SetMintingDenom(ctx, *genState.MintingDenom)
+	for _, elem := range genState.MintRateLimitList {
+		k.SetMintRateLimit(ctx, elem)
 	}
-	// this line is used by starport scaffolding # genesis/module/init
-	k.SetParams(ctx, genState.Params)
-}
 
-// ExportGenesis returns the module's exported GenesisState
-func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
-	genesis := types.DefaultGenesis()
-	genesis.Params = k.GetParams(ctx)
+	for _, elem := range genState.MintRateLimitWindowList {
+		k.SetMintRateLimitWindow(ctx, elem)
+	}
 
-	genesis.BlacklistedList = k.GetAllBlacklisted(ctx)
+	for _, elem := range genState.SupplyCapList {
+		k.SetSupplyCap(ctx, elem)
+	}
 
-	paused := k.GetPaused(ctx)
-	genesis.Paused = &paused
+	for _, elem := range genState.PauseScheduleList {
+		k.SetPauseSchedule(ctx, elem)
+	}
+	k.SetPauseScheduleCount(ctx, genState.PauseScheduleCount)
 
-	masterMinter, found := k.GetMasterMinter(ctx)
-	if found {
-		genesis.MasterMinter = &masterMinter
+	for _, elem := range genState.AuditLogList {
+		k.SetAuditLogEntry(ctx, elem)
 	}
-	genesis.MintersList = k.GetAllMinters(ctx)
+	k.SetAuditLogCount(ctx, genState.AuditLogCount)
 
-	pauser, found := k.GetPauser(ctx)
-	if found {
-		genesis.Pauser = &pauser
+	for _, elem := range genState.AdminQuorumList {
+		k.SetAdminQuorum(ctx, elem)
 	}
+	for _, elem := range genState.AdminProposalList {
+		k.SetAdminProposal(ctx, elem)
+	}
+	k.SetAdminProposalCount(ctx, genState.AdminProposalCount)
 
-	blacklister, found := k.GetBlacklister(ctx)
-	if found {
-		genesis.Blacklister = &blacklister
+	for _, elem := range genState.ProcessedReferenceList {
+		k.SetProcessedReference(ctx, elem)
 	}
 
-	owner, found := k.GetOwner(ctx)
-	if found {
-		genesis.Owner = &owner
+	for _, elem := range genState.RedemptionList {
+		k.SetRedemption(ctx, elem)
 	}
-	genesis.MinterControllerList = k.GetAllMinterControllers(ctx)
+	k.SetRedemptionCount(ctx, genState.RedemptionCount)
+	for _, elem := range genState.ReserveAttestationList {
+		k.SetReserveAttestation(ctx, elem)
+	}
+	k.SetReserveAttestationCount(ctx, genState.ReserveAttestationCount)
+	for _, elem := range genState.MintStatsList {
+		k.SetMintStats(ctx, elem)
+	}
+	for _, elem := range genState.DailyMintStatsList {
+		k.SetDailyMintStats(ctx, elem)
+	}
+	// this line is used by starport scaffolding # genesis/module/init
+	k.SetParams(ctx, genState.Params)
+}
 
-	mintingDenom := k.GetMintingDenom(ctx)
-	genesis.MintingDenom = &mintingDenom
+// ExportGenesis returns the module's exported GenesisState
+func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.MintingDenomList = k.GetAllMintingDenoms(ctx)
+	genesis.BlacklistedList = k.GetAllBlacklisted(ctx)
+	genesis.PausedList = k.GetAllPaused(ctx)
+	genesis.MasterMinterList = k.GetAllMasterMinters(ctx)
+	genesis.MintersList = k.GetAllMinters(ctx)
+	genesis.PauserList = k.GetAllPausers(ctx)
+	genesis.BlacklisterList = k.GetAllBlacklisters(ctx)
+	genesis.AttestorList = k.GetAllAttestors(ctx)
+	genesis.AllowlisterList = k.GetAllAllowlisters(ctx)
+	genesis.AllowlistedList = k.GetAllAllowlisted(ctx)
+	genesis.AllowlistModeList = k.GetAllAllowlistModes(ctx)
+	genesis.FrozenAmountList = k.GetAllFrozenAmounts(ctx)
+	genesis.OwnerList = k.GetAllOwners(ctx)
+	genesis.MinterControllerList = k.GetAllMinterControllers(ctx)
+	genesis.MintRateLimitList = k.GetAllMintRateLimits(ctx)
+	genesis.MintRateLimitWindowList = k.GetAllMintRateLimitWindows(ctx)
+	genesis.SupplyCapList = k.GetAllSupplyCaps(ctx)
+	genesis.PauseScheduleList = k.GetAllPauseSchedules(ctx)
+	genesis.PauseScheduleCount = k.GetPauseScheduleCount(ctx)
+	genesis.AuditLogList = k.GetAllAuditLogEntries(ctx)
+	genesis.AuditLogCount = k.GetAuditLogCount(ctx)
+	genesis.AdminQuorumList = k.GetAllAdminQuorums(ctx)
+	genesis.AdminProposalList = k.GetAllAdminProposals(ctx)
+	genesis.AdminProposalCount = k.GetAdminProposalCount(ctx)
+	genesis.ProcessedReferenceList = k.GetAllProcessedReferences(ctx)
+	genesis.RedemptionList = k.GetAllRedemptions(ctx)
+	genesis.RedemptionCount = k.GetRedemptionCount(ctx)
+	genesis.ReserveAttestationList = k.GetAllReserveAttestations(ctx)
+	genesis.ReserveAttestationCount = k.GetReserveAttestationCount(ctx)
+	genesis.MintStatsList = k.GetAllMintStats(ctx)
+	genesis.DailyMintStatsList = k.GetAllDailyMintStats(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -2,60 +2,294 @@ package tokenfactory_test
 
 import (
 	"testing"
+	"time"
 
 	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
 	"github.com/noble-assets/noble/v5/testutil/nullify"
 	"github.com/noble-assets/noble/v5/x/tokenfactory"
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiry := startTime.Add(24 * time.Hour)
+	adminProposalMsg, err := cdctypes.NewAnyWithValue(types.NewMsgUpdatePauser("23", "28", "65"))
+	require.NoError(t, err)
+
 	genesisState := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params: types.NewParams(30*24*time.Hour, 14*24*time.Hour, 24*time.Hour, 35*24*time.Hour, sdk.NewInt(1_000_000), 90*24*time.Hour),
 
+		MintingDenomList: []types.MintingDenom{
+			{
+				Denom: "65",
+			},
+			{
+				Denom: "66",
+			},
+		},
 		BlacklistedList: []types.Blacklisted{
 			{
-				AddressBz: []byte("0"),
+				AddressBz:     []byte("0"),
+				Denom:         "65",
+				Reason:        "sanctions",
+				CaseID:        "case-1",
+				Blacklister:   "2",
+				BlacklistedAt: &startTime,
+				Expiry:        &expiry,
 			},
 			{
 				AddressBz: []byte("1"),
+				Denom:     "66",
 			},
 		},
-		Paused: &types.Paused{
-			Paused: true,
+		PausedList: []types.Paused{
+			{
+				Paused: true,
+				Denom:  "65",
+			},
+			{
+				Paused: false,
+				Denom:  "66",
+			},
 		},
-		MasterMinter: &types.MasterMinter{
-			Address: "79",
+		MasterMinterList: []types.MasterMinter{
+			{
+				Address: "79",
+				Denom:   "65",
+			},
 		},
 		MintersList: []types.Minters{
 			{
 				Address: "0",
+				Denom:   "65",
+				Expiry:  &expiry,
 			},
 			{
 				Address: "1",
+				Denom:   "66",
 			},
 		},
-		Pauser: &types.Pauser{
-			Address: "96",
+		PauserList: []types.Pauser{
+			{
+				Address: "96",
+				Denom:   "65",
+			},
+		},
+		AttestorList: []types.Attestor{
+			{
+				Address: "97",
+				Denom:   "65",
+			},
 		},
-		Blacklister: &types.Blacklister{
-			Address: "20",
+		AllowlisterList: []types.Allowlister{
+			{
+				Address: "98",
+				Denom:   "65",
+			},
 		},
-		Owner: &types.Owner{
-			Address: "98",
+		AllowlistedList: []types.Allowlisted{
+			{
+				AddressBz:     []byte("99"),
+				Denom:         "65",
+				Allowlister:   "98",
+				AllowlistedAt: startTime,
+			},
+		},
+		AllowlistModeList: []types.AllowlistMode{
+			{
+				Denom:   "65",
+				Enabled: true,
+			},
+		},
+		FrozenAmountList: []types.FrozenAmount{
+			{
+				AddressBz: []byte("99"),
+				Amount:    sdk.Coin{Denom: "65", Amount: sdk.NewInt(40)},
+			},
+			{
+				AddressBz: []byte("100"),
+				Amount:    sdk.Coin{Denom: "65", Amount: sdk.NewInt(2)},
+			},
+		},
+		BlacklisterList: []types.Blacklister{
+			{
+				Address: "20",
+				Denom:   "65",
+			},
+		},
+		OwnerList: []types.Owner{
+			{
+				Address: "98",
+				Denom:   "65",
+			},
+			{
+				Address: "99",
+				Denom:   "66",
+			},
 		},
 		MinterControllerList: []types.MinterController{
 			{
+				Minter:     "0",
+				Controller: "0",
+				Denom:      "65",
+			},
+			{
+				Minter:     "1",
+				Controller: "1",
+				Denom:      "66",
+			},
+		},
+		MintRateLimitList: []types.MintRateLimit{
+			{
+				Denom:  "65",
+				Minter: "0",
+				Window: 24 * time.Hour,
+				Limit:  sdk.Coin{Denom: "65", Amount: sdk.NewInt(50)},
+			},
+			{
+				Denom:  "65",
+				Window: 24 * time.Hour,
+				Limit:  sdk.Coin{Denom: "65", Amount: sdk.NewInt(200)},
+			},
+		},
+		MintRateLimitWindowList: []types.MintRateLimitWindow{
+			{
+				Denom:  "65",
 				Minter: "0",
+				Buckets: []types.MintRateLimitBucket{
+					{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Minted: sdk.Coin{Denom: "65", Amount: sdk.NewInt(10)}},
+				},
+			},
+		},
+		SupplyCapList: []types.SupplyCap{
+			{
+				Denom:     "65",
+				MaxSupply: sdk.Coin{Denom: "65", Amount: sdk.NewInt(1000)},
+			},
+		},
+		PauseScheduleList: []types.PauseSchedule{
+			{
+				Denom:       "65",
+				Id:          0,
+				StartHeight: 10,
+				EndHeight:   20,
+			},
+			{
+				Denom:      "65",
+				Id:         1,
+				Operations: []types.PauseOperation{types.PauseMint},
+				StartTime:  &startTime,
+				EndHeight:  20,
+			},
+		},
+		PauseScheduleCount: 2,
+		AuditLogList: []types.AuditLogEntry{
+			{
+				Id:       0,
+				Denom:    "65",
+				Action:   types.AuditActionUpdateOwner,
+				Actor:    "23",
+				Target:   "24",
+				OldValue: "23",
+				NewValue: "24",
+				Height:   1,
+				Time:     startTime,
+			},
+			{
+				Id:       1,
+				Denom:    "66",
+				Action:   types.AuditActionPause,
+				Actor:    "25",
+				NewValue: "PAUSE_OPERATION_MINT",
+				Height:   2,
+				Time:     startTime,
 			},
+		},
+		AuditLogCount: 2,
+		AdminQuorumList: []types.AdminQuorum{
+			{
+				Denom:        "65",
+				Approvers:    []string{"26", "27"},
+				Threshold:    2,
+				VotingPeriod: time.Hour,
+			},
+		},
+		AdminProposalList: []types.AdminProposal{
+			{
+				Denom:      "65",
+				Id:         0,
+				Msg:        adminProposalMsg,
+				Proposer:   "26",
+				Approvals:  []string{"26"},
+				SubmitTime: startTime,
+				Expiry:     startTime.Add(time.Hour),
+			},
+		},
+		AdminProposalCount: 1,
+		ProcessedReferenceList: []types.ProcessedReference{
+			{
+				Denom:       "65",
+				Address:     "27",
+				ReferenceID: "deposit-1",
+				Height:      5,
+				Time:        startTime,
+				Operation:   types.ReferenceMint,
+			},
+		},
+		RedemptionList: []types.Redemption{
 			{
-				Minter: "1",
+				Id:                 0,
+				Requester:          "27",
+				Amount:             sdk.Coin{Denom: "65", Amount: sdk.NewInt(10)},
+				PayoutInstructions: "iban",
+				Status:             types.RedemptionStatusPending,
+				RequestTime:        startTime,
+				Timeout:            &expiry,
+			},
+			{
+				Id:                 1,
+				Requester:          "27",
+				Amount:             sdk.Coin{Denom: "65", Amount: sdk.NewInt(5)},
+				PayoutInstructions: "iban",
+				Status:             types.RedemptionStatusFulfilled,
+				RequestTime:        startTime,
+				Timeout:            &expiry,
+				Resolver:           "26",
+				ResolveTime:        &startTime,
+			},
+		},
+		RedemptionCount: 2,
+		ReserveAttestationList: []types.ReserveAttestation{
+			{
+				Id:         0,
+				Attestor:   "97",
+				Reserves:   sdk.Coin{Denom: "65", Amount: sdk.NewInt(100)},
+				Timestamp:  startTime,
+				Height:     3,
+				SubmitTime: startTime,
 			},
 		},
-		MintingDenom: &types.MintingDenom{
-			Denom: "65",
+		ReserveAttestationCount: 1,
+		MintStatsList: []types.MintStats{
+			{
+				Denom:  "65",
+				Minter: "26",
+				Minted: sdk.Coin{Denom: "65", Amount: sdk.NewInt(20)},
+				Burned: sdk.Coin{Denom: "65", Amount: sdk.NewInt(5)},
+			},
+		},
+		DailyMintStatsList: []types.DailyMintStats{
+			{
+				Denom:  "65",
+				Minter: "26",
+				Date:   "2024-01-01",
+				Minted: sdk.Coin{Denom: "65", Amount: sdk.NewInt(20)},
+				Burned: sdk.Coin{Denom: "65", Amount: sdk.NewInt(5)},
+			},
 		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
@@ -64,18 +298,42 @@ func TestGenesis(t *testing.T) {
 	tokenfactory.InitGenesis(ctx, k, keepertest.MockBankKeeper{}, genesisState)
 	got := tokenfactory.ExportGenesis(ctx, k)
 	require.NotNil(t, got)
+	require.Equal(t, genesisState.Params, got.Params)
 
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.ElementsMatch(t, genesisState.MintingDenomList, got.MintingDenomList)
 	require.ElementsMatch(t, genesisState.BlacklistedList, got.BlacklistedList)
-	require.Equal(t, genesisState.Paused, got.Paused)
-	require.Equal(t, genesisState.MasterMinter, got.MasterMinter)
+	require.ElementsMatch(t, genesisState.PausedList, got.PausedList)
+	require.ElementsMatch(t, genesisState.MasterMinterList, got.MasterMinterList)
 	require.ElementsMatch(t, genesisState.MintersList, got.MintersList)
-	require.Equal(t, genesisState.Pauser, got.Pauser)
-	require.Equal(t, genesisState.Blacklister, got.Blacklister)
-	require.Equal(t, genesisState.Owner, got.Owner)
+	require.ElementsMatch(t, genesisState.PauserList, got.PauserList)
+	require.ElementsMatch(t, genesisState.BlacklisterList, got.BlacklisterList)
+	require.ElementsMatch(t, genesisState.AttestorList, got.AttestorList)
+	require.ElementsMatch(t, genesisState.AllowlisterList, got.AllowlisterList)
+	require.ElementsMatch(t, genesisState.AllowlistedList, got.AllowlistedList)
+	require.ElementsMatch(t, genesisState.AllowlistModeList, got.AllowlistModeList)
+	require.ElementsMatch(t, genesisState.FrozenAmountList, got.FrozenAmountList)
+	require.Equal(t, sdk.Coin{Denom: "65", Amount: sdk.NewInt(42)}, k.GetTotalFrozen(ctx, "65"))
+	require.ElementsMatch(t, genesisState.ReserveAttestationList, got.ReserveAttestationList)
+	require.Equal(t, genesisState.ReserveAttestationCount, got.ReserveAttestationCount)
+	require.ElementsMatch(t, genesisState.OwnerList, got.OwnerList)
 	require.ElementsMatch(t, genesisState.MinterControllerList, got.MinterControllerList)
-	require.Equal(t, genesisState.MintingDenom, got.MintingDenom)
+	require.ElementsMatch(t, genesisState.MintRateLimitList, got.MintRateLimitList)
+	require.ElementsMatch(t, genesisState.MintRateLimitWindowList, got.MintRateLimitWindowList)
+	require.ElementsMatch(t, genesisState.SupplyCapList, got.SupplyCapList)
+	require.ElementsMatch(t, genesisState.PauseScheduleList, got.PauseScheduleList)
+	require.Equal(t, genesisState.PauseScheduleCount, got.PauseScheduleCount)
+	require.ElementsMatch(t, genesisState.AuditLogList, got.AuditLogList)
+	require.Equal(t, genesisState.AuditLogCount, got.AuditLogCount)
+	require.ElementsMatch(t, genesisState.AdminQuorumList, got.AdminQuorumList)
+	require.ElementsMatch(t, genesisState.AdminProposalList, got.AdminProposalList)
+	require.Equal(t, genesisState.AdminProposalCount, got.AdminProposalCount)
+	require.ElementsMatch(t, genesisState.ProcessedReferenceList, got.ProcessedReferenceList)
+	require.ElementsMatch(t, genesisState.RedemptionList, got.RedemptionList)
+	require.Equal(t, genesisState.RedemptionCount, got.RedemptionCount)
+	require.ElementsMatch(t, genesisState.MintStatsList, got.MintStatsList)
+	require.ElementsMatch(t, genesisState.DailyMintStatsList, got.DailyMintStatsList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
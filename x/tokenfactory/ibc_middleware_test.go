@@ -0,0 +1,61 @@
+package tokenfactory_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// mockTransferApp acknowledges every packet it receives successfully.
+type mockTransferApp struct {
+	porttypes.IBCModule
+	received int
+}
+
+func (app *mockTransferApp) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) ibcexported.Acknowledgement {
+	app.received++
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func TestIBCMiddlewareAllowlist(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: anteTestDenom})
+	k.SetAllowlistMode(ctx, types.AllowlistMode{Denom: anteTestDenom, Enabled: true})
+
+	allowlisted := sample.TestAccount()
+	k.SetAllowlisted(ctx, types.Allowlisted{AddressBz: allowlisted.AddressBz, Denom: anteTestDenom})
+
+	app := &mockTransferApp{}
+	middleware := tokenfactory.NewIBCMiddleware(app, k)
+
+	recv := func(denom string, receiver string) ibcexported.Acknowledgement {
+		data := transfertypes.NewFungibleTokenPacketData(denom, "1", sample.AccAddress(), receiver)
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-1", "transfer", "channel-0", clienttypes.ZeroHeight(), 1)
+		return middleware.OnRecvPacket(ctx, packet, nil)
+	}
+
+	// minting denoms return to this chain with the prefix of the counterparty channel
+	returning := "transfer/channel-1/" + anteTestDenom
+
+	require.True(t, recv(returning, allowlisted.Address).Success())
+	require.Equal(t, 1, app.received)
+
+	require.False(t, recv(returning, sample.AccAddress()).Success())
+	require.Equal(t, 1, app.received)
+
+	// vouchers of a denom with the same base denom are not the minting denom
+	require.True(t, recv(anteTestDenom, sample.AccAddress()).Success())
+	require.True(t, recv("transfer/channel-2/"+anteTestDenom, sample.AccAddress()).Success())
+	require.Equal(t, 3, app.received)
+}
@@ -0,0 +1,293 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// adminProposalContextKey marks a context in which an approved admin proposal is executed.
+type adminProposalContextKey struct{}
+
+// SetAdminQuorum set the admin quorum of a denom in the store
+func (k Keeper) SetAdminQuorum(ctx sdk.Context, quorum types.AdminQuorum) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&quorum)
+	store.Set(types.DenomPrefix(types.AdminQuorumKeyPrefix, quorum.Denom), b)
+}
+
+// GetAdminQuorum returns the admin quorum of a denom
+func (k Keeper) GetAdminQuorum(ctx sdk.Context, denom string) (val types.AdminQuorum, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(types.DenomPrefix(types.AdminQuorumKeyPrefix, denom))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// DeleteAdminQuorum removes the admin quorum of a denom from the store
+func (k Keeper) DeleteAdminQuorum(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DenomPrefix(types.AdminQuorumKeyPrefix, denom))
+}
+
+// GetAllAdminQuorums returns the admin quorum of every denom
+func (k Keeper) GetAllAdminQuorums(ctx sdk.Context) (list []types.AdminQuorum) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminQuorumKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AdminQuorum
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAdminProposalCount returns the number of admin proposals ever submitted, which is the id of the next one
+func (k Keeper) GetAdminProposalCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(types.KeyPrefix(types.AdminProposalCountKey))
+	if b == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(b)
+}
+
+// SetAdminProposalCount sets the number of admin proposals ever submitted
+func (k Keeper) SetAdminProposalCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.AdminProposalCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// AppendAdminProposal stores a new admin proposal under the next id and returns that id
+func (k Keeper) AppendAdminProposal(ctx sdk.Context, proposal types.AdminProposal) uint64 {
+	count := k.GetAdminProposalCount(ctx)
+
+	proposal.Id = count
+	k.SetAdminProposal(ctx, proposal)
+	k.SetAdminProposalCount(ctx, count+1)
+
+	return count
+}
+
+// SetAdminProposal set a specific admin proposal in the store from its index, along with its entry in the expiry index
+func (k Keeper) SetAdminProposal(ctx sdk.Context, proposal types.AdminProposal) {
+	if existing, found := k.GetAdminProposal(ctx, proposal.Denom, proposal.Id); found {
+		k.removeAdminProposalExpiry(ctx, existing)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminProposalKeyPrefix))
+	b := k.cdc.MustMarshal(&proposal)
+	key := types.AdminProposalKey(proposal.Denom, proposal.Id)
+	store.Set(key, b)
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminProposalExpiryKeyPrefix))
+	expiryStore.Set(types.AdminProposalExpiryKey(proposal.Expiry, proposal.Denom, proposal.Id), key)
+}
+
+// GetAdminProposal returns an admin proposal from its index
+func (k Keeper) GetAdminProposal(ctx sdk.Context, denom string, id uint64) (val types.AdminProposal, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminProposalKeyPrefix))
+
+	b := store.Get(types.AdminProposalKey(denom, id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// DeleteAdminProposal removes an admin proposal, along with its entry in the expiry index, from the store
+func (k Keeper) DeleteAdminProposal(ctx sdk.Context, denom string, id uint64) {
+	if existing, found := k.GetAdminProposal(ctx, denom, id); found {
+		k.removeAdminProposalExpiry(ctx, existing)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminProposalKeyPrefix))
+	store.Delete(types.AdminProposalKey(denom, id))
+}
+
+func (k Keeper) removeAdminProposalExpiry(ctx sdk.Context, proposal types.AdminProposal) {
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminProposalExpiryKeyPrefix))
+	expiryStore.Delete(types.AdminProposalExpiryKey(proposal.Expiry, proposal.Denom, proposal.Id))
+}
+
+// GetAllAdminProposals returns all pending admin proposals
+func (k Keeper) GetAllAdminProposals(ctx sdk.Context) (list []types.AdminProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminProposalKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AdminProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ExpireAdminProposals removes every pending admin proposal whose voting period has ended. It is
+// called at the end of every block, and only iterates the proposals that expired. A proposal whose
+// removal fails is kept until the next block, without affecting the others.
+func (k Keeper) ExpireAdminProposals(ctx sdk.Context) error {
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminProposalExpiryKeyPrefix))
+	iterator := expiryStore.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Value())
+	}
+	iterator.Close()
+
+	var errs []error
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminProposalKeyPrefix))
+	for _, key := range keys {
+		var proposal types.AdminProposal
+		k.cdc.MustUnmarshal(store.Get(key), &proposal)
+
+		if err := runCached(ctx, func(ctx sdk.Context) error {
+			return k.expireAdminProposal(ctx, proposal)
+		}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (k Keeper) expireAdminProposal(ctx sdk.Context, proposal types.AdminProposal) error {
+	k.DeleteAdminProposal(ctx, proposal.Denom, proposal.Id)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAdminProposalExpired{
+		Denom: proposal.Denom,
+		Id:    proposal.Id,
+	})
+}
+
+// requireAdminProposal returns an error if the denom has an admin quorum, unless the message is
+// executed as part of an approved admin proposal.
+func (k Keeper) requireAdminProposal(ctx sdk.Context, denom string) error {
+	if _, found := k.GetAdminQuorum(ctx, denom); !found {
+		return nil
+	}
+
+	if executing, _ := ctx.Value(adminProposalContextKey{}).(bool); executing {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(types.ErrAdminProposalRequired, "%s has an admin quorum", denom)
+}
+
+// runAdminMsg executes an administrative message as part of an approved admin proposal of a denom.
+// The message must be for that denom, since only the admin quorum of its own denom can approve it.
+func (k Keeper) runAdminMsg(ctx sdk.Context, denom string, msg types.AdminMsg) (err error) {
+	if msg.GetDenom() != denom {
+		return sdkerrors.Wrapf(types.ErrAdminProposal, "proposed message is for denom %s, not %s", msg.GetDenom(), denom)
+	}
+
+	goCtx := sdk.WrapSDKContext(ctx.WithValue(adminProposalContextKey{}, true))
+	server := NewMsgServerImpl(&k)
+
+	switch msg := msg.(type) {
+	case *types.MsgUpdateOwner:
+		_, err = server.UpdateOwner(goCtx, msg)
+	case *types.MsgUpdateMasterMinter:
+		_, err = server.UpdateMasterMinter(goCtx, msg)
+	case *types.MsgUpdatePauser:
+		_, err = server.UpdatePauser(goCtx, msg)
+	case *types.MsgUpdateBlacklister:
+		_, err = server.UpdateBlacklister(goCtx, msg)
+	case *types.MsgUpdateAttestor:
+		_, err = server.UpdateAttestor(goCtx, msg)
+	case *types.MsgUpdateAllowlister:
+		_, err = server.UpdateAllowlister(goCtx, msg)
+	case *types.MsgSetAllowlistMode:
+		_, err = server.SetAllowlistMode(goCtx, msg)
+	case *types.MsgConfigureMinterController:
+		_, err = server.ConfigureMinterController(goCtx, msg)
+	case *types.MsgRemoveMinterController:
+		_, err = server.RemoveMinterController(goCtx, msg)
+	case *types.MsgUnpause:
+		_, err = server.Unpause(goCtx, msg)
+	case *types.MsgSchedulePause:
+		_, err = server.SchedulePause(goCtx, msg)
+	case *types.MsgCancelPauseSchedule:
+		_, err = server.CancelPauseSchedule(goCtx, msg)
+	case *types.MsgSetAdminQuorum:
+		_, err = server.SetAdminQuorum(goCtx, msg)
+	default:
+		err = sdkerrors.Wrapf(types.ErrAdminProposal, "%s can not be submitted as an admin proposal", sdk.MsgTypeURL(msg))
+	}
+
+	return err
+}
+
+// executeAdminProposal removes a proposal that reached its threshold and executes its message. The
+// changes of the message are only kept if it succeeds, but the proposal is removed either way.
+func (k Keeper) executeAdminProposal(ctx sdk.Context, proposal types.AdminProposal) (bool, error) {
+	k.DeleteAdminProposal(ctx, proposal.Denom, proposal.Id)
+
+	msg, err := proposal.GetAdminMsg()
+	if err != nil {
+		return false, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.runAdminMsg(cacheCtx, proposal.Denom, msg); err != nil {
+		return false, ctx.EventManager().EmitTypedEvent(&types.EventAdminProposalFailed{
+			Denom:      proposal.Denom,
+			Id:         proposal.Id,
+			MsgTypeURL: sdk.MsgTypeURL(msg),
+			Error:      err.Error(),
+		})
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return true, ctx.EventManager().EmitTypedEvent(&types.EventAdminProposalExecuted{
+		Denom:      proposal.Denom,
+		Id:         proposal.Id,
+		MsgTypeURL: sdk.MsgTypeURL(msg),
+	})
+}
+
+// countVotes returns the number of voters that are still approvers of the quorum.
+func countVotes(quorum types.AdminQuorum, voters []string) (count uint32) {
+	for _, voter := range voters {
+		if quorum.IsApprover(voter) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// formatAdminQuorum returns the threshold and approvers of a quorum for the audit log.
+func formatAdminQuorum(quorum types.AdminQuorum) string {
+	if len(quorum.Approvers) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%d of %s", quorum.Threshold, strings.Join(quorum.Approvers, ","))
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// createNAdminProposals appends n proposals, each expiring one hour after the previous one.
+func createNAdminProposals(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.AdminProposal {
+	items := make([]types.AdminProposal, n)
+	for i := range items {
+		msg, err := cdctypes.NewAnyWithValue(types.NewMsgUpdatePauser(sample.AccAddress(), sample.AccAddress(), testDenom))
+		if err != nil {
+			panic(err)
+		}
+
+		proposer := sample.AccAddress()
+		items[i] = types.AdminProposal{
+			Denom:      testDenom,
+			Msg:        msg,
+			Proposer:   proposer,
+			Approvals:  []string{proposer},
+			SubmitTime: time.Unix(0, 0).UTC(),
+			Expiry:     time.Unix(0, 0).UTC().Add(time.Duration(i+1) * time.Hour),
+		}
+		items[i].Id = keeper.AppendAdminProposal(ctx, items[i])
+	}
+	return items
+}
+
+func TestAdminProposalGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNAdminProposals(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetAdminProposal(ctx, item.Denom, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+	require.Equal(t, uint64(10), keeper.GetAdminProposalCount(ctx))
+}
+
+func TestAdminProposalRemove(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNAdminProposals(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.DeleteAdminProposal(ctx, item.Denom, item.Id)
+		_, found := keeper.GetAdminProposal(ctx, item.Denom, item.Id)
+		require.False(t, found)
+	}
+}
+
+func TestAdminProposalGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNAdminProposals(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllAdminProposals(ctx)),
+	)
+}
+
+func TestExpireAdminProposals(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNAdminProposals(keeper, ctx, 4)
+
+	// a removed proposal leaves no entry in the expiry index
+	keeper.DeleteAdminProposal(ctx, testDenom, items[0].Id)
+
+	// proposals expire at the first block at or after their expiry
+	ctx = ctx.WithBlockTime(items[1].Expiry)
+	require.NoError(t, keeper.ExpireAdminProposals(ctx))
+
+	require.ElementsMatch(t,
+		nullify.Fill(items[2:]),
+		nullify.Fill(keeper.GetAllAdminProposals(ctx)),
+	)
+
+	events := ctx.EventManager().ABCIEvents()
+	require.Len(t, events, 1)
+	event, err := sdk.ParseTypedEvent(events[0])
+	require.NoError(t, err)
+	require.Equal(t, &types.EventAdminProposalExpired{Denom: testDenom, Id: items[1].Id}, event)
+}
+
+func TestAdminQuorumGetRemove(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+
+	_, found := keeper.GetAdminQuorum(ctx, testDenom)
+	require.False(t, found)
+
+	quorum := types.AdminQuorum{
+		Denom:        testDenom,
+		Approvers:    []string{sample.AccAddress(), sample.AccAddress()},
+		Threshold:    2,
+		VotingPeriod: time.Hour,
+	}
+	keeper.SetAdminQuorum(ctx, quorum)
+
+	got, found := keeper.GetAdminQuorum(ctx, testDenom)
+	require.True(t, found)
+	require.Equal(t, quorum, got)
+	require.Equal(t, []types.AdminQuorum{quorum}, keeper.GetAllAdminQuorums(ctx))
+
+	keeper.DeleteAdminQuorum(ctx, testDenom)
+	_, found = keeper.GetAdminQuorum(ctx, testDenom)
+	require.False(t, found)
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// SetAllowlisted set a specific allowlisted in the store from its index
+func (k Keeper) SetAllowlisted(ctx sdk.Context, allowlisted types.Allowlisted) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AllowlistedKeyPrefix))
+	b := k.cdc.MustMarshal(&allowlisted)
+	store.Set(types.AllowlistedKey(allowlisted.Denom, allowlisted.AddressBz), b)
+}
+
+// GetAllowlisted returns an allowlisted from its index
+func (k Keeper) GetAllowlisted(ctx sdk.Context, denom string, addressBz []byte) (val types.Allowlisted, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AllowlistedKeyPrefix))
+
+	b := store.Get(types.AllowlistedKey(denom, addressBz))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAllowlisted removes an allowlisted from the store
+func (k Keeper) RemoveAllowlisted(ctx sdk.Context, denom string, addressBz []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AllowlistedKeyPrefix))
+	store.Delete(types.AllowlistedKey(denom, addressBz))
+}
+
+// GetAllAllowlisted returns all allowlisted
+func (k Keeper) GetAllAllowlisted(ctx sdk.Context) (list []types.Allowlisted) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AllowlistedKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Allowlisted
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetAllowlistMode set the allowlist mode of a denom in the store
+func (k Keeper) SetAllowlistMode(ctx sdk.Context, mode types.AllowlistMode) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&mode)
+	store.Set(types.DenomPrefix(types.AllowlistModeKeyPrefix, mode.Denom), b)
+}
+
+// GetAllowlistMode returns the allowlist mode of a denom, which is disabled unless it has been enabled.
+func (k Keeper) GetAllowlistMode(ctx sdk.Context, denom string) types.AllowlistMode {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(types.DenomPrefix(types.AllowlistModeKeyPrefix, denom))
+	if b == nil {
+		return types.AllowlistMode{Denom: denom}
+	}
+
+	var val types.AllowlistMode
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// GetAllAllowlistModes returns the allowlist mode of every denom it has been set for
+func (k Keeper) GetAllAllowlistModes(ctx sdk.Context) (list []types.AllowlistMode) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AllowlistModeKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AllowlistMode
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// CheckAllowlisted returns ErrNotAllowlisted if denom is a minting denom with its allowlist mode
+// enabled and address is not allowlisted for it.
+func (k Keeper) CheckAllowlisted(ctx sdk.Context, denom string, address string) error {
+	if !k.MintingDenomSet(ctx, denom) || !k.GetAllowlistMode(ctx, denom).Enabled {
+		return nil
+	}
+
+	_, addressBz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.GetAllowlisted(ctx, denom, addressBz); !found {
+		return sdkerrors.Wrapf(types.ErrNotAllowlisted, "%s can not hold %s", address, denom)
+	}
+
+	return nil
+}
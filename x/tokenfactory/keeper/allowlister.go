@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAllowlister set allowlister in the store
+func (k Keeper) SetAllowlister(ctx sdk.Context, allowlister types.Allowlister) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&allowlister)
+	store.Set(types.DenomPrefix(types.AllowlisterKey, allowlister.Denom), b)
+}
+
+// GetAllowlister returns allowlister
+func (k Keeper) GetAllowlister(ctx sdk.Context, denom string) (val types.Allowlister, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(types.DenomPrefix(types.AllowlisterKey, denom))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllAllowlisters returns the allowlister of every denom
+func (k Keeper) GetAllAllowlisters(ctx sdk.Context) (list []types.Allowlister) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AllowlisterKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Allowlister
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAttestor set attestor in the store
+func (k Keeper) SetAttestor(ctx sdk.Context, attestor types.Attestor) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&attestor)
+	store.Set(types.DenomPrefix(types.AttestorKey, attestor.Denom), b)
+}
+
+// GetAttestor returns attestor
+func (k Keeper) GetAttestor(ctx sdk.Context, denom string) (val types.Attestor, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(types.DenomPrefix(types.AttestorKey, denom))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllAttestors returns the attestor of every denom
+func (k Keeper) GetAllAttestors(ctx sdk.Context) (list []types.Attestor) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AttestorKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Attestor
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
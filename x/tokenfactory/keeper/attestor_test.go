@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func createTestAttestor(keeper *keeper.Keeper, ctx sdk.Context) types.Attestor {
+	item := types.Attestor{Denom: testDenom}
+	keeper.SetAttestor(ctx, item)
+	return item
+}
+
+func TestAttestorGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	item := createTestAttestor(keeper, ctx)
+	rst, found := keeper.GetAttestor(ctx, testDenom)
+	require.True(t, found)
+	require.Equal(t,
+		nullify.Fill(&item),
+		nullify.Fill(&rst),
+	)
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// GetAuditLogCount returns the number of audit log entries ever recorded, which is the id of the next one
+func (k Keeper) GetAuditLogCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(types.KeyPrefix(types.AuditLogCountKey))
+	if b == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(b)
+}
+
+// SetAuditLogCount sets the number of audit log entries ever recorded
+func (k Keeper) SetAuditLogCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.AuditLogCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// AppendAuditLogEntry stores a new audit log entry under the next id, stamped with the current
+// block height and time, and returns that id
+func (k Keeper) AppendAuditLogEntry(ctx sdk.Context, entry types.AuditLogEntry) uint64 {
+	count := k.GetAuditLogCount(ctx)
+
+	entry.Id = count
+	entry.Height = ctx.BlockHeight()
+	entry.Time = ctx.BlockTime()
+	k.SetAuditLogEntry(ctx, entry)
+	k.SetAuditLogCount(ctx, count+1)
+
+	return count
+}
+
+// SetAuditLogEntry set a specific auditLogEntry in the store from its index
+func (k Keeper) SetAuditLogEntry(ctx sdk.Context, entry types.AuditLogEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuditLogKeyPrefix))
+	b := k.cdc.MustMarshal(&entry)
+	store.Set(types.AuditLogKey(entry.Id), b)
+}
+
+// GetAuditLogEntry returns an auditLogEntry from its index
+func (k Keeper) GetAuditLogEntry(ctx sdk.Context, id uint64) (val types.AuditLogEntry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuditLogKeyPrefix))
+
+	b := store.Get(types.AuditLogKey(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// DeleteAuditLogEntry removes an auditLogEntry from the store
+func (k Keeper) DeleteAuditLogEntry(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuditLogKeyPrefix))
+	store.Delete(types.AuditLogKey(id))
+}
+
+// GetAllAuditLogEntries returns all auditLogEntry
+func (k Keeper) GetAllAuditLogEntries(ctx sdk.Context) (list []types.AuditLogEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuditLogKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuditLogEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// PruneAuditLog deletes the audit log entries that are older than the audit log retention param.
+// It is called at the end of every block and does nothing if the retention is zero.
+func (k Keeper) PruneAuditLog(ctx sdk.Context) {
+	retention := k.GetParams(ctx).AuditLogRetention
+	if retention <= 0 {
+		return
+	}
+
+	cutoff := ctx.BlockTime().Add(-retention)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuditLogKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	// entries are stored in the order they were recorded, so pruning stops at the first one to keep
+	var expired []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuditLogEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		if !val.Time.Before(cutoff) {
+			break
+		}
+		expired = append(expired, val.Id)
+	}
+	iterator.Close()
+
+	for _, id := range expired {
+		k.DeleteAuditLogEntry(ctx, id)
+	}
+}
+
+// recordAudit appends an entry for an administrative action to the audit log.
+func (k Keeper) recordAudit(ctx sdk.Context, denom string, action types.AuditAction, actor, target, oldValue, newValue string) {
+	k.AppendAuditLogEntry(ctx, types.AuditLogEntry{
+		Denom:    denom,
+		Action:   action,
+		Actor:    actor,
+		Target:   target,
+		OldValue: oldValue,
+		NewValue: newValue,
+	})
+}
+
+// moduleActor is the actor recorded for actions the module takes on its own at the end of a block.
+func moduleActor() string {
+	return authtypes.NewModuleAddress(types.ModuleName).String()
+}
@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// createNAuditLogEntries appends n entries, each recorded one block and one hour after the previous one.
+func createNAuditLogEntries(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.AuditLogEntry {
+	items := make([]types.AuditLogEntry, n)
+	for i := range items {
+		blockCtx := ctx.WithBlockHeight(int64(i + 1)).WithBlockTime(time.Unix(0, 0).UTC().Add(time.Duration(i) * time.Hour))
+		items[i] = types.AuditLogEntry{
+			Denom:    testDenom,
+			Action:   types.AuditActionUpdatePauser,
+			Actor:    sample.AccAddress(),
+			Target:   sample.AccAddress(),
+			NewValue: "pauser",
+		}
+		items[i].Id = keeper.AppendAuditLogEntry(blockCtx, items[i])
+		items[i].Height = blockCtx.BlockHeight()
+		items[i].Time = blockCtx.BlockTime()
+	}
+	return items
+}
+
+func TestAuditLogEntryGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNAuditLogEntries(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetAuditLogEntry(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+	require.Equal(t, uint64(len(items)), keeper.GetAuditLogCount(ctx))
+}
+
+func TestAuditLogEntryGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNAuditLogEntries(keeper, ctx, 10)
+	require.Equal(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllAuditLogEntries(ctx)),
+	)
+}
+
+func TestPruneAuditLog(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNAuditLogEntries(keeper, ctx, 5)
+
+	// without a retention nothing is pruned
+	ctx = ctx.WithBlockTime(items[4].Time.Add(365 * 24 * time.Hour))
+	keeper.PruneAuditLog(ctx)
+	require.Len(t, keeper.GetAllAuditLogEntries(ctx), 5)
+
+	keeper.SetParams(ctx, types.NewParams(2*time.Hour, types.DefaultReferenceIDRetention, types.DefaultRedemptionTimeout, types.DefaultMaxAttestationAge, types.DefaultMinRedemptionAmount, types.DefaultRedemptionRetention))
+
+	// entries recorded more than two hours before the block time are pruned
+	ctx = ctx.WithBlockTime(items[2].Time.Add(2 * time.Hour))
+	keeper.PruneAuditLog(ctx)
+	require.Equal(t,
+		nullify.Fill(items[2:]),
+		nullify.Fill(keeper.GetAllAuditLogEntries(ctx)),
+	)
+
+	// the count keeps increasing so pruned ids are never reused
+	require.Equal(t, uint64(5), keeper.GetAuditLogCount(ctx))
+}
+
+func TestAuditLogRecordsAdminActions(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0).UTC())
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner, masterMinter, controller, minter := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	pauser, blacklister, blacklisted := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetOwner(ctx, types.Owner{Address: owner, Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+
+	_, err := server.UpdateMasterMinter(wctx, &types.MsgUpdateMasterMinter{From: owner, Address: masterMinter, Denom: testDenom})
+	require.NoError(t, err)
+	_, err = server.UpdatePauser(wctx, &types.MsgUpdatePauser{From: owner, Address: pauser, Denom: testDenom})
+	require.NoError(t, err)
+	_, err = server.UpdateBlacklister(wctx, &types.MsgUpdateBlacklister{From: owner, Address: blacklister, Denom: testDenom})
+	require.NoError(t, err)
+	_, err = server.ConfigureMinterController(wctx, &types.MsgConfigureMinterController{From: masterMinter, Controller: controller, Minter: minter, Denom: testDenom})
+	require.NoError(t, err)
+	_, err = server.ConfigureMinter(wctx, &types.MsgConfigureMinter{From: controller, Address: minter, Allowance: sdk.NewInt64Coin(testDenom, 100)})
+	require.NoError(t, err)
+	_, err = server.IncreaseMinterAllowance(wctx, &types.MsgIncreaseMinterAllowance{From: controller, Address: minter, Increment: sdk.NewInt64Coin(testDenom, 50)})
+	require.NoError(t, err)
+	_, err = server.Blacklist(wctx, types.NewMsgBlacklist(blacklister, blacklisted, testDenom, "sanctions", "", nil))
+	require.NoError(t, err)
+	_, err = server.Unblacklist(wctx, &types.MsgUnblacklist{From: blacklister, Address: blacklisted, Denom: testDenom})
+	require.NoError(t, err)
+	_, err = server.Pause(wctx, types.NewMsgPause(pauser, testDenom, []types.PauseOperation{types.PauseMint}))
+	require.NoError(t, err)
+	_, err = server.Unpause(wctx, types.NewMsgUnpause(pauser, testDenom, nil))
+	require.NoError(t, err)
+
+	// a failed action is not recorded
+	_, err = server.UpdatePauser(wctx, &types.MsgUpdatePauser{From: pauser, Address: sample.AccAddress(), Denom: testDenom})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	expected := []types.AuditLogEntry{
+		{Action: types.AuditActionUpdateMasterMinter, Actor: owner, Target: masterMinter, NewValue: masterMinter},
+		{Action: types.AuditActionUpdatePauser, Actor: owner, Target: pauser, NewValue: pauser},
+		{Action: types.AuditActionUpdateBlacklister, Actor: owner, Target: blacklister, NewValue: blacklister},
+		{Action: types.AuditActionConfigureMinterController, Actor: masterMinter, Target: controller, NewValue: minter},
+		{Action: types.AuditActionConfigureMinter, Actor: controller, Target: minter, NewValue: "100" + testDenom},
+		{Action: types.AuditActionIncreaseMinterAllowance, Actor: controller, Target: minter, OldValue: "100" + testDenom, NewValue: "150" + testDenom},
+		{Action: types.AuditActionBlacklist, Actor: blacklister, Target: blacklisted, NewValue: "sanctions"},
+		{Action: types.AuditActionUnblacklist, Actor: blacklister, Target: blacklisted, OldValue: "sanctions"},
+		{Action: types.AuditActionPause, Actor: pauser, NewValue: "PAUSE_OPERATION_MINT"},
+		{Action: types.AuditActionUnpause, Actor: pauser, OldValue: "PAUSE_OPERATION_MINT"},
+	}
+	for i := range expected {
+		expected[i].Id = uint64(i)
+		expected[i].Denom = testDenom
+		expected[i].Height = 10
+		expected[i].Time = ctx.BlockTime()
+	}
+
+	require.Equal(t, expected, k.GetAllAuditLogEntries(ctx))
+}
+
+func TestAuditLogRecordsSupplyAndScheduleActions(t *testing.T) {
+	bankKeeper := keepertest.NewMockSupplyBankKeeper()
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	server := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0).UTC())
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner, pauser, minter, blacklisted := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.TestAccount()
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetOwner(ctx, types.Owner{Address: owner, Denom: testDenom})
+	k.SetPauser(ctx, types.Pauser{Address: pauser, Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklisted.AddressBz, Denom: testDenom})
+
+	balance := sdk.NewCoins(sdk.NewInt64Coin(testDenom, 30))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, balance))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, blacklisted.AddressBz, balance))
+
+	_, err := server.SetMaxSupply(wctx, types.NewMsgSetMaxSupply(owner, sdk.NewInt64Coin(testDenom, 1000)))
+	require.NoError(t, err)
+	_, err = server.SetMintRateLimit(wctx, types.NewMsgSetMintRateLimit(owner, minter, time.Hour, sdk.NewInt64Coin(testDenom, 100)))
+	require.NoError(t, err)
+	_, err = server.RemoveMintRateLimit(wctx, types.NewMsgRemoveMintRateLimit(owner, minter, testDenom))
+	require.NoError(t, err)
+	_, err = server.WipeBlacklistedBalance(wctx, types.NewMsgWipeBlacklistedBalance(owner, blacklisted.Address, testDenom, "case-1"))
+	require.NoError(t, err)
+	_, err = server.SchedulePause(wctx, types.NewMsgSchedulePause(pauser, testDenom, []types.PauseOperation{types.PauseMint}, 20, nil, 30, nil))
+	require.NoError(t, err)
+	_, err = server.CancelPauseSchedule(wctx, types.NewMsgCancelPauseSchedule(pauser, testDenom, 0))
+	require.NoError(t, err)
+
+	window := "PAUSE_OPERATION_MINT from height 20 to height 30"
+	expected := []types.AuditLogEntry{
+		{Action: types.AuditActionSetMaxSupply, Actor: owner, OldValue: "0" + testDenom, NewValue: "1000" + testDenom},
+		{Action: types.AuditActionSetMintRateLimit, Actor: owner, Target: minter, NewValue: "100" + testDenom + " per 1h0m0s"},
+		{Action: types.AuditActionRemoveMintRateLimit, Actor: owner, Target: minter, OldValue: "100" + testDenom + " per 1h0m0s"},
+		{Action: types.AuditActionWipeBlacklistedBalance, Actor: owner, Target: blacklisted.Address, OldValue: "30" + testDenom, NewValue: "case-1"},
+		{Action: types.AuditActionSchedulePause, Actor: pauser, Target: "0", NewValue: window},
+		{Action: types.AuditActionCancelPauseSchedule, Actor: pauser, Target: "0", OldValue: window},
+	}
+	for i := range expected {
+		expected[i].Id = uint64(i)
+		expected[i].Denom = testDenom
+		expected[i].Height = 10
+		expected[i].Time = ctx.BlockTime()
+	}
+
+	require.Equal(t, expected, k.GetAllAuditLogEntries(ctx))
+}
+
+func TestAuditLogRecordsModuleActions(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0).UTC())
+
+	blacklister, blacklisted := sample.AccAddress(), sample.AccAddress()
+	k.SetBlacklister(ctx, types.Blacklister{Address: blacklister, Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+
+	expiry := ctx.BlockTime().Add(time.Hour)
+	_, err := server.Blacklist(sdk.WrapSDKContext(ctx), types.NewMsgBlacklist(blacklister, blacklisted, testDenom, "fraud", "", &expiry))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(expiry)
+	require.NoError(t, k.UnblacklistExpired(ctx))
+
+	entries := k.GetAllAuditLogEntries(ctx)
+	require.Len(t, entries, 2)
+	require.Equal(t, types.AuditActionUnblacklist, entries[1].Action)
+	require.Equal(t, authtypes.NewModuleAddress(types.ModuleName).String(), entries[1].Actor)
+	require.Equal(t, blacklisted, entries[1].Target)
+	require.Equal(t, "fraud", entries[1].OldValue)
+}
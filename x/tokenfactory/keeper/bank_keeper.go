@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// FrozenBankKeeper wraps the bank keeper of the app so that no module can send the amount frozen
+// on an address, as opposed to the ante handler which only checks the messages of a transaction.
+// It is given to every module but the tokenfactory, which moves frozen amounts itself when wiping
+// a blacklisted balance.
+type FrozenBankKeeper struct {
+	bankkeeper.Keeper
+	tokenFactory *Keeper
+}
+
+var _ bankkeeper.Keeper = (*FrozenBankKeeper)(nil)
+
+func NewFrozenBankKeeper(bankKeeper bankkeeper.Keeper) *FrozenBankKeeper {
+	return &FrozenBankKeeper{Keeper: bankKeeper}
+}
+
+// SetTokenFactoryKeeper sets the keeper the frozen amounts are read from, which is created after
+// the bank keeper. Sends are not restricted until it is set.
+func (k *FrozenBankKeeper) SetTokenFactoryKeeper(tokenFactory *Keeper) {
+	k.tokenFactory = tokenFactory
+}
+
+func (k *FrozenBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkFrozen(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+func (k *FrozenBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	for _, input := range inputs {
+		address, err := sdk.AccAddressFromBech32(input.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.checkFrozen(ctx, address, input.Coins); err != nil {
+			return err
+		}
+	}
+	return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+func (k *FrozenBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.checkFrozen(ctx, senderAddr, amt); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+func (k *FrozenBankKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkFrozen(ctx, delegatorAddr, amt); err != nil {
+		return err
+	}
+	return k.Keeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt)
+}
+
+func (k *FrozenBankKeeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.checkFrozen(ctx, senderAddr, amt); err != nil {
+		return err
+	}
+	return k.Keeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+func (k *FrozenBankKeeper) checkFrozen(ctx sdk.Context, address sdk.AccAddress, amt sdk.Coins) error {
+	if k.tokenFactory == nil {
+		return nil
+	}
+
+	for _, coin := range amt {
+		if err := k.tokenFactory.CheckFrozen(ctx, address.String(), coin); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
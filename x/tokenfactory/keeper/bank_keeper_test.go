@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// sendBankKeeper is a bank keeper whose sends move the balances of a MockSupplyBankKeeper. Its other
+// methods are not implemented.
+type sendBankKeeper struct {
+	bankkeeper.Keeper
+	mock *keepertest.MockSupplyBankKeeper
+}
+
+func (k sendBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.mock.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+func (k sendBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return k.mock.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+func TestFrozenBankKeeper(t *testing.T) {
+	bankKeeper := keepertest.NewMockSupplyBankKeeper()
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	blacklister, minter := sample.AccAddress(), sample.AccAddress()
+	user, other := sample.TestAccount(), sample.TestAccount()
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(testDenom, sdk.NewInt(amount))
+	}
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+	k.SetBlacklister(ctx, types.Blacklister{Address: blacklister, Denom: testDenom})
+	k.SetMinters(ctx, types.Minters{Address: minter, Allowance: coin(1000), Denom: testDenom})
+
+	_, err := server.Mint(wctx, types.NewMsgMint(minter, user.Address, coin(100)))
+	require.NoError(t, err)
+	_, err = server.FreezeAmount(wctx, types.NewMsgFreezeAmount(blacklister, user.Address, coin(70)))
+	require.NoError(t, err)
+
+	frozenBankKeeper := keeper.NewFrozenBankKeeper(sendBankKeeper{mock: bankKeeper})
+
+	// sends are not restricted until the tokenfactory keeper is set
+	require.NoError(t, frozenBankKeeper.SendCoins(ctx, user.AddressBz, other.AddressBz, sdk.NewCoins(coin(10))))
+	require.NoError(t, frozenBankKeeper.SendCoins(ctx, other.AddressBz, user.AddressBz, sdk.NewCoins(coin(10))))
+
+	frozenBankKeeper.SetTokenFactoryKeeper(k)
+
+	// sends of other modules, which do not go through the ante handler, can not spend the frozen amount
+	err = frozenBankKeeper.SendCoins(ctx, user.AddressBz, other.AddressBz, sdk.NewCoins(coin(31)))
+	require.ErrorIs(t, err, types.ErrFrozenAmount)
+	err = frozenBankKeeper.SendCoinsFromAccountToModule(ctx, user.AddressBz, types.ModuleName, sdk.NewCoins(coin(31)))
+	require.ErrorIs(t, err, types.ErrFrozenAmount)
+	err = frozenBankKeeper.DelegateCoinsFromAccountToModule(ctx, user.AddressBz, types.ModuleName, sdk.NewCoins(coin(31)))
+	require.ErrorIs(t, err, types.ErrFrozenAmount)
+	err = frozenBankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{banktypes.NewInput(user.AddressBz, sdk.NewCoins(coin(31)))},
+		[]banktypes.Output{banktypes.NewOutput(other.AddressBz, sdk.NewCoins(coin(31)))},
+	)
+	require.ErrorIs(t, err, types.ErrFrozenAmount)
+
+	// the balance above the frozen amount can be sent
+	require.NoError(t, frozenBankKeeper.SendCoins(ctx, user.AddressBz, other.AddressBz, sdk.NewCoins(coin(20))))
+	require.NoError(t, frozenBankKeeper.SendCoinsFromAccountToModule(ctx, user.AddressBz, types.ModuleName, sdk.NewCoins(coin(10))))
+	require.Equal(t, sdk.NewInt(70), bankKeeper.SpendableCoins(ctx, user.AddressBz).AmountOf(testDenom))
+	require.Equal(t, sdk.NewInt(20), bankKeeper.SpendableCoins(ctx, other.AddressBz).AmountOf(testDenom))
+
+	// addresses without a frozen amount are not restricted
+	require.NoError(t, frozenBankKeeper.SendCoins(ctx, other.AddressBz, user.AddressBz, sdk.NewCoins(coin(20))))
+}
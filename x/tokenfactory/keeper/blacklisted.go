@@ -1,23 +1,35 @@
 package keeper
 
 import (
+	"errors"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 )
 
-// SetBlacklisted set a specific blacklisted in the store from its index
+// SetBlacklisted set a specific blacklisted in the store from its index, along with its entry in the expiry index
 func (k Keeper) SetBlacklisted(ctx sdk.Context, blacklisted types.Blacklisted) {
+	if existing, found := k.GetBlacklisted(ctx, blacklisted.Denom, blacklisted.AddressBz); found {
+		k.removeBlacklistedExpiry(ctx, existing)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistedKeyPrefix))
 	b := k.cdc.MustMarshal(&blacklisted)
-	store.Set(types.BlacklistedKey(blacklisted.AddressBz), b)
+	key := types.BlacklistedKey(blacklisted.Denom, blacklisted.AddressBz)
+	store.Set(key, b)
+
+	if blacklisted.Expiry != nil {
+		expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistedExpiryKeyPrefix))
+		expiryStore.Set(types.BlacklistedExpiryKey(*blacklisted.Expiry, blacklisted.Denom, blacklisted.AddressBz), key)
+	}
 }
 
 // GetBlacklisted returns a blacklisted from its index
-func (k Keeper) GetBlacklisted(ctx sdk.Context, addressBz []byte) (val types.Blacklisted, found bool) {
+func (k Keeper) GetBlacklisted(ctx sdk.Context, denom string, addressBz []byte) (val types.Blacklisted, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistedKeyPrefix))
 
-	b := store.Get(types.BlacklistedKey(addressBz))
+	b := store.Get(types.BlacklistedKey(denom, addressBz))
 	if b == nil {
 		return val, false
 	}
@@ -26,10 +38,23 @@ func (k Keeper) GetBlacklisted(ctx sdk.Context, addressBz []byte) (val types.Bla
 	return val, true
 }
 
-// RemoveBlacklisted removes a blacklisted from the store
-func (k Keeper) RemoveBlacklisted(ctx sdk.Context, addressBz []byte) {
+// RemoveBlacklisted removes a blacklisted, along with its entry in the expiry index, from the store
+func (k Keeper) RemoveBlacklisted(ctx sdk.Context, denom string, addressBz []byte) {
+	if existing, found := k.GetBlacklisted(ctx, denom, addressBz); found {
+		k.removeBlacklistedExpiry(ctx, existing)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistedKeyPrefix))
-	store.Delete(types.BlacklistedKey(addressBz))
+	store.Delete(types.BlacklistedKey(denom, addressBz))
+}
+
+func (k Keeper) removeBlacklistedExpiry(ctx sdk.Context, blacklisted types.Blacklisted) {
+	if blacklisted.Expiry == nil {
+		return
+	}
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistedExpiryKeyPrefix))
+	expiryStore.Delete(types.BlacklistedExpiryKey(*blacklisted.Expiry, blacklisted.Denom, blacklisted.AddressBz))
 }
 
 // GetAllBlacklisted returns all blacklisted
@@ -47,3 +72,55 @@ func (k Keeper) GetAllBlacklisted(ctx sdk.Context) (list []types.Blacklisted) {
 
 	return
 }
+
+// UnblacklistExpired removes every blacklisted whose expiry is at or before the current block time.
+// It is called at the end of every block. A blacklisted whose removal fails is kept until the next
+// block, without affecting the others.
+func (k Keeper) UnblacklistExpired(ctx sdk.Context) error {
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistedExpiryKeyPrefix))
+	iterator := expiryStore.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Value())
+	}
+	iterator.Close()
+
+	var errs []error
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistedKeyPrefix))
+	for _, key := range keys {
+		var blacklisted types.Blacklisted
+		k.cdc.MustUnmarshal(store.Get(key), &blacklisted)
+
+		if err := runCached(ctx, func(ctx sdk.Context) error {
+			return k.unblacklistExpired(ctx, blacklisted)
+		}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (k Keeper) unblacklistExpired(ctx sdk.Context, blacklisted types.Blacklisted) error {
+	address := sdk.AccAddress(blacklisted.AddressBz).String()
+
+	k.RemoveBlacklisted(ctx, blacklisted.Denom, blacklisted.AddressBz)
+	k.recordAudit(ctx, blacklisted.Denom, types.AuditActionUnblacklist, moduleActor(), address, blacklisted.Reason, "")
+
+	if err := k.releaseHeldRedemptions(ctx, blacklisted.Denom, address); err != nil {
+		return err
+	}
+
+	if err := k.afterUnblacklist(ctx, blacklisted.Denom, address); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventBlacklistExpired{
+		Address: address,
+		Denom:   blacklisted.Denom,
+		Reason:  blacklisted.Reason,
+		CaseID:  blacklisted.CaseID,
+	})
+}
@@ -27,6 +27,7 @@ func createNBlacklisted(keeper *keeper.Keeper, ctx sdk.Context, n int) []blackli
 		acc := sample.TestAccount()
 		items[i].address = acc.Address
 		items[i].bl.AddressBz = acc.AddressBz
+		items[i].bl.Denom = testDenom
 
 		keeper.SetBlacklisted(ctx, items[i].bl)
 	}
@@ -38,6 +39,7 @@ func TestBlacklistedGet(t *testing.T) {
 	items := createNBlacklisted(keeper, ctx, 10)
 	for _, item := range items {
 		rst, found := keeper.GetBlacklisted(ctx,
+			testDenom,
 			item.bl.AddressBz,
 		)
 		require.True(t, found)
@@ -53,9 +55,11 @@ func TestBlacklistedRemove(t *testing.T) {
 	items := createNBlacklisted(keeper, ctx, 10)
 	for _, item := range items {
 		keeper.RemoveBlacklisted(ctx,
+			testDenom,
 			item.bl.AddressBz,
 		)
 		_, found := keeper.GetBlacklisted(ctx,
+			testDenom,
 			item.bl.AddressBz,
 		)
 		require.False(t, found)
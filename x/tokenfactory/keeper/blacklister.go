@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,14 +11,14 @@ import (
 func (k Keeper) SetBlacklister(ctx sdk.Context, blacklister types.Blacklister) {
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshal(&blacklister)
-	store.Set(types.KeyPrefix(types.BlacklisterKey), b)
+	store.Set(types.DenomPrefix(types.BlacklisterKey, blacklister.Denom), b)
 }
 
 // GetBlacklister returns blacklister
-func (k Keeper) GetBlacklister(ctx sdk.Context) (val types.Blacklister, found bool) {
+func (k Keeper) GetBlacklister(ctx sdk.Context, denom string) (val types.Blacklister, found bool) {
 	store := ctx.KVStore(k.storeKey)
 
-	b := store.Get(types.KeyPrefix(types.BlacklisterKey))
+	b := store.Get(types.DenomPrefix(types.BlacklisterKey, denom))
 	if b == nil {
 		return val, false
 	}
@@ -25,3 +26,19 @@ func (k Keeper) GetBlacklister(ctx sdk.Context) (val types.Blacklister, found bo
 	k.cdc.MustUnmarshal(b, &val)
 	return val, true
 }
+
+// GetAllBlacklisters returns the blacklister of every denom
+func (k Keeper) GetAllBlacklisters(ctx sdk.Context) (list []types.Blacklister) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklisterKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Blacklister
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -13,7 +13,7 @@ import (
 )
 
 func createTestBlacklister(keeper *keeper.Keeper, ctx sdk.Context) types.Blacklister {
-	item := types.Blacklister{}
+	item := types.Blacklister{Denom: testDenom}
 	keeper.SetBlacklister(ctx, item)
 	return item
 }
@@ -21,7 +21,7 @@ func createTestBlacklister(keeper *keeper.Keeper, ctx sdk.Context) types.Blackli
 func TestBlacklisterGet(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	item := createTestBlacklister(keeper, ctx)
-	rst, found := keeper.GetBlacklister(ctx)
+	rst, found := keeper.GetBlacklister(ctx, testDenom)
 	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&item),
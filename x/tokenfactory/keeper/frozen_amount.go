@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// SetFrozenAmount set a specific frozenAmount in the store from its index, removing it once the
+// amount is zero, and keeps the total frozen amount of the denom in sync.
+func (k Keeper) SetFrozenAmount(ctx sdk.Context, frozenAmount types.FrozenAmount) {
+	denom := frozenAmount.Amount.Denom
+
+	previous := k.GetFrozenAmount(ctx, denom, frozenAmount.AddressBz)
+	total := k.GetTotalFrozen(ctx, denom).Sub(previous.Amount).Add(frozenAmount.Amount)
+	k.setTotalFrozen(ctx, total)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FrozenAmountKeyPrefix))
+	if frozenAmount.Amount.IsZero() {
+		store.Delete(types.FrozenAmountKey(denom, frozenAmount.AddressBz))
+		return
+	}
+
+	b := k.cdc.MustMarshal(&frozenAmount)
+	store.Set(types.FrozenAmountKey(denom, frozenAmount.AddressBz), b)
+}
+
+// GetFrozenAmount returns the amount of a denom frozen on an address, which is zero unless an
+// amount has been frozen.
+func (k Keeper) GetFrozenAmount(ctx sdk.Context, denom string, addressBz []byte) types.FrozenAmount {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FrozenAmountKeyPrefix))
+
+	b := store.Get(types.FrozenAmountKey(denom, addressBz))
+	if b == nil {
+		return types.FrozenAmount{AddressBz: addressBz, Amount: sdk.Coin{Denom: denom, Amount: sdk.ZeroInt()}}
+	}
+
+	var val types.FrozenAmount
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// GetAllFrozenAmounts returns all frozenAmount
+func (k Keeper) GetAllFrozenAmounts(ctx sdk.Context) (list []types.FrozenAmount) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FrozenAmountKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FrozenAmount
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetTotalFrozen returns the amount of a denom frozen across all addresses
+func (k Keeper) GetTotalFrozen(ctx sdk.Context, denom string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(types.DenomPrefix(types.TotalFrozenKeyPrefix, denom))
+	if b == nil {
+		return sdk.Coin{Denom: denom, Amount: sdk.ZeroInt()}
+	}
+
+	var val sdk.Coin
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+func (k Keeper) setTotalFrozen(ctx sdk.Context, total sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.DenomPrefix(types.TotalFrozenKeyPrefix, total.Denom)
+
+	if total.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&total))
+}
+
+// CheckFrozen returns ErrFrozenAmount if sending amount from address would bring its spendable
+// balance below the amount of the denom frozen on it.
+func (k Keeper) CheckFrozen(ctx sdk.Context, address string, amount sdk.Coin) error {
+	if !k.MintingDenomSet(ctx, amount.Denom) {
+		return nil
+	}
+
+	_, addressBz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return err
+	}
+
+	frozen := k.GetFrozenAmount(ctx, amount.Denom, addressBz)
+	if frozen.Amount.IsZero() {
+		return nil
+	}
+
+	spendable := k.bankKeeper.SpendableCoins(ctx, addressBz).AmountOf(amount.Denom)
+	if spendable.Sub(amount.Amount).LT(frozen.Amount.Amount) {
+		return sdkerrors.Wrapf(
+			types.ErrFrozenAmount,
+			"sending %s would bring the balance of %s below its frozen amount of %s",
+			amount, address, frozen.Amount,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AdminQuorum(c context.Context, req *types.QueryGetAdminQuorumRequest) (*types.QueryGetAdminQuorumResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetAdminQuorum(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetAdminQuorumResponse{AdminQuorum: val}, nil
+}
+
+func (k Keeper) AdminProposalAll(c context.Context, req *types.QueryAllAdminProposalRequest) (*types.QueryAllAdminProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var adminProposals []types.AdminProposal
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	adminProposalStore := prefix.NewStore(store, types.KeyPrefix(types.AdminProposalKeyPrefix))
+	if req.Denom != "" {
+		adminProposalStore = prefix.NewStore(store, types.DenomPrefix(types.AdminProposalKeyPrefix, req.Denom))
+	}
+
+	pageRes, err := query.Paginate(adminProposalStore, req.Pagination, func(key []byte, value []byte) error {
+		var adminProposal types.AdminProposal
+		if err := k.cdc.Unmarshal(value, &adminProposal); err != nil {
+			return err
+		}
+
+		adminProposals = append(adminProposals, adminProposal)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAdminProposalResponse{AdminProposal: adminProposals, Pagination: pageRes}, nil
+}
+
+func (k Keeper) AdminProposal(c context.Context, req *types.QueryGetAdminProposalRequest) (*types.QueryGetAdminProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetAdminProposal(ctx, req.Denom, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetAdminProposalResponse{AdminProposal: val}, nil
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestAdminQuorumQuery(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	quorum := types.AdminQuorum{
+		Denom:        testDenom,
+		Approvers:    []string{sample.AccAddress(), sample.AccAddress()},
+		Threshold:    1,
+		VotingPeriod: time.Hour,
+	}
+	keeper.SetAdminQuorum(ctx, quorum)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetAdminQuorumRequest
+		response *types.QueryGetAdminQuorumResponse
+		err      error
+	}{
+		{
+			desc:     "Found",
+			request:  &types.QueryGetAdminQuorumRequest{Denom: testDenom},
+			response: &types.QueryGetAdminQuorumResponse{AdminQuorum: quorum},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetAdminQuorumRequest{Denom: "uother"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.AdminQuorum(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestAdminProposalQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNAdminProposals(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetAdminProposalRequest
+		response *types.QueryGetAdminProposalResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetAdminProposalRequest{Denom: testDenom, Id: msgs[0].Id},
+			response: &types.QueryGetAdminProposalResponse{AdminProposal: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetAdminProposalRequest{Denom: testDenom, Id: msgs[1].Id},
+			response: &types.QueryGetAdminProposalResponse{AdminProposal: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetAdminProposalRequest{Denom: testDenom, Id: 100000},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.AdminProposal(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestAdminProposalQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNAdminProposals(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllAdminProposalRequest {
+		return &types.QueryAllAdminProposalRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.AdminProposalAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.AdminProposal), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.AdminProposal),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.AdminProposalAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.AdminProposal), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.AdminProposal),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.AdminProposalAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.AdminProposal),
+		)
+	})
+	t.Run("ByDenom", func(t *testing.T) {
+		resp, err := keeper.AdminProposalAll(wctx, &types.QueryAllAdminProposalRequest{Denom: "uother"})
+		require.NoError(t, err)
+		require.Empty(t, resp.AdminProposal)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.AdminProposalAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AllowlistedAll(c context.Context, req *types.QueryAllAllowlistedRequest) (*types.QueryAllAllowlistedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var allowlisteds []types.Allowlisted
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	allowlistedStore := prefix.NewStore(store, types.DenomPrefix(types.AllowlistedKeyPrefix, req.Denom))
+
+	pageRes, err := query.Paginate(allowlistedStore, req.Pagination, func(key []byte, value []byte) error {
+		var allowlisted types.Allowlisted
+		if err := k.cdc.Unmarshal(value, &allowlisted); err != nil {
+			return err
+		}
+
+		allowlisteds = append(allowlisteds, allowlisted)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAllowlistedResponse{Allowlisted: allowlisteds, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Allowlisted(c context.Context, req *types.QueryGetAllowlistedRequest) (*types.QueryGetAllowlistedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, addressBz, err := bech32.DecodeAndConvert(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	val, found := k.GetAllowlisted(ctx, req.Denom, addressBz)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetAllowlistedResponse{Allowlisted: val}, nil
+}
+
+func (k Keeper) AllowlistMode(c context.Context, req *types.QueryGetAllowlistModeRequest) (*types.QueryGetAllowlistModeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.MintingDenomSet(ctx, req.Denom) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetAllowlistModeResponse{AllowlistMode: k.GetAllowlistMode(ctx, req.Denom)}, nil
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AuditLogEntryAll(c context.Context, req *types.QueryAllAuditLogEntryRequest) (*types.QueryAllAuditLogEntryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.MaxHeight > 0 && req.MaxHeight < req.MinHeight {
+		return nil, status.Error(codes.InvalidArgument, "max height is lower than min height")
+	}
+
+	var entries []types.AuditLogEntry
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	auditLogStore := prefix.NewStore(store, types.KeyPrefix(types.AuditLogKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(auditLogStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var entry types.AuditLogEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return false, err
+		}
+
+		if (req.Denom != "" && entry.Denom != req.Denom) ||
+			(req.Action != types.AuditActionUnspecified && entry.Action != req.Action) ||
+			(req.Actor != "" && entry.Actor != req.Actor) ||
+			(req.MinHeight > 0 && entry.Height < req.MinHeight) ||
+			(req.MaxHeight > 0 && entry.Height > req.MaxHeight) {
+			return false, nil
+		}
+
+		if accumulate {
+			entries = append(entries, entry)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAuditLogEntryResponse{AuditLogEntry: entries, Pagination: pageRes}, nil
+}
+
+func (k Keeper) AuditLogEntry(c context.Context, req *types.QueryGetAuditLogEntryRequest) (*types.QueryGetAuditLogEntryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetAuditLogEntry(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetAuditLogEntryResponse{AuditLogEntry: val}, nil
+}
@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestAuditLogEntryQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNAuditLogEntries(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetAuditLogEntryRequest
+		response *types.QueryGetAuditLogEntryResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetAuditLogEntryRequest{Id: msgs[0].Id},
+			response: &types.QueryGetAuditLogEntryResponse{AuditLogEntry: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetAuditLogEntryRequest{Id: msgs[1].Id},
+			response: &types.QueryGetAuditLogEntryResponse{AuditLogEntry: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetAuditLogEntryRequest{Id: 100000},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.AuditLogEntry(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestAuditLogEntryQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNAuditLogEntries(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllAuditLogEntryRequest {
+		return &types.QueryAllAuditLogEntryRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.AuditLogEntryAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.AuditLogEntry), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.AuditLogEntry),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.AuditLogEntryAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.AuditLogEntry), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.AuditLogEntry),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.AuditLogEntryAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.AuditLogEntry),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.AuditLogEntryAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestAuditLogEntryQueryFiltered(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNAuditLogEntries(keeper, ctx, 5)
+
+	// record a different action of another denom by the actor of the first entry
+	other := types.AuditLogEntry{Denom: "uother", Action: types.AuditActionPause, Actor: msgs[0].Actor}
+	other.Id = keeper.AppendAuditLogEntry(ctx.WithBlockHeight(6), other)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.QueryAllAuditLogEntryRequest
+		ids     []uint64
+		err     error
+	}{
+		{
+			desc:    "ByDenom",
+			request: &types.QueryAllAuditLogEntryRequest{Denom: "uother"},
+			ids:     []uint64{other.Id},
+		},
+		{
+			desc:    "ByAction",
+			request: &types.QueryAllAuditLogEntryRequest{Action: types.AuditActionUpdatePauser},
+			ids:     []uint64{0, 1, 2, 3, 4},
+		},
+		{
+			desc:    "ByActor",
+			request: &types.QueryAllAuditLogEntryRequest{Actor: msgs[0].Actor},
+			ids:     []uint64{0, other.Id},
+		},
+		{
+			desc:    "ByHeightRange",
+			request: &types.QueryAllAuditLogEntryRequest{MinHeight: 2, MaxHeight: 4},
+			ids:     []uint64{1, 2, 3},
+		},
+		{
+			desc:    "ByMinHeight",
+			request: &types.QueryAllAuditLogEntryRequest{MinHeight: 5},
+			ids:     []uint64{4, other.Id},
+		},
+		{
+			desc:    "Combined",
+			request: &types.QueryAllAuditLogEntryRequest{Actor: msgs[0].Actor, Action: types.AuditActionPause, MaxHeight: 5},
+		},
+		{
+			desc:    "InvalidHeightRange",
+			request: &types.QueryAllAuditLogEntryRequest{MinHeight: 4, MaxHeight: 2},
+			err:     status.Error(codes.InvalidArgument, "max height is lower than min height"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := keeper.AuditLogEntryAll(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			var ids []uint64
+			for _, entry := range resp.AuditLogEntry {
+				ids = append(ids, entry.Id)
+			}
+			require.Equal(t, tc.ids, ids)
+		})
+	}
+}
@@ -21,16 +21,22 @@ func (k Keeper) BlacklistedAll(c context.Context, req *types.QueryAllBlacklisted
 	ctx := sdk.UnwrapSDKContext(c)
 
 	store := ctx.KVStore(k.storeKey)
-	blacklistedStore := prefix.NewStore(store, types.KeyPrefix(types.BlacklistedKeyPrefix))
+	blacklistedStore := prefix.NewStore(store, types.DenomPrefix(types.BlacklistedKeyPrefix, req.Denom))
 
-	pageRes, err := query.Paginate(blacklistedStore, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(blacklistedStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var blacklisted types.Blacklisted
 		if err := k.cdc.Unmarshal(value, &blacklisted); err != nil {
-			return err
+			return false, err
 		}
 
-		blacklisteds = append(blacklisteds, blacklisted)
-		return nil
+		if req.Reason != "" && blacklisted.Reason != req.Reason {
+			return false, nil
+		}
+
+		if accumulate {
+			blacklisteds = append(blacklisteds, blacklisted)
+		}
+		return true, nil
 	})
 
 	if err != nil {
@@ -51,7 +57,7 @@ func (k Keeper) Blacklisted(c context.Context, req *types.QueryGetBlacklistedReq
 		return nil, err
 	}
 
-	val, found := k.GetBlacklisted(ctx, addressBz)
+	val, found := k.GetBlacklisted(ctx, req.Denom, addressBz)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
@@ -32,6 +32,7 @@ func TestBlacklistedQuerySingle(t *testing.T) {
 		{
 			desc: "First",
 			request: &types.QueryGetBlacklistedRequest{
+				Denom:   testDenom,
 				Address: msgs[0].address,
 			},
 			response: &types.QueryGetBlacklistedResponse{Blacklisted: msgs[0].bl},
@@ -39,6 +40,7 @@ func TestBlacklistedQuerySingle(t *testing.T) {
 		{
 			desc: "Second",
 			request: &types.QueryGetBlacklistedRequest{
+				Denom:   testDenom,
 				Address: msgs[1].address,
 			},
 			response: &types.QueryGetBlacklistedResponse{Blacklisted: msgs[1].bl},
@@ -46,6 +48,7 @@ func TestBlacklistedQuerySingle(t *testing.T) {
 		{
 			desc: "KeyNotFound",
 			request: &types.QueryGetBlacklistedRequest{
+				Denom:   testDenom,
 				Address: sample.AccAddress(),
 			},
 			err: status.Error(codes.NotFound, "not found"),
@@ -81,6 +84,7 @@ func TestBlacklistedQueryPaginated(t *testing.T) {
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllBlacklistedRequest {
 		return &types.QueryAllBlacklistedRequest{
+			Denom: testDenom,
 			Pagination: &query.PageRequest{
 				Key:        next,
 				Offset:     offset,
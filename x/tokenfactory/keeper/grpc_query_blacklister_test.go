@@ -25,7 +25,7 @@ func TestBlacklisterQuery(t *testing.T) {
 	}{
 		{
 			desc:     "First",
-			request:  &types.QueryGetBlacklisterRequest{},
+			request:  &types.QueryGetBlacklisterRequest{Denom: testDenom},
 			response: &types.QueryGetBlacklisterResponse{Blacklister: item},
 		},
 		{
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) FrozenAmountAll(c context.Context, req *types.QueryAllFrozenAmountRequest) (*types.QueryAllFrozenAmountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var frozenAmounts []types.FrozenAmount
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	frozenAmountStore := prefix.NewStore(store, types.DenomPrefix(types.FrozenAmountKeyPrefix, req.Denom))
+
+	pageRes, err := query.Paginate(frozenAmountStore, req.Pagination, func(key []byte, value []byte) error {
+		var frozenAmount types.FrozenAmount
+		if err := k.cdc.Unmarshal(value, &frozenAmount); err != nil {
+			return err
+		}
+
+		frozenAmounts = append(frozenAmounts, frozenAmount)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllFrozenAmountResponse{FrozenAmount: frozenAmounts, Pagination: pageRes}, nil
+}
+
+func (k Keeper) FrozenAmount(c context.Context, req *types.QueryGetFrozenAmountRequest) (*types.QueryGetFrozenAmountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.MintingDenomSet(ctx, req.Denom) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	_, addressBz, err := bech32.DecodeAndConvert(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetFrozenAmountResponse{FrozenAmount: k.GetFrozenAmount(ctx, req.Denom, addressBz)}, nil
+}
+
+func (k Keeper) TotalFrozen(c context.Context, req *types.QueryTotalFrozenRequest) (*types.QueryTotalFrozenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.MintingDenomSet(ctx, req.Denom) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryTotalFrozenResponse{Amount: k.GetTotalFrozen(ctx, req.Denom)}, nil
+}
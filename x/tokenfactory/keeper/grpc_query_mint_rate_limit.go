@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) MintRateLimitAll(c context.Context, req *types.QueryAllMintRateLimitRequest) (*types.QueryAllMintRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var mintRateLimits []types.MintRateLimit
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	mintRateLimitStore := prefix.NewStore(store, types.DenomPrefix(types.MintRateLimitKeyPrefix, req.Denom))
+
+	pageRes, err := query.Paginate(mintRateLimitStore, req.Pagination, func(key []byte, value []byte) error {
+		var mintRateLimit types.MintRateLimit
+		if err := k.cdc.Unmarshal(value, &mintRateLimit); err != nil {
+			return err
+		}
+
+		mintRateLimits = append(mintRateLimits, mintRateLimit)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllMintRateLimitResponse{MintRateLimit: mintRateLimits, Pagination: pageRes}, nil
+}
+
+func (k Keeper) MintRateLimit(c context.Context, req *types.QueryGetMintRateLimitRequest) (*types.QueryGetMintRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetMintRateLimit(
+		ctx,
+		req.Denom,
+		req.Minter,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	res := &types.QueryGetMintRateLimitResponse{MintRateLimit: val}
+
+	window := k.CurrentMintRateLimitWindow(ctx, val)
+	res.Minted = MintRateLimitMinted(val, window)
+	res.Remaining = MintRateLimitRemaining(val, window)
+
+	if len(window.Buckets) > 0 {
+		resetTime := mintRateLimitBucketEnd(val, window.Buckets[0])
+		res.ResetTime = &resetTime
+	}
+
+	return res, nil
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestMintRateLimitQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNMintRateLimit(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetMintRateLimitRequest
+		response *types.QueryGetMintRateLimitResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetMintRateLimitRequest{
+				Denom:  testDenom,
+				Minter: msgs[0].Minter,
+			},
+			response: &types.QueryGetMintRateLimitResponse{
+				MintRateLimit: msgs[0],
+				Minted:        sdk.NewCoin(testDenom, sdk.ZeroInt()),
+				Remaining:     msgs[0].Limit,
+			},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetMintRateLimitRequest{
+				Denom:  testDenom,
+				Minter: msgs[1].Minter,
+			},
+			response: &types.QueryGetMintRateLimitResponse{
+				MintRateLimit: msgs[1],
+				Minted:        sdk.NewCoin(testDenom, sdk.ZeroInt()),
+				Remaining:     msgs[1].Limit,
+			},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetMintRateLimitRequest{
+				Denom:  testDenom,
+				Minter: sample.AccAddress(),
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.MintRateLimit(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestMintRateLimitQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNMintRateLimit(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllMintRateLimitRequest {
+		return &types.QueryAllMintRateLimitRequest{
+			Denom: testDenom,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.MintRateLimitAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.MintRateLimit), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.MintRateLimit),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.MintRateLimitAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.MintRateLimit), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.MintRateLimit),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.MintRateLimitAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.MintRateLimit),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.MintRateLimitAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) MinterStats(c context.Context, req *types.QueryMinterStatsRequest) (*types.QueryMinterStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Minter == "" {
+		return nil, status.Error(codes.InvalidArgument, "minter can not be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res, err := k.mintStats(ctx, req.Denom, req.Minter, req.StartDate, req.EndDate, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMinterStatsResponse{
+		Lifetime:   res.Lifetime,
+		Daily:      res.Daily,
+		Minted:     res.Minted,
+		Burned:     res.Burned,
+		Pagination: res.Pagination,
+	}, nil
+}
+
+func (k Keeper) ModuleStats(c context.Context, req *types.QueryModuleStatsRequest) (*types.QueryModuleStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return k.mintStats(ctx, req.Denom, "", req.StartDate, req.EndDate, req.Pagination)
+}
+
+// mintStats returns the lifetime counters of a minter, or of all minters of the denom if the minter
+// is empty, along with a page of its daily counters and their totals within a date range.
+func (k Keeper) mintStats(
+	ctx sdk.Context,
+	denom string,
+	minter string,
+	startDate string,
+	endDate string,
+	pagination *query.PageRequest,
+) (*types.QueryModuleStatsResponse, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for _, date := range []string{startDate, endDate} {
+		if date == "" {
+			continue
+		}
+		if err := types.ValidateMintStatsDate(date); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if startDate != "" && endDate != "" && startDate > endDate {
+		return nil, status.Error(codes.InvalidArgument, "start date can not be after the end date")
+	}
+
+	res := &types.QueryModuleStatsResponse{
+		Lifetime: k.GetMintStats(ctx, denom, minter),
+		Minted:   sdk.NewCoin(denom, sdk.ZeroInt()),
+		Burned:   sdk.NewCoin(denom, sdk.ZeroInt()),
+	}
+
+	// the daily counters of a minter are keyed by date, which is formatted so that the lexical
+	// order of the keys matches their chronological order, so only the date range is iterated
+	dailyStore := dateRangeStore{
+		KVStore: prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefix(types.DailyMintStatsKeyPrefix), types.MintStatsKey(denom, minter)...)),
+	}
+	if startDate != "" {
+		dailyStore.start = []byte(startDate)
+	}
+	if endDate != "" {
+		dailyStore.end = sdk.PrefixEndBytes([]byte(endDate))
+	}
+
+	iterator := dailyStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var daily types.DailyMintStats
+		k.cdc.MustUnmarshal(iterator.Value(), &daily)
+		res.Minted = res.Minted.Add(daily.Minted)
+		res.Burned = res.Burned.Add(daily.Burned)
+	}
+	iterator.Close()
+
+	pageRes, err := query.Paginate(dailyStore, pagination, func(key []byte, value []byte) error {
+		var daily types.DailyMintStats
+		if err := k.cdc.Unmarshal(value, &daily); err != nil {
+			return err
+		}
+
+		res.Daily = append(res.Daily, daily)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res.Pagination = pageRes
+	return res, nil
+}
+
+// dateRangeStore restricts the iterators of a store to the keys in [start, end), so that a date range
+// can be paginated without iterating the keys outside of it. A nil bound leaves that side open.
+type dateRangeStore struct {
+	sdk.KVStore
+	start []byte
+	end   []byte
+}
+
+func (s dateRangeStore) Iterator(start, end []byte) sdk.Iterator {
+	start, end = s.bounds(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s dateRangeStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	start, end = s.bounds(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func (s dateRangeStore) bounds(start, end []byte) ([]byte, []byte) {
+	if s.start != nil && (start == nil || bytes.Compare(start, s.start) < 0) {
+		start = s.start
+	}
+	if s.end != nil && (end == nil || bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		start = end
+	}
+	return start, end
+}
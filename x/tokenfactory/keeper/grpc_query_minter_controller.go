@@ -21,7 +21,7 @@ func (k Keeper) MinterControllerAll(c context.Context, req *types.QueryAllMinter
 	ctx := sdk.UnwrapSDKContext(c)
 
 	store := ctx.KVStore(k.storeKey)
-	minterControllerStore := prefix.NewStore(store, types.KeyPrefix(types.MinterControllerKeyPrefix))
+	minterControllerStore := prefix.NewStore(store, types.DenomPrefix(types.MinterControllerKeyPrefix, req.Denom))
 
 	pageRes, err := query.Paginate(minterControllerStore, req.Pagination, func(key []byte, value []byte) error {
 		var minterController types.MinterController
@@ -48,7 +48,9 @@ func (k Keeper) MinterController(c context.Context, req *types.QueryGetMinterCon
 
 	val, found := k.GetMinterController(
 		ctx,
+		req.Denom,
 		req.ControllerAddress,
+		req.MinterAddress,
 	)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
@@ -56,3 +58,45 @@ func (k Keeper) MinterController(c context.Context, req *types.QueryGetMinterCon
 
 	return &types.QueryGetMinterControllerResponse{MinterController: val}, nil
 }
+
+func (k Keeper) MintersByController(c context.Context, req *types.QueryMintersByControllerRequest) (*types.QueryMintersByControllerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var minterControllers []types.MinterController
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerKeyPrefix))
+	minterControllerStore := prefix.NewStore(store, types.MinterControllerPrefix(req.Denom, req.ControllerAddress))
+
+	pageRes, err := query.Paginate(minterControllerStore, req.Pagination, func(key []byte, value []byte) error {
+		var minterController types.MinterController
+		if err := k.cdc.Unmarshal(value, &minterController); err != nil {
+			return err
+		}
+
+		minterControllers = append(minterControllers, minterController)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintersByControllerResponse{MinterController: minterControllers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ControllerByMinter(c context.Context, req *types.QueryControllerByMinterRequest) (*types.QueryControllerByMinterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetControllerByMinter(ctx, req.Denom, req.MinterAddress)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryControllerByMinterResponse{MinterController: val}, nil
+}
@@ -31,21 +31,27 @@ func TestMinterControllerQuerySingle(t *testing.T) {
 		{
 			desc: "First",
 			request: &types.QueryGetMinterControllerRequest{
+				Denom:             testDenom,
 				ControllerAddress: msgs[0].Controller,
+				MinterAddress:     msgs[0].Minter,
 			},
 			response: &types.QueryGetMinterControllerResponse{MinterController: msgs[0]},
 		},
 		{
 			desc: "Second",
 			request: &types.QueryGetMinterControllerRequest{
+				Denom:             testDenom,
 				ControllerAddress: msgs[1].Controller,
+				MinterAddress:     msgs[1].Minter,
 			},
 			response: &types.QueryGetMinterControllerResponse{MinterController: msgs[1]},
 		},
 		{
 			desc: "KeyNotFound",
 			request: &types.QueryGetMinterControllerRequest{
+				Denom:             testDenom,
 				ControllerAddress: strconv.Itoa(100000),
+				MinterAddress:     strconv.Itoa(100000),
 			},
 			err: status.Error(codes.NotFound, "not found"),
 		},
@@ -76,6 +82,7 @@ func TestMinterControllerQueryPaginated(t *testing.T) {
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllMinterControllerRequest {
 		return &types.QueryAllMinterControllerRequest{
+			Denom: testDenom,
 			Pagination: &query.PageRequest{
 				Key:        next,
 				Offset:     offset,
@@ -124,3 +131,48 @@ func TestMinterControllerQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestMinterControllerQueryIndexes(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	pairs := []types.MinterController{
+		{Denom: testDenom, Controller: "controller1", Minter: "minter1"},
+		{Denom: testDenom, Controller: "controller1", Minter: "minter2"},
+		{Denom: testDenom, Controller: "controller2", Minter: "minter3"},
+	}
+	for _, pair := range pairs {
+		keeper.SetMinterController(ctx, pair)
+	}
+
+	t.Run("MintersByController", func(t *testing.T) {
+		resp, err := keeper.MintersByController(wctx, &types.QueryMintersByControllerRequest{
+			Denom:             testDenom,
+			ControllerAddress: "controller1",
+			Pagination:        &query.PageRequest{CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), resp.Pagination.Total)
+		require.ElementsMatch(t, pairs[:2], resp.MinterController)
+	})
+	t.Run("ControllerByMinter", func(t *testing.T) {
+		resp, err := keeper.ControllerByMinter(wctx, &types.QueryControllerByMinterRequest{
+			Denom:         testDenom,
+			MinterAddress: "minter3",
+		})
+		require.NoError(t, err)
+		require.Equal(t, pairs[2], resp.MinterController)
+
+		_, err = keeper.ControllerByMinter(wctx, &types.QueryControllerByMinterRequest{
+			Denom:         testDenom,
+			MinterAddress: "minter4",
+		})
+		require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.MintersByController(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+		_, err = keeper.ControllerByMinter(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
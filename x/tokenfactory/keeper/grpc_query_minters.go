@@ -20,7 +20,7 @@ func (k Keeper) MintersAll(c context.Context, req *types.QueryAllMintersRequest)
 	ctx := sdk.UnwrapSDKContext(c)
 
 	store := ctx.KVStore(k.storeKey)
-	mintersStore := prefix.NewStore(store, types.KeyPrefix(types.MintersKeyPrefix))
+	mintersStore := prefix.NewStore(store, types.DenomPrefix(types.MintersKeyPrefix, req.Denom))
 
 	pageRes, err := query.Paginate(mintersStore, req.Pagination, func(key []byte, value []byte) error {
 		var minter types.Minters
@@ -47,6 +47,7 @@ func (k Keeper) Minters(c context.Context, req *types.QueryGetMintersRequest) (*
 
 	val, found := k.GetMinters(
 		ctx,
+		req.Denom,
 		req.Address,
 	)
 	if !found {
@@ -31,6 +31,7 @@ func TestMintersQuerySingle(t *testing.T) {
 		{
 			desc: "First",
 			request: &types.QueryGetMintersRequest{
+				Denom:   testDenom,
 				Address: msgs[0].Address,
 			},
 			response: &types.QueryGetMintersResponse{Minters: msgs[0]},
@@ -38,6 +39,7 @@ func TestMintersQuerySingle(t *testing.T) {
 		{
 			desc: "Second",
 			request: &types.QueryGetMintersRequest{
+				Denom:   testDenom,
 				Address: msgs[1].Address,
 			},
 			response: &types.QueryGetMintersResponse{Minters: msgs[1]},
@@ -45,6 +47,7 @@ func TestMintersQuerySingle(t *testing.T) {
 		{
 			desc: "KeyNotFound",
 			request: &types.QueryGetMintersRequest{
+				Denom:   testDenom,
 				Address: strconv.Itoa(100000),
 			},
 			err: status.Error(codes.NotFound, "not found"),
@@ -76,6 +79,7 @@ func TestMintersQueryPaginated(t *testing.T) {
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllMintersRequest {
 		return &types.QueryAllMintersRequest{
+			Denom: testDenom,
 			Pagination: &query.PageRequest{
 				Key:        next,
 				Offset:     offset,
@@ -25,9 +25,14 @@ func TestMintingDenomQuery(t *testing.T) {
 	}{
 		{
 			desc:     "First",
-			request:  &types.QueryGetMintingDenomRequest{},
+			request:  &types.QueryGetMintingDenomRequest{Denom: item.Denom},
 			response: &types.QueryGetMintingDenomResponse{MintingDenom: item},
 		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetMintingDenomRequest{Denom: "unknown"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
 		{
 			desc: "InvalidRequest",
 			err:  status.Error(codes.InvalidArgument, "invalid request"),
@@ -15,7 +15,7 @@ func (k Keeper) Owner(c context.Context, req *types.QueryGetOwnerRequest) (*type
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	val, found := k.GetOwner(ctx)
+	val, found := k.GetOwner(ctx, req.Denom)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
@@ -16,7 +16,7 @@ import (
 func TestOwnerQuery(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
-	owner := types.Owner{Address: "test"}
+	owner := types.Owner{Address: "test", Denom: testDenom}
 	keeper.SetOwner(ctx, owner)
 	for _, tc := range []struct {
 		desc     string
@@ -26,7 +26,7 @@ func TestOwnerQuery(t *testing.T) {
 	}{
 		{
 			desc:     "First",
-			request:  &types.QueryGetOwnerRequest{},
+			request:  &types.QueryGetOwnerRequest{Denom: testDenom},
 			response: &types.QueryGetOwnerResponse{Owner: owner},
 		},
 		{
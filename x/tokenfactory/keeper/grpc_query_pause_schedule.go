@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PauseScheduleAll(c context.Context, req *types.QueryAllPauseScheduleRequest) (*types.QueryAllPauseScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var pauseSchedules []types.PauseSchedule
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	pauseScheduleStore := prefix.NewStore(store, types.DenomPrefix(types.PauseScheduleKeyPrefix, req.Denom))
+
+	pageRes, err := query.Paginate(pauseScheduleStore, req.Pagination, func(key []byte, value []byte) error {
+		var pauseSchedule types.PauseSchedule
+		if err := k.cdc.Unmarshal(value, &pauseSchedule); err != nil {
+			return err
+		}
+
+		pauseSchedules = append(pauseSchedules, pauseSchedule)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPauseScheduleResponse{PauseSchedule: pauseSchedules, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PauseSchedule(c context.Context, req *types.QueryGetPauseScheduleRequest) (*types.QueryGetPauseScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetPauseSchedule(ctx, req.Denom, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPauseScheduleResponse{PauseSchedule: val}, nil
+}
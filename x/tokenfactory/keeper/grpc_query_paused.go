@@ -16,7 +16,11 @@ func (k Keeper) Paused(c context.Context, req *types.QueryGetPausedRequest) (*ty
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	val := k.GetPaused(ctx)
+	if !k.MintingDenomSet(ctx, req.Denom) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	val := k.GetPaused(ctx, req.Denom)
 
 	return &types.QueryGetPausedResponse{Paused: val}, nil
 }
@@ -16,6 +16,7 @@ import (
 func TestPausedQuery(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
+	createTestMintingDenom(keeper, ctx)
 	item := createTestPaused(keeper, ctx)
 	for _, tc := range []struct {
 		desc     string
@@ -25,9 +26,14 @@ func TestPausedQuery(t *testing.T) {
 	}{
 		{
 			desc:     "First",
-			request:  &types.QueryGetPausedRequest{},
+			request:  &types.QueryGetPausedRequest{Denom: testDenom},
 			response: &types.QueryGetPausedResponse{Paused: item},
 		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetPausedRequest{Denom: "unknown"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
 		{
 			desc: "InvalidRequest",
 			err:  status.Error(codes.InvalidArgument, "invalid request"),
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProcessedReference(c context.Context, req *types.QueryGetProcessedReferenceRequest) (*types.QueryGetProcessedReferenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetProcessedReference(ctx, req.Denom, req.Address, req.Operation, req.ReferenceId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetProcessedReferenceResponse{ProcessedReference: val}, nil
+}
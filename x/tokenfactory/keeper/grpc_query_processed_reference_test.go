@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestProcessedReferenceQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNProcessedReferences(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetProcessedReferenceRequest
+		response *types.QueryGetProcessedReferenceResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetProcessedReferenceRequest{
+				Denom:       testDenom,
+				Address:     msgs[0].Address,
+				Operation:   types.ReferenceMint,
+				ReferenceId: msgs[0].ReferenceID,
+			},
+			response: &types.QueryGetProcessedReferenceResponse{ProcessedReference: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetProcessedReferenceRequest{
+				Denom:       testDenom,
+				Address:     msgs[1].Address,
+				Operation:   types.ReferenceMint,
+				ReferenceId: msgs[1].ReferenceID,
+			},
+			response: &types.QueryGetProcessedReferenceResponse{ProcessedReference: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetProcessedReferenceRequest{
+				Denom:       testDenom,
+				Address:     msgs[0].Address,
+				Operation:   types.ReferenceMint,
+				ReferenceId: msgs[1].ReferenceID,
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "OtherOperation",
+			request: &types.QueryGetProcessedReferenceRequest{
+				Denom:       testDenom,
+				Address:     msgs[0].Address,
+				Operation:   types.ReferenceBurn,
+				ReferenceId: msgs[0].ReferenceID,
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.ProcessedReference(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
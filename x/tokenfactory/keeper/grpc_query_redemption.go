@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RedemptionAll(c context.Context, req *types.QueryAllRedemptionRequest) (*types.QueryAllRedemptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var redemptions []types.Redemption
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	redemptionStore := prefix.NewStore(store, types.KeyPrefix(types.RedemptionKeyPrefix))
+
+	// without a requester the redemptions are paginated in the status index, or in the store itself
+	// if no status is given either. The status filter is applied while paginating the requester index.
+	indexStore := redemptionStore
+	switch {
+	case req.Requester != "":
+		indexStore = prefix.NewStore(store, types.RedemptionRequesterPrefix(req.Requester))
+	case req.Status != types.RedemptionStatusUnspecified:
+		indexStore = prefix.NewStore(store, types.RedemptionStatusPrefix(req.Status))
+	}
+
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if req.Requester != "" || req.Status != types.RedemptionStatusUnspecified {
+			value = redemptionStore.Get(value)
+		}
+
+		var redemption types.Redemption
+		if err := k.cdc.Unmarshal(value, &redemption); err != nil {
+			return false, err
+		}
+
+		if req.Status != types.RedemptionStatusUnspecified && redemption.Status != req.Status {
+			return false, nil
+		}
+
+		if accumulate {
+			redemptions = append(redemptions, redemption)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRedemptionResponse{Redemption: redemptions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Redemption(c context.Context, req *types.QueryGetRedemptionRequest) (*types.QueryGetRedemptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetRedemption(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetRedemptionResponse{Redemption: val}, nil
+}
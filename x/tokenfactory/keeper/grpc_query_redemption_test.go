@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestRedemptionQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNRedemptions(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetRedemptionRequest
+		response *types.QueryGetRedemptionResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetRedemptionRequest{Id: msgs[0].Id},
+			response: &types.QueryGetRedemptionResponse{Redemption: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetRedemptionRequest{Id: msgs[1].Id},
+			response: &types.QueryGetRedemptionResponse{Redemption: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetRedemptionRequest{Id: 100000},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.Redemption(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestRedemptionQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNRedemptions(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllRedemptionRequest {
+		return &types.QueryAllRedemptionRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.RedemptionAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Redemption), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Redemption),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.RedemptionAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Redemption), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Redemption),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.RedemptionAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.Redemption),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.RedemptionAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestRedemptionQueryFiltered(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNRedemptions(keeper, ctx, 4)
+
+	// the first two redemptions are of the same requester, one of which is fulfilled
+	msgs[1].Requester = msgs[0].Requester
+	msgs[1].Status = types.RedemptionStatusFulfilled
+	keeper.SetRedemption(ctx, msgs[1])
+	msgs[3].Status = types.RedemptionStatusFulfilled
+	keeper.SetRedemption(ctx, msgs[3])
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryAllRedemptionRequest
+		response []types.Redemption
+	}{
+		{
+			desc:     "Requester",
+			request:  &types.QueryAllRedemptionRequest{Requester: msgs[0].Requester},
+			response: msgs[:2],
+		},
+		{
+			desc:     "Status",
+			request:  &types.QueryAllRedemptionRequest{Status: types.RedemptionStatusFulfilled},
+			response: []types.Redemption{msgs[1], msgs[3]},
+		},
+		{
+			desc:     "RequesterAndStatus",
+			request:  &types.QueryAllRedemptionRequest{Requester: msgs[0].Requester, Status: types.RedemptionStatusPending},
+			response: msgs[:1],
+		},
+		{
+			desc:    "NoMatch",
+			request: &types.QueryAllRedemptionRequest{Requester: msgs[2].Requester, Status: types.RedemptionStatusRejected},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			tc.request.Pagination = &query.PageRequest{CountTotal: true}
+			resp, err := keeper.RedemptionAll(wctx, tc.request)
+			require.NoError(t, err)
+			require.Equal(t, len(tc.response), int(resp.Pagination.Total))
+			require.ElementsMatch(t,
+				nullify.Fill(tc.response),
+				nullify.Fill(resp.Redemption),
+			)
+		})
+	}
+}
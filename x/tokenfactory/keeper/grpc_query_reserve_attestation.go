@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ReserveAttestationAll(c context.Context, req *types.QueryAllReserveAttestationRequest) (*types.QueryAllReserveAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var attestations []types.ReserveAttestation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	attestationStore := prefix.NewStore(store, types.DenomPrefix(types.ReserveAttestationKeyPrefix, req.Denom))
+
+	pageRes, err := query.Paginate(attestationStore, req.Pagination, func(key []byte, value []byte) error {
+		var attestation types.ReserveAttestation
+		if err := k.cdc.Unmarshal(value, &attestation); err != nil {
+			return err
+		}
+
+		attestations = append(attestations, attestation)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllReserveAttestationResponse{ReserveAttestation: attestations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ReserveAttestation(c context.Context, req *types.QueryGetReserveAttestationRequest) (*types.QueryGetReserveAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetReserveAttestation(ctx, req.Denom, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetReserveAttestationResponse{ReserveAttestation: val}, nil
+}
+
+func (k Keeper) CollateralizationRatio(c context.Context, req *types.QueryCollateralizationRatioRequest) (*types.QueryCollateralizationRatioResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	attestation, found := k.GetLatestReserveAttestation(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, req.Denom)
+
+	ratio := sdk.ZeroDec()
+	if supply.IsPositive() {
+		ratio = sdk.NewDecFromInt(attestation.Reserves.Amount).QuoInt(supply.Amount)
+	}
+
+	return &types.QueryCollateralizationRatioResponse{
+		ReserveAttestation: attestation,
+		Supply:             supply,
+		Ratio:              ratio,
+		Stale:              k.IsReserveAttestationStale(ctx, attestation),
+	}, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SupplyCap(c context.Context, req *types.QuerySupplyCapRequest) (*types.QuerySupplyCapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetSupplyCap(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, req.Denom)
+
+	return &types.QuerySupplyCapResponse{
+		SupplyCap: val,
+		Supply:    supply,
+		Headroom:  SupplyCapHeadroom(val, supply),
+	}, nil
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// SetHooks sets the hooks of the keeper. It panics if they have already been set, use
+// types.NewMultiTokenFactoryHooks to set more than one.
+func (k *Keeper) SetHooks(hooks types.TokenFactoryHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set tokenfactory hooks twice")
+	}
+
+	k.hooks = hooks
+
+	return k
+}
+
+// runCached runs fn on a cache of ctx and only keeps its changes and events if it succeeds, so that
+// a hook called at the end of a block can reject a change without affecting the others.
+func runCached(ctx sdk.Context, fn func(ctx sdk.Context) error) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := fn(cacheCtx); err != nil {
+		return err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
+// the helpers below call the hooks, if any have been set
+
+func (k Keeper) beforeMint(ctx sdk.Context, minter string, recipient string, amount sdk.Coin) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.BeforeMint(ctx, minter, recipient, amount)
+}
+
+func (k Keeper) afterMint(ctx sdk.Context, minter string, recipient string, amount sdk.Coin) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterMint(ctx, minter, recipient, amount)
+}
+
+func (k Keeper) afterBurn(ctx sdk.Context, burner string, amount sdk.Coin) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterBurn(ctx, burner, amount)
+}
+
+func (k Keeper) afterBlacklist(ctx sdk.Context, denom string, address string) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterBlacklist(ctx, denom, address)
+}
+
+func (k Keeper) afterUnblacklist(ctx sdk.Context, denom string, address string) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterUnblacklist(ctx, denom, address)
+}
+
+func (k Keeper) afterPauseChange(ctx sdk.Context, denom string, paused types.Paused) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterPauseChange(ctx, denom, paused)
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+var errHookFailed = errors.New("hook failed")
+
+// mockHooks records every hook call and fails the calls that contain fail, e.g. a hook name.
+type mockHooks struct {
+	name  string
+	calls *[]string
+	fail  string
+}
+
+var _ types.TokenFactoryHooks = &mockHooks{}
+
+func (h *mockHooks) call(hook string, args ...interface{}) error {
+	call := fmt.Sprintf("%s%v", hook, args)
+	*h.calls = append(*h.calls, h.name+"."+call)
+	if h.fail != "" && strings.Contains(call, h.fail) {
+		return errHookFailed
+	}
+	return nil
+}
+
+func (h *mockHooks) BeforeMint(_ sdk.Context, minter string, recipient string, amount sdk.Coin) error {
+	return h.call("BeforeMint", minter, recipient, amount)
+}
+
+func (h *mockHooks) AfterMint(_ sdk.Context, minter string, recipient string, amount sdk.Coin) error {
+	return h.call("AfterMint", minter, recipient, amount)
+}
+
+func (h *mockHooks) AfterBurn(_ sdk.Context, burner string, amount sdk.Coin) error {
+	return h.call("AfterBurn", burner, amount)
+}
+
+func (h *mockHooks) AfterBlacklist(_ sdk.Context, denom string, address string) error {
+	return h.call("AfterBlacklist", denom, address)
+}
+
+func (h *mockHooks) AfterUnblacklist(_ sdk.Context, denom string, address string) error {
+	return h.call("AfterUnblacklist", denom, address)
+}
+
+func (h *mockHooks) AfterPauseChange(_ sdk.Context, denom string, paused types.Paused) error {
+	return h.call("AfterPauseChange", denom, types.FormatPauseOperations(paused.PausedOperations()))
+}
+
+func TestHooks(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, keepertest.NewMockSupplyBankKeeper())
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	pauser, blacklister, minter := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	user := sample.AccAddress()
+	coin := sdk.NewCoin(testDenom, sdk.NewInt(10))
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+	k.SetPauser(ctx, types.Pauser{Address: pauser, Denom: testDenom})
+	k.SetBlacklister(ctx, types.Blacklister{Address: blacklister, Denom: testDenom})
+	k.SetMinters(ctx, types.Minters{Address: minter, Allowance: sdk.NewCoin(testDenom, sdk.NewInt(100)), Denom: testDenom})
+
+	var calls []string
+	first := &mockHooks{name: "first", calls: &calls}
+	second := &mockHooks{name: "second", calls: &calls}
+	k.SetHooks(types.NewMultiTokenFactoryHooks(first, second))
+
+	require.Panics(t, func() { k.SetHooks(second) })
+
+	// every hook is called in the order the hooks were combined
+	_, err := server.Mint(wctx, types.NewMsgMint(minter, minter, coin))
+	require.NoError(t, err)
+	_, err = server.Burn(wctx, types.NewMsgBurn(minter, coin))
+	require.NoError(t, err)
+	_, err = server.Blacklist(wctx, types.NewMsgBlacklist(blacklister, user, testDenom, "", "", nil))
+	require.NoError(t, err)
+	_, err = server.Unblacklist(wctx, types.NewMsgUnblacklist(blacklister, user, testDenom))
+	require.NoError(t, err)
+	_, err = server.Pause(wctx, types.NewMsgPause(pauser, testDenom, []types.PauseOperation{types.PauseMint}))
+	require.NoError(t, err)
+	_, err = server.Unpause(wctx, types.NewMsgUnpause(pauser, testDenom, []types.PauseOperation{types.PauseMint}))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		fmt.Sprintf("first.BeforeMint[%s %s %s]", minter, minter, coin),
+		fmt.Sprintf("second.BeforeMint[%s %s %s]", minter, minter, coin),
+		fmt.Sprintf("first.AfterMint[%s %s %s]", minter, minter, coin),
+		fmt.Sprintf("second.AfterMint[%s %s %s]", minter, minter, coin),
+		fmt.Sprintf("first.AfterBurn[%s %s]", minter, coin),
+		fmt.Sprintf("second.AfterBurn[%s %s]", minter, coin),
+		fmt.Sprintf("first.AfterBlacklist[%s %s]", testDenom, user),
+		fmt.Sprintf("second.AfterBlacklist[%s %s]", testDenom, user),
+		fmt.Sprintf("first.AfterUnblacklist[%s %s]", testDenom, user),
+		fmt.Sprintf("second.AfterUnblacklist[%s %s]", testDenom, user),
+		fmt.Sprintf("first.AfterPauseChange[%s PAUSE_OPERATION_MINT]", testDenom),
+		fmt.Sprintf("second.AfterPauseChange[%s PAUSE_OPERATION_MINT]", testDenom),
+		fmt.Sprintf("first.AfterPauseChange[%s ]", testDenom),
+		fmt.Sprintf("second.AfterPauseChange[%s ]", testDenom),
+	}, calls)
+
+	// a hook that returns an error aborts the message and the hooks after it are not called
+	calls = nil
+	first.fail = "BeforeMint"
+	_, err = server.Mint(wctx, types.NewMsgMint(minter, user, coin))
+	require.ErrorIs(t, err, errHookFailed)
+	require.Equal(t, []string{fmt.Sprintf("first.BeforeMint[%s %s %s]", minter, user, coin)}, calls)
+
+	minters, _ := k.GetMinters(ctx, testDenom, minter)
+	require.Equal(t, sdk.NewCoin(testDenom, sdk.NewInt(90)), minters.Allowance)
+
+	for _, tc := range []struct {
+		hook string
+		run  func() error
+	}{
+		{"AfterMint", func() error {
+			_, err := server.Mint(wctx, types.NewMsgMint(minter, minter, coin))
+			return err
+		}},
+		{"AfterBurn", func() error {
+			_, err := server.Burn(wctx, types.NewMsgBurn(minter, coin))
+			return err
+		}},
+		{"AfterBlacklist", func() error {
+			_, err := server.Blacklist(wctx, types.NewMsgBlacklist(blacklister, sample.AccAddress(), testDenom, "", "", nil))
+			return err
+		}},
+		{"AfterBlacklist", func() error {
+			_, err := server.BlacklistBatch(wctx, types.NewMsgBlacklistBatch(blacklister, []string{sample.AccAddress()}, testDenom, "", "", nil, false))
+			return err
+		}},
+		{"AfterPauseChange", func() error {
+			_, err := server.Pause(wctx, types.NewMsgPause(pauser, testDenom, nil))
+			return err
+		}},
+	} {
+		t.Run(tc.hook, func(t *testing.T) {
+			first.fail = ""
+			second.fail = tc.hook
+			require.ErrorIs(t, tc.run(), errHookFailed)
+		})
+	}
+}
+
+func TestHooksEndBlock(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(now)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	pauser, blacklister := sample.AccAddress(), sample.AccAddress()
+	user, other := sample.TestAccount(), sample.TestAccount()
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+	k.SetPauser(ctx, types.Pauser{Address: pauser, Denom: testDenom})
+	k.SetBlacklister(ctx, types.Blacklister{Address: blacklister, Denom: testDenom})
+
+	var calls []string
+	hooks := &mockHooks{name: "hooks", calls: &calls}
+	k.SetHooks(hooks)
+
+	expiry := now.Add(time.Hour)
+	for _, address := range []string{user.Address, other.Address} {
+		_, err := server.Blacklist(wctx, types.NewMsgBlacklist(blacklister, address, testDenom, "", "", &expiry))
+		require.NoError(t, err)
+	}
+	_, err := server.SchedulePause(wctx, types.NewMsgSchedulePause(pauser, testDenom, nil, 20, nil, 30, nil))
+	require.NoError(t, err)
+
+	// a hook that fails at the end of a block only keeps its own change from being applied
+	hooks.fail = "AfterUnblacklist[" + testDenom + " " + user.Address
+	require.ErrorIs(t, k.UnblacklistExpired(ctx.WithBlockTime(expiry)), errHookFailed)
+
+	_, found := k.GetBlacklisted(ctx, testDenom, user.AddressBz)
+	require.True(t, found)
+	_, found = k.GetBlacklisted(ctx, testDenom, other.AddressBz)
+	require.False(t, found)
+
+	hooks.fail = "AfterPauseChange"
+	require.ErrorIs(t, k.ApplyPauseSchedules(ctx.WithBlockHeight(20)), errHookFailed)
+	require.False(t, k.GetPaused(ctx, testDenom).IsPaused(types.PauseMint))
+
+	schedules := k.GetAllPauseSchedules(ctx)
+	require.Len(t, schedules, 1)
+	require.False(t, schedules[0].Active)
+
+	// the changes are applied once the hooks no longer fail
+	hooks.fail = ""
+	require.NoError(t, k.UnblacklistExpired(ctx.WithBlockTime(expiry)))
+	_, found = k.GetBlacklisted(ctx, testDenom, user.AddressBz)
+	require.False(t, found)
+
+	require.NoError(t, k.ApplyPauseSchedules(ctx.WithBlockHeight(20)))
+	require.True(t, k.GetPaused(ctx, testDenom).IsPaused(types.PauseMint))
+}
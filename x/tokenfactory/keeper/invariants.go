@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// RegisterInvariants registers all tokenfactory invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "minter-allowances", MinterAllowancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "privileged-roles", PrivilegedRolesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "minter-controllers", MinterControllersInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "denom-metadata", DenomMetadataInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account-balance", ModuleAccountBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the tokenfactory module
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			MinterAllowancesInvariant(k),
+			PrivilegedRolesInvariant(k),
+			MinterControllersInvariant(k),
+			DenomMetadataInvariant(k),
+			ModuleAccountBalanceInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+
+		return "", false
+	}
+}
+
+// MinterAllowancesInvariant checks that every minter allowance is non-negative and in the minting
+// denom the minter is configured for.
+func MinterAllowancesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		for _, minter := range k.GetAllMinters(ctx) {
+			switch {
+			case !k.MintingDenomSet(ctx, minter.Denom):
+				msg += fmt.Sprintf("\tminter %s is configured for %s, which is not a minting denom\n", minter.Address, minter.Denom)
+			case minter.Allowance.Denom != minter.Denom:
+				msg += fmt.Sprintf("\tminter %s of %s has an allowance in %s\n", minter.Address, minter.Denom, minter.Allowance.Denom)
+			case minter.Allowance.Amount.IsNil() || minter.Allowance.IsNegative():
+				msg += fmt.Sprintf("\tminter %s of %s has a negative allowance %s\n", minter.Address, minter.Denom, minter.Allowance)
+			default:
+				continue
+			}
+			broken++
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "minter-allowances",
+			fmt.Sprintf("found %d minters with an invalid allowance\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// PrivilegedRolesInvariant checks that no address holds more than one of the owner, pending owner,
+// master minter, pauser and blacklister roles of a minting denom.
+func PrivilegedRolesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		for _, mintingDenom := range k.GetAllMintingDenoms(ctx) {
+			denom := mintingDenom.Denom
+			roles := make(map[string]string)
+
+			addRole := func(role string, address string, found bool) {
+				if !found {
+					return
+				}
+				if other, ok := roles[address]; ok {
+					msg += fmt.Sprintf("\t%s is both the %s and the %s of %s\n", address, other, role, denom)
+					broken++
+					return
+				}
+				roles[address] = role
+			}
+
+			owner, found := k.GetOwner(ctx, denom)
+			addRole("owner", owner.Address, found)
+			pendingOwner, found := k.GetPendingOwner(ctx, denom)
+			addRole("pending owner", pendingOwner.Address, found)
+			masterMinter, found := k.GetMasterMinter(ctx, denom)
+			addRole("master minter", masterMinter.Address, found)
+			pauser, found := k.GetPauser(ctx, denom)
+			addRole("pauser", pauser.Address, found)
+			blacklister, found := k.GetBlacklister(ctx, denom)
+			addRole("blacklister", blacklister.Address, found)
+			attestor, found := k.GetAttestor(ctx, denom)
+			addRole("attestor", attestor.Address, found)
+			allowlister, found := k.GetAllowlister(ctx, denom)
+			addRole("allowlister", allowlister.Address, found)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "privileged-roles",
+			fmt.Sprintf("found %d shared privileged roles\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// MinterControllersInvariant checks that every minter controller points to a valid minter address
+// of a minting denom and can be found from that minter. A controller may be configured before its
+// minter, so the minter itself does not need to be configured yet.
+func MinterControllersInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		for _, minterController := range k.GetAllMinterControllers(ctx) {
+			if !k.MintingDenomSet(ctx, minterController.Denom) {
+				msg += fmt.Sprintf("\tcontroller %s of minter %s is configured for %s, which is not a minting denom\n", minterController.Controller, minterController.Minter, minterController.Denom)
+				broken++
+				continue
+			}
+
+			if _, err := sdk.AccAddressFromBech32(minterController.Minter); err != nil {
+				msg += fmt.Sprintf("\tcontroller %s of %s points to an invalid minter address %q\n", minterController.Controller, minterController.Denom, minterController.Minter)
+				broken++
+				continue
+			}
+
+			if byMinter, found := k.GetControllerByMinter(ctx, minterController.Denom, minterController.Minter); !found || byMinter.Controller != minterController.Controller {
+				msg += fmt.Sprintf("\tcontroller %s of minter %s of %s is not the controller of the minter\n", minterController.Controller, minterController.Minter, minterController.Denom)
+				broken++
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "minter-controllers",
+			fmt.Sprintf("found %d invalid minter controllers\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// DenomMetadataInvariant checks that every minting denom has denom metadata in the bank module.
+func DenomMetadataInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		for _, mintingDenom := range k.GetAllMintingDenoms(ctx) {
+			if _, found := k.bankKeeper.GetDenomMetaData(ctx, mintingDenom.Denom); !found {
+				msg += fmt.Sprintf("\tminting denom %s has no denom metadata\n", mintingDenom.Denom)
+				broken++
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "denom-metadata",
+			fmt.Sprintf("found %d minting denoms without denom metadata\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// ModuleAccountBalanceInvariant checks that the module account holds exactly the tokens escrowed for
+// pending redemptions of every minting denom. Minted coins are sent to the recipient and burned coins
+// are burned within the same message, so any other balance left in the module account was either
+// sent there directly or is a leftover of a failed mint, burn or redemption.
+func ModuleAccountBalanceInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		balance := k.bankKeeper.SpendableCoins(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		escrow := k.GetRedemptionEscrow(ctx)
+		for _, mintingDenom := range k.GetAllMintingDenoms(ctx) {
+			amount, escrowed := balance.AmountOf(mintingDenom.Denom), escrow.AmountOf(mintingDenom.Denom)
+			if !amount.Equal(escrowed) {
+				msg += fmt.Sprintf("\tmodule account holds %s%s but %s%s is escrowed for redemptions\n", amount, mintingDenom.Denom, escrowed, mintingDenom.Denom)
+				broken++
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-account-balance",
+			fmt.Sprintf("found %d minting denoms with an unexpected module account balance\n%s", broken, msg),
+		), broken != 0
+	}
+}
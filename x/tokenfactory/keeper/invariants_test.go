@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestMinterAllowancesInvariant(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+
+	k.SetMinters(ctx, types.Minters{Address: sample.AccAddress(), Denom: testDenom, Allowance: sdk.NewInt64Coin(testDenom, 10)})
+	_, broken := keeper.MinterAllowancesInvariant(k)(ctx)
+	require.False(t, broken)
+
+	for _, minter := range []types.Minters{
+		{Address: sample.AccAddress(), Denom: testDenom, Allowance: sdk.Coin{Denom: testDenom, Amount: sdk.NewInt(-1)}},
+		{Address: sample.AccAddress(), Denom: testDenom, Allowance: sdk.NewInt64Coin("uother", 10)},
+		{Address: sample.AccAddress(), Denom: "uother", Allowance: sdk.NewInt64Coin("uother", 10)},
+	} {
+		k, ctx := keepertest.TokenfactoryKeeper(t)
+		k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+		k.SetMinters(ctx, minter)
+
+		_, broken := keeper.MinterAllowancesInvariant(k)(ctx)
+		require.True(t, broken)
+	}
+}
+
+func TestPrivilegedRolesInvariant(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+
+	owner, pauser := sample.AccAddress(), sample.AccAddress()
+	k.SetOwner(ctx, types.Owner{Address: owner, Denom: testDenom})
+	k.SetPauser(ctx, types.Pauser{Address: pauser, Denom: testDenom})
+	k.SetBlacklister(ctx, types.Blacklister{Address: sample.AccAddress(), Denom: testDenom})
+
+	_, broken := keeper.PrivilegedRolesInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// the same address may hold roles of different denoms
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uother"})
+	k.SetOwner(ctx, types.Owner{Address: pauser, Denom: "uother"})
+	_, broken = keeper.PrivilegedRolesInvariant(k)(ctx)
+	require.False(t, broken)
+
+	k.SetPendingOwner(ctx, types.Owner{Address: pauser, Denom: testDenom})
+	msg, broken := keeper.PrivilegedRolesInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "pending owner")
+}
+
+func TestMinterControllersInvariant(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+
+	// a controller may be configured before its minter
+	k.SetMinterController(ctx, types.MinterController{Controller: sample.AccAddress(), Minter: sample.AccAddress(), Denom: testDenom})
+	_, broken := keeper.MinterControllersInvariant(k)(ctx)
+	require.False(t, broken)
+
+	k.SetMinterController(ctx, types.MinterController{Controller: sample.AccAddress(), Minter: "invalid", Denom: testDenom})
+	_, broken = keeper.MinterControllersInvariant(k)(ctx)
+	require.True(t, broken)
+
+	k, ctx = keepertest.TokenfactoryKeeper(t)
+	k.SetMinterController(ctx, types.MinterController{Controller: sample.AccAddress(), Minter: sample.AccAddress(), Denom: testDenom})
+	_, broken = keeper.MinterControllersInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestDenomMetadataInvariant(t *testing.T) {
+	bankKeeper := keepertest.NewMockMetadataBankKeeper()
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{Base: testDenom})
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+
+	_, broken := keeper.DenomMetadataInvariant(k)(ctx)
+	require.False(t, broken)
+
+	bankKeeper.DeleteDenomMetaData(testDenom)
+	_, broken = keeper.DenomMetadataInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestModuleAccountBalanceInvariant(t *testing.T) {
+	bankKeeper := keepertest.NewMockSupplyBankKeeper()
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+
+	// balances of other denoms are ignored
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uother", 5))))
+	_, broken := keeper.ModuleAccountBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 5))))
+	_, broken = keeper.ModuleAccountBalanceInvariant(k)(ctx)
+	require.True(t, broken)
+
+	_, broken = keeper.AllInvariants(k)(ctx)
+	require.True(t, broken)
+
+	// tokens escrowed for pending redemptions are expected in the module account
+	k.AppendRedemption(ctx, types.Redemption{
+		Requester: sample.AccAddress(),
+		Amount:    sdk.NewInt64Coin(testDenom, 5),
+		Status:    types.RedemptionStatusPending,
+	})
+	_, broken = keeper.ModuleAccountBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+
+	k.AppendRedemption(ctx, types.Redemption{
+		Requester: sample.AccAddress(),
+		Amount:    sdk.NewInt64Coin(testDenom, 3),
+		Status:    types.RedemptionStatusPending,
+	})
+	_, broken = keeper.ModuleAccountBalanceInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestValidatePrivilegesPendingOwner(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner, pendingOwner := sample.AccAddress(), sample.AccAddress()
+	k.SetOwner(ctx, types.Owner{Address: owner, Denom: testDenom})
+
+	_, err := server.UpdateOwner(wctx, &types.MsgUpdateOwner{From: owner, Address: pendingOwner, Denom: testDenom})
+	require.NoError(t, err)
+
+	// the pending owner can not be assigned another role, which it would share once it accepts ownership
+	_, err = server.UpdatePauser(wctx, &types.MsgUpdatePauser{From: owner, Address: pendingOwner, Denom: testDenom})
+	require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
+}
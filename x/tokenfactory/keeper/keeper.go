@@ -19,7 +19,10 @@ type (
 		storeKey   storetypes.StoreKey
 		paramstore paramtypes.Subspace
 
-		bankKeeper types.BankKeeper
+		bankKeeper      types.BankKeeper
+		authorityKeeper types.AuthorityKeeper
+
+		hooks types.TokenFactoryHooks
 	}
 )
 
@@ -29,6 +32,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 
 	bankKeeper types.BankKeeper,
+	authorityKeeper types.AuthorityKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -37,10 +41,11 @@ func NewKeeper(
 
 	return &Keeper{
 
-		cdc:        cdc,
-		storeKey:   storeKey,
-		paramstore: ps,
-		bankKeeper: bankKeeper,
+		cdc:             cdc,
+		storeKey:        storeKey,
+		paramstore:      ps,
+		bankKeeper:      bankKeeper,
+		authorityKeeper: authorityKeeper,
 	}
 }
 
@@ -48,32 +53,47 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-// ValidatePrivileges checks if a specified address has already been assigned to a privileged role.
-func (k Keeper) ValidatePrivileges(ctx sdk.Context, address string) error {
+// ValidatePrivileges checks if a specified address has already been assigned to a privileged role of a denom.
+func (k Keeper) ValidatePrivileges(ctx sdk.Context, denom string, address string) error {
 	acc, err := sdk.AccAddressFromBech32(address)
 	if err != nil {
 		return err
 	}
 
-	owner, found := k.GetOwner(ctx)
+	owner, found := k.GetOwner(ctx, denom)
 	if found && owner.Address == acc.String() {
 		return sdkerrors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to owner role", acc.String())
 	}
 
-	blacklister, found := k.GetBlacklister(ctx)
+	pendingOwner, found := k.GetPendingOwner(ctx, denom)
+	if found && pendingOwner.Address == acc.String() {
+		return sdkerrors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to pending owner role", acc.String())
+	}
+
+	blacklister, found := k.GetBlacklister(ctx, denom)
 	if found && blacklister.Address == acc.String() {
 		return sdkerrors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to black lister role", acc.String())
 	}
 
-	masterminter, found := k.GetMasterMinter(ctx)
+	masterminter, found := k.GetMasterMinter(ctx, denom)
 	if found && masterminter.Address == acc.String() {
 		return sdkerrors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to master minter role", acc.String())
 	}
 
-	pauser, found := k.GetPauser(ctx)
+	pauser, found := k.GetPauser(ctx, denom)
 	if found && pauser.Address == acc.String() {
 		return sdkerrors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to pauser role", acc.String())
 	}
 
+	attestor, found := k.GetAttestor(ctx, denom)
+	if found && attestor.Address == acc.String() {
+		return sdkerrors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to attestor role", acc.String())
+	}
+
+	allowlister, found := k.GetAllowlister(ctx, denom)
+	if found && allowlister.Address == acc.String() {
+		return sdkerrors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to allowlister role", acc.String())
+	}
+
 	return nil
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,14 +11,14 @@ import (
 func (k Keeper) SetMasterMinter(ctx sdk.Context, masterMinter types.MasterMinter) {
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshal(&masterMinter)
-	store.Set(types.KeyPrefix(types.MasterMinterKey), b)
+	store.Set(types.DenomPrefix(types.MasterMinterKey, masterMinter.Denom), b)
 }
 
 // GetMasterMinter returns masterMinter
-func (k Keeper) GetMasterMinter(ctx sdk.Context) (val types.MasterMinter, found bool) {
+func (k Keeper) GetMasterMinter(ctx sdk.Context, denom string) (val types.MasterMinter, found bool) {
 	store := ctx.KVStore(k.storeKey)
 
-	b := store.Get(types.KeyPrefix(types.MasterMinterKey))
+	b := store.Get(types.DenomPrefix(types.MasterMinterKey, denom))
 	if b == nil {
 		return val, false
 	}
@@ -25,3 +26,19 @@ func (k Keeper) GetMasterMinter(ctx sdk.Context) (val types.MasterMinter, found
 	k.cdc.MustUnmarshal(b, &val)
 	return val, true
 }
+
+// GetAllMasterMinters returns the masterMinter of every denom
+func (k Keeper) GetAllMasterMinters(ctx sdk.Context) (list []types.MasterMinter) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MasterMinterKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MasterMinter
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -13,7 +13,7 @@ import (
 )
 
 func createTestMasterMinter(keeper *keeper.Keeper, ctx sdk.Context) types.MasterMinter {
-	item := types.MasterMinter{}
+	item := types.MasterMinter{Denom: testDenom}
 	keeper.SetMasterMinter(ctx, item)
 	return item
 }
@@ -21,7 +21,7 @@ func createTestMasterMinter(keeper *keeper.Keeper, ctx sdk.Context) types.Master
 func TestMasterMinterGet(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	item := createTestMasterMinter(keeper, ctx)
-	rst, found := keeper.GetMasterMinter(ctx)
+	rst, found := keeper.GetMasterMinter(ctx, testDenom)
 	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&item),
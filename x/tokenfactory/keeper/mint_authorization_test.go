@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// TestMintAuthorization executes mints the way x/authz does for a MsgExec: the authorization
+// accepts the mint and is updated, then the mint is executed with the granter as the signer.
+func TestMintAuthorization(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, keepertest.NewMockSupplyBankKeeper())
+	server := keeper.NewMsgServerImpl(k)
+
+	minter, recipient := sample.AccAddress(), sample.AccAddress()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(testDenom, sdk.NewInt(amount))
+	}
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Paused: false, Denom: testDenom})
+	k.SetMinters(ctx, types.Minters{Address: minter, Allowance: coin(100), Denom: testDenom})
+
+	expiry := now.Add(time.Hour)
+	var authorization authz.Authorization = types.NewMintAuthorization(coin(150), []string{recipient}, &expiry)
+	require.NoError(t, authorization.ValidateBasic())
+
+	exec := func(ctx sdk.Context, msg *types.MsgMint) (authz.AcceptResponse, error) {
+		resp, err := authorization.Accept(ctx, msg)
+		if err != nil {
+			return resp, err
+		}
+		if _, err := server.Mint(sdk.WrapSDKContext(ctx), msg); err != nil {
+			return resp, err
+		}
+		if resp.Updated != nil {
+			authorization = resp.Updated
+		}
+		return resp, nil
+	}
+
+	_, err := exec(ctx, types.NewMsgMint(minter, sample.AccAddress(), coin(10)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = exec(ctx.WithBlockTime(expiry), types.NewMsgMint(minter, recipient, coin(10)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	resp, err := exec(ctx, types.NewMsgMint(minter, recipient, coin(60)))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.Equal(t, coin(90), authorization.(*types.MintAuthorization).SpendLimit)
+
+	// the authorization still allows 90, but the granter can only mint 40 more
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = exec(cacheCtx, types.NewMsgMint(minter, recipient, coin(50)))
+	require.ErrorIs(t, err, types.ErrMint)
+	require.Equal(t, coin(90), authorization.(*types.MintAuthorization).SpendLimit)
+
+	_, err = exec(ctx, types.NewMsgMint(minter, recipient, coin(40)))
+	require.NoError(t, err)
+	require.Equal(t, coin(50), authorization.(*types.MintAuthorization).SpendLimit)
+
+	minters, found := k.GetMinters(ctx, testDenom, minter)
+	require.True(t, found)
+	require.True(t, minters.Allowance.IsZero())
+}
@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// SetMintRateLimit set a specific mintRateLimit in the store from its index
+func (k Keeper) SetMintRateLimit(ctx sdk.Context, mintRateLimit types.MintRateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintRateLimitKeyPrefix))
+	b := k.cdc.MustMarshal(&mintRateLimit)
+	store.Set(types.MintRateLimitKey(
+		mintRateLimit.Denom,
+		mintRateLimit.Minter,
+	), b)
+}
+
+// GetMintRateLimit returns a mintRateLimit from its index
+func (k Keeper) GetMintRateLimit(
+	ctx sdk.Context,
+	denom string,
+	minter string,
+
+) (val types.MintRateLimit, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintRateLimitKeyPrefix))
+
+	b := store.Get(types.MintRateLimitKey(
+		denom,
+		minter,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// DeleteMintRateLimit removes a mintRateLimit, along with its current window, from the store
+func (k Keeper) DeleteMintRateLimit(
+	ctx sdk.Context,
+	denom string,
+	minter string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintRateLimitKeyPrefix))
+	store.Delete(types.MintRateLimitKey(
+		denom,
+		minter,
+	))
+
+	windowStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintRateLimitWindowKeyPrefix))
+	windowStore.Delete(types.MintRateLimitKey(
+		denom,
+		minter,
+	))
+}
+
+// GetAllMintRateLimits returns all mintRateLimit
+func (k Keeper) GetAllMintRateLimits(ctx sdk.Context) (list []types.MintRateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintRateLimitKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MintRateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetMintRateLimitWindow set a specific mintRateLimitWindow in the store from its index
+func (k Keeper) SetMintRateLimitWindow(ctx sdk.Context, window types.MintRateLimitWindow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintRateLimitWindowKeyPrefix))
+	b := k.cdc.MustMarshal(&window)
+	store.Set(types.MintRateLimitKey(
+		window.Denom,
+		window.Minter,
+	), b)
+}
+
+// GetMintRateLimitWindow returns a mintRateLimitWindow from its index
+func (k Keeper) GetMintRateLimitWindow(
+	ctx sdk.Context,
+	denom string,
+	minter string,
+
+) (val types.MintRateLimitWindow, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintRateLimitWindowKeyPrefix))
+
+	b := store.Get(types.MintRateLimitKey(
+		denom,
+		minter,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllMintRateLimitWindows returns all mintRateLimitWindow
+func (k Keeper) GetAllMintRateLimitWindows(ctx sdk.Context) (list []types.MintRateLimitWindow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintRateLimitWindowKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MintRateLimitWindow
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// mintRateLimitBuckets is the number of buckets the window of a mint rate limit is divided into.
+// Mints are only tracked per bucket, so a limit may be enforced up to one bucket longer than its window.
+const mintRateLimitBuckets = 24
+
+// mintRateLimitBucketDuration returns the duration of a bucket of the window of a mint rate limit.
+func mintRateLimitBucketDuration(rateLimit types.MintRateLimit) time.Duration {
+	if duration := rateLimit.Window / mintRateLimitBuckets; duration > 0 {
+		return duration
+	}
+
+	return 1
+}
+
+// mintRateLimitBucketEnd returns the block time at which a bucket stops counting against a mint rate limit,
+// which is once no mint in the bucket can be within the window anymore.
+func mintRateLimitBucketEnd(rateLimit types.MintRateLimit, bucket types.MintRateLimitBucket) time.Time {
+	return bucket.Start.Add(mintRateLimitBucketDuration(rateLimit)).Add(rateLimit.Window)
+}
+
+// CurrentMintRateLimitWindow returns the window of a mint rate limit as of the current block time, holding
+// only the buckets that still count against the limit.
+func (k Keeper) CurrentMintRateLimitWindow(ctx sdk.Context, rateLimit types.MintRateLimit) types.MintRateLimitWindow {
+	window, found := k.GetMintRateLimitWindow(ctx, rateLimit.Denom, rateLimit.Minter)
+	if !found {
+		return types.MintRateLimitWindow{
+			Denom:  rateLimit.Denom,
+			Minter: rateLimit.Minter,
+		}
+	}
+
+	var buckets []types.MintRateLimitBucket
+	for _, bucket := range window.Buckets {
+		if mintRateLimitBucketEnd(rateLimit, bucket).After(ctx.BlockTime()) {
+			buckets = append(buckets, bucket)
+		}
+	}
+	window.Buckets = buckets
+
+	return window
+}
+
+// applyMintRateLimit returns the window of the rate limit of minter, if one is set, with amount added
+// to it. An error that states when enough of the window frees up is returned if the limit would be exceeded.
+func (k Keeper) applyMintRateLimit(ctx sdk.Context, minter string, amount sdk.Coin) (*types.MintRateLimitWindow, error) {
+	rateLimit, found := k.GetMintRateLimit(ctx, amount.Denom, minter)
+	if !found {
+		return nil, nil
+	}
+
+	window := k.CurrentMintRateLimitWindow(ctx, rateLimit)
+	minted := MintRateLimitMinted(rateLimit, window).Add(amount)
+
+	if rateLimit.Limit.IsLT(minted) {
+		subject := "all minters"
+		if minter != "" {
+			subject = "minter " + minter
+		}
+
+		// the amount can be minted once enough of the oldest buckets stop counting against the limit
+		excess := minted.Sub(rateLimit.Limit)
+		freed := sdk.NewCoin(rateLimit.Denom, sdk.ZeroInt())
+		var availableAt time.Time
+		for _, bucket := range window.Buckets {
+			freed = freed.Add(bucket.Minted)
+			availableAt = mintRateLimitBucketEnd(rateLimit, bucket)
+			if !freed.IsLT(excess) {
+				break
+			}
+		}
+
+		return nil, sdkerrors.Wrapf(
+			types.ErrMintRateLimit,
+			"%s can mint at most %s until more of the window frees up at %s",
+			subject, MintRateLimitRemaining(rateLimit, window), availableAt.UTC().Format(time.RFC3339),
+		)
+	}
+
+	// mints are added to the bucket the current block time falls into
+	duration := mintRateLimitBucketDuration(rateLimit)
+	start := time.Unix(0, ctx.BlockTime().UnixNano()-ctx.BlockTime().UnixNano()%int64(duration)).UTC()
+
+	if last := len(window.Buckets) - 1; last >= 0 && window.Buckets[last].Start.Equal(start) {
+		window.Buckets[last].Minted = window.Buckets[last].Minted.Add(amount)
+	} else {
+		window.Buckets = append(window.Buckets, types.MintRateLimitBucket{Start: start, Minted: amount})
+	}
+
+	return &window, nil
+}
+
+// MintRateLimitMinted returns the amount minted in window that counts against rateLimit.
+func MintRateLimitMinted(rateLimit types.MintRateLimit, window types.MintRateLimitWindow) sdk.Coin {
+	minted := sdk.NewCoin(rateLimit.Limit.Denom, sdk.ZeroInt())
+	for _, bucket := range window.Buckets {
+		minted = minted.Add(bucket.Minted)
+	}
+
+	return minted
+}
+
+// MintRateLimitRemaining returns the amount that can still be minted in window under rateLimit.
+func MintRateLimitRemaining(rateLimit types.MintRateLimit, window types.MintRateLimitWindow) sdk.Coin {
+	minted := MintRateLimitMinted(rateLimit, window)
+	if !minted.IsLT(rateLimit.Limit) {
+		return sdk.NewCoin(rateLimit.Limit.Denom, sdk.ZeroInt())
+	}
+
+	return rateLimit.Limit.Sub(minted)
+}
+
+// formatMintRateLimit returns the limit and window of a mint rate limit for the audit log.
+func formatMintRateLimit(rateLimit types.MintRateLimit) string {
+	return fmt.Sprintf("%s per %s", rateLimit.Limit, rateLimit.Window)
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNMintRateLimit(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.MintRateLimit {
+	items := make([]types.MintRateLimit, n)
+	for i := range items {
+		items[i].Denom = testDenom
+		items[i].Minter = sample.AccAddress()
+		items[i].Window = time.Hour
+		items[i].Limit = sdk.NewCoin(testDenom, sdk.NewInt(int64(i+1)))
+
+		keeper.SetMintRateLimit(ctx, items[i])
+	}
+	return items
+}
+
+func TestMintRateLimitGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNMintRateLimit(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetMintRateLimit(ctx,
+			testDenom,
+			item.Minter,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestMintRateLimitRemove(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNMintRateLimit(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.SetMintRateLimitWindow(ctx, types.MintRateLimitWindow{
+			Denom:  testDenom,
+			Minter: item.Minter,
+			Buckets: []types.MintRateLimitBucket{
+				{Start: ctx.BlockTime(), Minted: sdk.NewCoin(testDenom, sdk.NewInt(1))},
+			},
+		})
+
+		keeper.DeleteMintRateLimit(ctx,
+			testDenom,
+			item.Minter,
+		)
+		_, found := keeper.GetMintRateLimit(ctx,
+			testDenom,
+			item.Minter,
+		)
+		require.False(t, found)
+		_, found = keeper.GetMintRateLimitWindow(ctx,
+			testDenom,
+			item.Minter,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestMintRateLimitGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNMintRateLimit(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllMintRateLimits(ctx)),
+	)
+}
+
+func TestCurrentMintRateLimitWindow(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+
+	rateLimit := types.MintRateLimit{
+		Denom:  testDenom,
+		Window: 24 * time.Hour,
+		Limit:  sdk.NewCoin(testDenom, sdk.NewInt(100)),
+	}
+	k.SetMintRateLimit(ctx, rateLimit)
+
+	window := k.CurrentMintRateLimitWindow(ctx, rateLimit)
+	require.Empty(t, window.Buckets)
+	require.True(t, keeper.MintRateLimitMinted(rateLimit, window).IsZero())
+
+	k.SetMintRateLimitWindow(ctx, types.MintRateLimitWindow{
+		Denom: testDenom,
+		Buckets: []types.MintRateLimitBucket{
+			{Start: start, Minted: sdk.NewCoin(testDenom, sdk.NewInt(40))},
+			{Start: start.Add(12 * time.Hour), Minted: sdk.NewCoin(testDenom, sdk.NewInt(30))},
+		},
+	})
+
+	// a bucket counts until the window has passed its end
+	ctx = ctx.WithBlockTime(start.Add(24*time.Hour + 59*time.Minute))
+	window = k.CurrentMintRateLimitWindow(ctx, rateLimit)
+	require.Len(t, window.Buckets, 2)
+	require.Equal(t, sdk.NewInt(70), keeper.MintRateLimitMinted(rateLimit, window).Amount)
+	require.Equal(t, sdk.NewInt(30), keeper.MintRateLimitRemaining(rateLimit, window).Amount)
+
+	ctx = ctx.WithBlockTime(start.Add(25 * time.Hour))
+	window = k.CurrentMintRateLimitWindow(ctx, rateLimit)
+	require.Len(t, window.Buckets, 1)
+	require.Equal(t, start.Add(12*time.Hour), window.Buckets[0].Start)
+	require.Equal(t, sdk.NewInt(30), keeper.MintRateLimitMinted(rateLimit, window).Amount)
+
+	ctx = ctx.WithBlockTime(start.Add(37 * time.Hour))
+	window = k.CurrentMintRateLimitWindow(ctx, rateLimit)
+	require.Empty(t, window.Buckets)
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// SetMintStats set a specific mintStats in the store from its index
+func (k Keeper) SetMintStats(ctx sdk.Context, mintStats types.MintStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintStatsKeyPrefix))
+	b := k.cdc.MustMarshal(&mintStats)
+	store.Set(types.MintStatsKey(mintStats.Denom, mintStats.Minter), b)
+}
+
+// GetMintStats returns the lifetime counters of a minter, or of all minters of the denom if the
+// minter is empty. Counters that were never recorded are returned as zero.
+func (k Keeper) GetMintStats(ctx sdk.Context, denom string, minter string) types.MintStats {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintStatsKeyPrefix))
+
+	b := store.Get(types.MintStatsKey(denom, minter))
+	if b == nil {
+		return types.MintStats{
+			Denom:  denom,
+			Minter: minter,
+			Minted: sdk.NewCoin(denom, sdk.ZeroInt()),
+			Burned: sdk.NewCoin(denom, sdk.ZeroInt()),
+		}
+	}
+
+	var val types.MintStats
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// GetAllMintStats returns all mintStats
+func (k Keeper) GetAllMintStats(ctx sdk.Context) (list []types.MintStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintStatsKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MintStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetDailyMintStats set a specific dailyMintStats in the store from its index
+func (k Keeper) SetDailyMintStats(ctx sdk.Context, dailyMintStats types.DailyMintStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DailyMintStatsKeyPrefix))
+	b := k.cdc.MustMarshal(&dailyMintStats)
+	store.Set(types.DailyMintStatsKey(dailyMintStats.Denom, dailyMintStats.Minter, dailyMintStats.Date), b)
+}
+
+// GetDailyMintStats returns the counters of a minter on a date, or of all minters of the denom if
+// the minter is empty. Counters that were never recorded are returned as zero.
+func (k Keeper) GetDailyMintStats(ctx sdk.Context, denom string, minter string, date string) types.DailyMintStats {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DailyMintStatsKeyPrefix))
+
+	b := store.Get(types.DailyMintStatsKey(denom, minter, date))
+	if b == nil {
+		return types.DailyMintStats{
+			Denom:  denom,
+			Minter: minter,
+			Date:   date,
+			Minted: sdk.NewCoin(denom, sdk.ZeroInt()),
+			Burned: sdk.NewCoin(denom, sdk.ZeroInt()),
+		}
+	}
+
+	var val types.DailyMintStats
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// GetAllDailyMintStats returns all dailyMintStats
+func (k Keeper) GetAllDailyMintStats(ctx sdk.Context) (list []types.DailyMintStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DailyMintStatsKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DailyMintStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// recordMinted adds an amount minted by a minter to its counters and to the counters across all
+// minters of the denom.
+func (k Keeper) recordMinted(ctx sdk.Context, minter string, amount sdk.Coin) {
+	k.addMintStats(ctx, []string{minter, ""}, amount, sdk.NewCoin(amount.Denom, sdk.ZeroInt()))
+}
+
+// recordBurned adds an amount burned by a minter to its counters and to the counters across all
+// minters of the denom.
+func (k Keeper) recordBurned(ctx sdk.Context, minter string, amount sdk.Coin) {
+	k.addMintStats(ctx, []string{minter, ""}, sdk.NewCoin(amount.Denom, sdk.ZeroInt()), amount)
+}
+
+// recordWiped adds a wiped blacklisted balance to the burned counters across all minters of the
+// denom only, as it was not burned by a minter.
+func (k Keeper) recordWiped(ctx sdk.Context, amount sdk.Coin) {
+	k.addMintStats(ctx, []string{""}, sdk.NewCoin(amount.Denom, sdk.ZeroInt()), amount)
+}
+
+func (k Keeper) addMintStats(ctx sdk.Context, addresses []string, minted sdk.Coin, burned sdk.Coin) {
+	denom := minted.Denom
+	date := types.MintStatsDate(ctx.BlockTime())
+
+	for _, address := range addresses {
+		stats := k.GetMintStats(ctx, denom, address)
+		stats.Minted = stats.Minted.Add(minted)
+		stats.Burned = stats.Burned.Add(burned)
+		k.SetMintStats(ctx, stats)
+
+		daily := k.GetDailyMintStats(ctx, denom, address, date)
+		daily.Minted = daily.Minted.Add(minted)
+		daily.Burned = daily.Burned.Add(burned)
+		k.SetDailyMintStats(ctx, daily)
+	}
+}
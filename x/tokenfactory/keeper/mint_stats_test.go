@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestMintStats(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, keepertest.NewMockSupplyBankKeeper())
+	server := keeper.NewMsgServerImpl(k)
+
+	minter1, minter2, user := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(testDenom, sdk.NewInt(amount))
+	}
+	daily := func(minter string, date string, minted int64, burned int64) types.DailyMintStats {
+		return types.DailyMintStats{Denom: testDenom, Minter: minter, Date: date, Minted: coin(minted), Burned: coin(burned)}
+	}
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+
+	params := types.DefaultParams()
+	params.MinRedemptionAmount = sdk.NewInt(1)
+	k.SetParams(ctx, params)
+	k.SetMinters(ctx, types.Minters{Address: minter1, Allowance: coin(1000), Denom: testDenom})
+	k.SetMinters(ctx, types.Minters{Address: minter2, Allowance: coin(1000), Denom: testDenom})
+
+	day1 := time.Date(2024, 1, 1, 23, 59, 59, 0, time.UTC)
+	day2 := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	day3 := time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC)
+
+	wctx := sdk.WrapSDKContext(ctx.WithBlockTime(day1))
+	_, err := server.Mint(wctx, types.NewMsgMint(minter1, minter1, coin(100)))
+	require.NoError(t, err)
+	_, err = server.Burn(wctx, types.NewMsgBurn(minter1, coin(40)))
+	require.NoError(t, err)
+
+	wctx = sdk.WrapSDKContext(ctx.WithBlockTime(day2))
+	_, err = server.Mint(wctx, types.NewMsgMint(minter1, user, coin(10)))
+	require.NoError(t, err)
+	_, err = server.Mint(wctx, types.NewMsgMint(minter2, user, coin(50)))
+	require.NoError(t, err)
+
+	// a fulfilled redemption counts as burned by the minter that fulfilled it
+	wctx = sdk.WrapSDKContext(ctx.WithBlockTime(day3))
+	res, err := server.RequestRedemption(wctx, types.NewMsgRequestRedemption(user, coin(20), "iban:DE00"))
+	require.NoError(t, err)
+	_, err = server.FulfillRedemption(wctx, types.NewMsgFulfillRedemption(minter2, res.Id, "wire-1"))
+	require.NoError(t, err)
+
+	// failed mints are not counted
+	_, err = server.Mint(wctx, types.NewMsgMint(minter2, user, coin(5000)))
+	require.Error(t, err)
+
+	require.Equal(t, types.MintStats{Denom: testDenom, Minter: minter1, Minted: coin(110), Burned: coin(40)}, k.GetMintStats(ctx, testDenom, minter1))
+	require.Equal(t, types.MintStats{Denom: testDenom, Minter: minter2, Minted: coin(50), Burned: coin(20)}, k.GetMintStats(ctx, testDenom, minter2))
+	require.Equal(t, types.MintStats{Denom: testDenom, Minter: "", Minted: coin(160), Burned: coin(60)}, k.GetMintStats(ctx, testDenom, ""))
+	require.Equal(t, types.MintStats{Denom: testDenom, Minter: user, Minted: coin(0), Burned: coin(0)}, k.GetMintStats(ctx, testDenom, user))
+	require.Len(t, k.GetAllMintStats(ctx), 3)
+
+	require.Equal(t, daily(minter1, "2024-01-01", 100, 40), k.GetDailyMintStats(ctx, testDenom, minter1, "2024-01-01"))
+	require.Equal(t, daily(minter1, "2024-01-02", 10, 0), k.GetDailyMintStats(ctx, testDenom, minter1, "2024-01-02"))
+	require.Equal(t, daily(minter1, "2024-01-03", 0, 0), k.GetDailyMintStats(ctx, testDenom, minter1, "2024-01-03"))
+	require.Equal(t, daily("", "2024-01-02", 60, 0), k.GetDailyMintStats(ctx, testDenom, "", "2024-01-02"))
+	require.Len(t, k.GetAllDailyMintStats(ctx), 7)
+
+	wctx = sdk.WrapSDKContext(ctx)
+
+	minterRes, err := k.MinterStats(wctx, &types.QueryMinterStatsRequest{Denom: testDenom, Minter: minter1})
+	require.NoError(t, err)
+	require.Equal(t, k.GetMintStats(ctx, testDenom, minter1), minterRes.Lifetime)
+	require.Equal(t, []types.DailyMintStats{daily(minter1, "2024-01-01", 100, 40), daily(minter1, "2024-01-02", 10, 0)}, minterRes.Daily)
+	require.Equal(t, coin(110), minterRes.Minted)
+	require.Equal(t, coin(40), minterRes.Burned)
+
+	minterRes, err = k.MinterStats(wctx, &types.QueryMinterStatsRequest{Denom: testDenom, Minter: minter1, StartDate: "2024-01-02"})
+	require.NoError(t, err)
+	require.Equal(t, []types.DailyMintStats{daily(minter1, "2024-01-02", 10, 0)}, minterRes.Daily)
+	require.Equal(t, coin(10), minterRes.Minted)
+	require.Equal(t, coin(0), minterRes.Burned)
+
+	minterRes, err = k.MinterStats(wctx, &types.QueryMinterStatsRequest{Denom: testDenom, Minter: minter1, EndDate: "2024-01-01"})
+	require.NoError(t, err)
+	require.Equal(t, []types.DailyMintStats{daily(minter1, "2024-01-01", 100, 40)}, minterRes.Daily)
+	require.Equal(t, coin(100), minterRes.Minted)
+	require.Equal(t, coin(40), minterRes.Burned)
+
+	// a page key outside of the date range does not return days outside of it
+	minterRes, err = k.MinterStats(wctx, &types.QueryMinterStatsRequest{
+		Denom:      testDenom,
+		Minter:     minter1,
+		EndDate:    "2024-01-01",
+		Pagination: &query.PageRequest{Key: []byte("2024-01-02")},
+	})
+	require.NoError(t, err)
+	require.Empty(t, minterRes.Daily)
+
+	minterRes, err = k.MinterStats(wctx, &types.QueryMinterStatsRequest{
+		Denom:      testDenom,
+		Minter:     minter1,
+		StartDate:  "2024-01-01",
+		EndDate:    "2024-01-02",
+		Pagination: &query.PageRequest{Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.DailyMintStats{daily(minter1, "2024-01-02", 10, 0), daily(minter1, "2024-01-01", 100, 40)}, minterRes.Daily)
+
+	// the range totals cover every day in the range, not only the returned page
+	moduleRes, err := k.ModuleStats(wctx, &types.QueryModuleStatsRequest{
+		Denom:      testDenom,
+		StartDate:  "2024-01-02",
+		EndDate:    "2024-01-03",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, k.GetMintStats(ctx, testDenom, ""), moduleRes.Lifetime)
+	require.Equal(t, []types.DailyMintStats{daily("", "2024-01-02", 60, 0)}, moduleRes.Daily)
+	require.Equal(t, coin(60), moduleRes.Minted)
+	require.Equal(t, coin(20), moduleRes.Burned)
+	require.Equal(t, uint64(2), moduleRes.Pagination.Total)
+
+	moduleRes, err = k.ModuleStats(wctx, &types.QueryModuleStatsRequest{
+		Denom:      testDenom,
+		StartDate:  "2024-01-02",
+		EndDate:    "2024-01-03",
+		Pagination: &query.PageRequest{Key: moduleRes.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.DailyMintStats{daily("", "2024-01-03", 0, 20)}, moduleRes.Daily)
+	require.Nil(t, moduleRes.Pagination.NextKey)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.QueryModuleStatsRequest
+		err     error
+	}{
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		{
+			desc:    "InvalidDenom",
+			request: &types.QueryModuleStatsRequest{},
+			err:     status.Error(codes.InvalidArgument, "invalid denom: "),
+		},
+		{
+			desc:    "InvalidDate",
+			request: &types.QueryModuleStatsRequest{Denom: testDenom, StartDate: "2024-1-1"},
+			err:     status.Error(codes.InvalidArgument, `invalid date "2024-1-1", expected YYYY-MM-DD`),
+		},
+		{
+			desc:    "InvalidRange",
+			request: &types.QueryModuleStatsRequest{Denom: testDenom, StartDate: "2024-01-03", EndDate: "2024-01-02"},
+			err:     status.Error(codes.InvalidArgument, "start date can not be after the end date"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := k.ModuleStats(wctx, tc.request)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	_, err = k.MinterStats(wctx, &types.QueryMinterStatsRequest{Denom: testDenom})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "minter can not be empty"))
+}
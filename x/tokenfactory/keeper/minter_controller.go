@@ -12,6 +12,15 @@ func (k Keeper) SetMinterController(ctx sdk.Context, minterController types.Mint
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerKeyPrefix))
 	b := k.cdc.MustMarshal(&minterController)
 	store.Set(types.MinterControllerKey(
+		minterController.Denom,
+		minterController.Controller,
+		minterController.Minter,
+	), b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerByMinterKeyPrefix))
+	indexStore.Set(types.MinterControllerByMinterKey(
+		minterController.Denom,
+		minterController.Minter,
 		minterController.Controller,
 	), b)
 }
@@ -19,13 +28,17 @@ func (k Keeper) SetMinterController(ctx sdk.Context, minterController types.Mint
 // GetMinterController returns a minterController from its index
 func (k Keeper) GetMinterController(
 	ctx sdk.Context,
+	denom string,
 	controller string,
+	minter string,
 
 ) (val types.MinterController, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerKeyPrefix))
 
 	b := store.Get(types.MinterControllerKey(
+		denom,
 		controller,
+		minter,
 	))
 	if b == nil {
 		return val, false
@@ -35,16 +48,58 @@ func (k Keeper) GetMinterController(
 	return val, true
 }
 
-// RemoveMinterController removes a minterController from the store
+// DeleteMinterController removes a minterController from the store
 func (k Keeper) DeleteMinterController(
 	ctx sdk.Context,
+	denom string,
 	controller string,
+	minter string,
 
 ) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerKeyPrefix))
 	store.Delete(types.MinterControllerKey(
+		denom,
 		controller,
+		minter,
 	))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerByMinterKeyPrefix))
+	indexStore.Delete(types.MinterControllerByMinterKey(
+		denom,
+		minter,
+		controller,
+	))
+}
+
+// GetMintersByController returns all minterControllers of a controller
+func (k Keeper) GetMintersByController(ctx sdk.Context, denom string, controller string) (list []types.MinterController) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.MinterControllerPrefix(denom, controller))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MinterController
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetControllerByMinter returns the minterController of a minter, since a minter has at most one controller
+func (k Keeper) GetControllerByMinter(ctx sdk.Context, denom string, minter string) (val types.MinterController, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerByMinterKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.MinterControllerByMinterPrefix(denom, minter))
+
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val, true
 }
 
 // GetAllMinterController returns all minterController
@@ -20,6 +20,8 @@ func createNMinterController(keeper *keeper.Keeper, ctx sdk.Context, n int) []ty
 	items := make([]types.MinterController, n)
 	for i := range items {
 		items[i].Controller = strconv.Itoa(i)
+		items[i].Minter = strconv.Itoa(i)
+		items[i].Denom = testDenom
 
 		keeper.SetMinterController(ctx, items[i])
 	}
@@ -31,7 +33,9 @@ func TestMinterControllerGet(t *testing.T) {
 	items := createNMinterController(keeper, ctx, 10)
 	for _, item := range items {
 		rst, found := keeper.GetMinterController(ctx,
+			testDenom,
 			item.Controller,
+			item.Minter,
 		)
 		require.True(t, found)
 		require.Equal(t,
@@ -45,9 +49,13 @@ func TestMinterControllerRemove(t *testing.T) {
 	items := createNMinterController(keeper, ctx, 10)
 	for _, item := range items {
 		keeper.DeleteMinterController(ctx,
+			testDenom,
+			item.Controller,
 			item.Minter,
 		)
 		_, found := keeper.GetMinterController(ctx,
+			testDenom,
+			item.Controller,
 			item.Minter,
 		)
 		require.False(t, found)
@@ -62,3 +70,33 @@ func TestMinterControllerGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllMinterControllers(ctx)),
 	)
 }
+
+func TestMinterControllerIndexes(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+
+	pairs := []types.MinterController{
+		{Denom: testDenom, Controller: "controller1", Minter: "minter1"},
+		{Denom: testDenom, Controller: "controller1", Minter: "minter2"},
+		{Denom: testDenom, Controller: "controller2", Minter: "minter3"},
+		{Denom: "other", Controller: "controller1", Minter: "minter1"},
+	}
+	for _, pair := range pairs {
+		keeper.SetMinterController(ctx, pair)
+	}
+
+	require.ElementsMatch(t, pairs[:2], keeper.GetMintersByController(ctx, testDenom, "controller1"))
+	require.ElementsMatch(t, pairs[3:], keeper.GetMintersByController(ctx, "other", "controller1"))
+	controller, found := keeper.GetControllerByMinter(ctx, testDenom, "minter1")
+	require.True(t, found)
+	require.Equal(t, pairs[0], controller)
+
+	keeper.DeleteMinterController(ctx, testDenom, "controller1", "minter1")
+
+	require.ElementsMatch(t, pairs[1:2], keeper.GetMintersByController(ctx, testDenom, "controller1"))
+	_, found = keeper.GetControllerByMinter(ctx, testDenom, "minter1")
+	require.False(t, found)
+	controller, found = keeper.GetControllerByMinter(ctx, "other", "minter1")
+	require.True(t, found)
+	require.Equal(t, pairs[3], controller)
+	require.Len(t, keeper.GetAllMinterControllers(ctx), 3)
+}
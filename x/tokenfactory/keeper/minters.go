@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"errors"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
@@ -8,22 +10,35 @@ import (
 
 // SetMinters set a specific minters in the store from its index
 func (k Keeper) SetMinters(ctx sdk.Context, minters types.Minters) {
+	if existing, found := k.GetMinters(ctx, minters.Denom, minters.Address); found {
+		k.removeMintersExpiry(ctx, existing)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintersKeyPrefix))
 	b := k.cdc.MustMarshal(&minters)
-	store.Set(types.MintersKey(
+	key := types.MintersKey(
+		minters.Denom,
 		minters.Address,
-	), b)
+	)
+	store.Set(key, b)
+
+	if minters.Expiry != nil {
+		expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintersExpiryKeyPrefix))
+		expiryStore.Set(types.MintersExpiryKey(*minters.Expiry, minters.Denom, minters.Address), key)
+	}
 }
 
 // GetMinters returns a minters from its index
 func (k Keeper) GetMinters(
 	ctx sdk.Context,
+	denom string,
 	address string,
 
 ) (val types.Minters, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintersKeyPrefix))
 
 	b := store.Get(types.MintersKey(
+		denom,
 		address,
 	))
 	if b == nil {
@@ -34,14 +49,20 @@ func (k Keeper) GetMinters(
 	return val, true
 }
 
-// RemoveMinters removes a minters from the store
+// RemoveMinters removes a minters, along with its entry in the expiry index, from the store
 func (k Keeper) RemoveMinters(
 	ctx sdk.Context,
+	denom string,
 	address string,
 
 ) {
+	if existing, found := k.GetMinters(ctx, denom, address); found {
+		k.removeMintersExpiry(ctx, existing)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintersKeyPrefix))
 	store.Delete(types.MintersKey(
+		denom,
 		address,
 	))
 }
@@ -61,3 +82,59 @@ func (k Keeper) GetAllMinters(ctx sdk.Context) (list []types.Minters) {
 
 	return
 }
+
+func (k Keeper) removeMintersExpiry(ctx sdk.Context, minters types.Minters) {
+	if minters.Expiry == nil {
+		return
+	}
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintersExpiryKeyPrefix))
+	expiryStore.Delete(types.MintersExpiryKey(*minters.Expiry, minters.Denom, minters.Address))
+}
+
+// ExpireMinterAllowances resets the allowance of every minter whose expiry is at or before the
+// current block time to zero. It is called at the end of every block. Each minter is expired in its
+// own cached context, so that a failure leaves it to be retried without affecting the others.
+func (k Keeper) ExpireMinterAllowances(ctx sdk.Context) error {
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintersExpiryKeyPrefix))
+	iterator := expiryStore.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Value())
+	}
+	iterator.Close()
+
+	var errs []error
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintersKeyPrefix))
+	for _, key := range keys {
+		var minter types.Minters
+		k.cdc.MustUnmarshal(store.Get(key), &minter)
+
+		if err := runCached(ctx, func(ctx sdk.Context) error {
+			return k.expireMinterAllowance(ctx, minter)
+		}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (k Keeper) expireMinterAllowance(ctx sdk.Context, minter types.Minters) error {
+	expiredAllowance := minter.Allowance
+	expiry := *minter.Expiry
+
+	minter.Allowance = sdk.NewCoin(minter.Denom, sdk.ZeroInt())
+	minter.Expiry = nil
+	k.SetMinters(ctx, minter)
+	k.recordAudit(ctx, minter.Denom, types.AuditActionConfigureMinter, moduleActor(), minter.Address, expiredAllowance.String(), minter.Allowance.String())
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMinterAllowanceExpired{
+		Minter:           minter.Address,
+		Denom:            minter.Denom,
+		ExpiredAllowance: expiredAllowance,
+		Expiry:           expiry,
+	})
+}
@@ -19,6 +19,7 @@ func createNMinters(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Minte
 	items := make([]types.Minters, n)
 	for i := range items {
 		items[i].Address = strconv.Itoa(i)
+		items[i].Denom = testDenom
 
 		keeper.SetMinters(ctx, items[i])
 	}
@@ -30,6 +31,7 @@ func TestMintersGet(t *testing.T) {
 	items := createNMinters(keeper, ctx, 10)
 	for _, item := range items {
 		rst, found := keeper.GetMinters(ctx,
+			testDenom,
 			item.Address,
 		)
 		require.True(t, found)
@@ -44,9 +46,11 @@ func TestMintersRemove(t *testing.T) {
 	items := createNMinters(keeper, ctx, 10)
 	for _, item := range items {
 		keeper.RemoveMinters(ctx,
+			testDenom,
 			item.Address,
 		)
 		_, found := keeper.GetMinters(ctx,
+			testDenom,
 			item.Address,
 		)
 		require.False(t, found)
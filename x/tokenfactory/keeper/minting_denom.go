@@ -11,7 +11,7 @@ import (
 
 // SetMintingDenom set mintingDenom in the store
 func (k *Keeper) SetMintingDenom(ctx sdk.Context, mintingDenom types.MintingDenom) {
-	if k.MintingDenomSet(ctx) {
+	if k.MintingDenomSet(ctx, mintingDenom.Denom) {
 		panic(types.ErrMintingDenomSet)
 	}
 
@@ -22,30 +22,41 @@ func (k *Keeper) SetMintingDenom(ctx sdk.Context, mintingDenom types.MintingDeno
 
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintingDenomKey))
 	b := k.cdc.MustMarshal(&mintingDenom)
-	store.Set(types.KeyPrefix(types.MintingDenomKey), b)
+	store.Set(types.DenomKey(mintingDenom.Denom), b)
 }
 
 // GetMintingDenom returns mintingDenom
-func (k *Keeper) GetMintingDenom(ctx sdk.Context) (val types.MintingDenom) {
+func (k *Keeper) GetMintingDenom(ctx sdk.Context, denom string) (val types.MintingDenom, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintingDenomKey))
 
-	b := store.Get(types.KeyPrefix(types.MintingDenomKey))
+	b := store.Get(types.DenomKey(denom))
 	if b == nil {
-		panic("Minting denom is not set")
+		return val, false
 	}
 
 	k.cdc.MustUnmarshal(b, &val)
-	return val
+	return val, true
 }
 
-// MintingDenomSet returns true if the MintingDenom is already set in the store, it returns false otherwise.
-func (k Keeper) MintingDenomSet(ctx sdk.Context) bool {
+// GetAllMintingDenoms returns all mintingDenoms
+func (k *Keeper) GetAllMintingDenoms(ctx sdk.Context) (list []types.MintingDenom) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintingDenomKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
 
-	b := store.Get(types.KeyPrefix(types.MintingDenomKey))
-	if b == nil {
-		return false
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MintingDenom
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
 	}
 
-	return true
+	return
+}
+
+// MintingDenomSet returns true if the denom is a registered MintingDenom, it returns false otherwise.
+func (k Keeper) MintingDenomSet(ctx sdk.Context, denom string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintingDenomKey))
+
+	return store.Has(types.DenomKey(denom))
 }
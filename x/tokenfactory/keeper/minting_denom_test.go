@@ -12,9 +12,11 @@ import (
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 )
 
+const testDenom = "utoken"
+
 func createTestMintingDenom(keeper *keeper.Keeper, ctx sdk.Context) types.MintingDenom {
 	item := types.MintingDenom{
-		Denom: "abcd",
+		Denom: testDenom,
 	}
 	keeper.SetMintingDenom(ctx, item)
 	return item
@@ -23,9 +25,25 @@ func createTestMintingDenom(keeper *keeper.Keeper, ctx sdk.Context) types.Mintin
 func TestMintingDenomGet(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	item := createTestMintingDenom(keeper, ctx)
-	rst := keeper.GetMintingDenom(ctx)
+	rst, found := keeper.GetMintingDenom(ctx, item.Denom)
+	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&item),
 		nullify.Fill(&rst),
 	)
 }
+
+func TestMintingDenomGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := []types.MintingDenom{{Denom: "uone"}, {Denom: "utwo"}}
+	for _, item := range items {
+		keeper.SetMintingDenom(ctx, item)
+	}
+
+	require.True(t, keeper.MintingDenomSet(ctx, "uone"))
+	require.False(t, keeper.MintingDenomSet(ctx, "uthree"))
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllMintingDenoms(ctx)),
+	)
+}
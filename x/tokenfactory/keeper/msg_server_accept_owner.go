@@ -12,7 +12,7 @@ import (
 func (k msgServer) AcceptOwner(goCtx context.Context, msg *types.MsgAcceptOwner) (*types.MsgAcceptOwnerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner, found := k.GetPendingOwner(ctx)
+	owner, found := k.GetPendingOwner(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pending owner is not set")
 	}
@@ -21,11 +21,20 @@ func (k msgServer) AcceptOwner(goCtx context.Context, msg *types.MsgAcceptOwner)
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pending owner")
 	}
 
+	previousOwner, _ := k.GetOwner(ctx, msg.Denom)
+
 	k.SetOwner(ctx, owner)
+	k.recordAudit(ctx, msg.Denom, types.AuditActionAcceptOwner, msg.From, owner.Address, previousOwner.Address, owner.Address)
 
-	k.DeletePendingOwner(ctx)
+	k.DeletePendingOwner(ctx, msg.Denom)
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventRoleUpdated{
+		Denom:           msg.Denom,
+		Role:            types.RoleOwner,
+		PreviousAddress: previousOwner.Address,
+		Address:         owner.Address,
+		UpdatedBy:       msg.From,
+	})
 
 	return &types.MsgAcceptOwnerResponse{}, err
 }
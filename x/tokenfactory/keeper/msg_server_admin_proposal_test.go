@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestAdminProposals(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+
+	owner, pauser, blacklister := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	a, b, c := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetOwner(ctx, types.Owner{Address: owner, Denom: testDenom})
+	k.SetPauser(ctx, types.Pauser{Address: pauser, Denom: testDenom})
+	k.SetBlacklister(ctx, types.Blacklister{Address: blacklister, Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+
+	submit := func(ctx sdk.Context, from string, msg types.AdminMsg) (*types.MsgSubmitAdminProposalResponse, error) {
+		submitMsg, err := types.NewMsgSubmitAdminProposal(from, testDenom, msg)
+		require.NoError(t, err)
+		return server.SubmitAdminProposal(sdk.WrapSDKContext(ctx), submitMsg)
+	}
+	approve := func(ctx sdk.Context, from string, id uint64) (*types.MsgApproveResponse, error) {
+		return server.Approve(sdk.WrapSDKContext(ctx), types.NewMsgApprove(from, testDenom, id))
+	}
+	reject := func(ctx sdk.Context, from string, id uint64) (*types.MsgRejectResponse, error) {
+		return server.Reject(sdk.WrapSDKContext(ctx), types.NewMsgReject(from, testDenom, id))
+	}
+	getPauser := func() string {
+		pauser, found := k.GetPauser(ctx, testDenom)
+		require.True(t, found)
+		return pauser.Address
+	}
+
+	// proposals can only be submitted once a quorum is set
+	_, err := submit(ctx, a, types.NewMsgUpdatePauser(owner, sample.AccAddress(), testDenom))
+	require.ErrorIs(t, err, types.ErrAdminProposal)
+
+	setQuorum := types.NewMsgSetAdminQuorum(owner, testDenom, []string{a, b, c}, 2, time.Hour)
+	_, err = server.SetAdminQuorum(sdk.WrapSDKContext(ctx), types.NewMsgSetAdminQuorum(a, testDenom, []string{a, b, c}, 2, time.Hour))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = server.SetAdminQuorum(sdk.WrapSDKContext(ctx), setQuorum)
+	require.NoError(t, err)
+	quorum, found := k.GetAdminQuorum(ctx, testDenom)
+	require.True(t, found)
+	require.Equal(t, setQuorum.AdminQuorum(), quorum)
+
+	// the owner can no longer rotate roles on its own
+	newPauser := sample.AccAddress()
+	updatePauser := types.NewMsgUpdatePauser(owner, newPauser, testDenom)
+	_, err = server.UpdatePauser(sdk.WrapSDKContext(ctx), updatePauser)
+	require.ErrorIs(t, err, types.ErrAdminProposalRequired)
+	_, err = server.SetAdminQuorum(sdk.WrapSDKContext(ctx), setQuorum)
+	require.ErrorIs(t, err, types.ErrAdminProposalRequired)
+
+	// only approvers can submit proposals, of admin messages that can be executed
+	_, err = submit(ctx, owner, updatePauser)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = submit(ctx, a, types.NewMsgUpdatePauser(a, newPauser, testDenom))
+	require.ErrorIs(t, err, types.ErrAdminProposal)
+	_, err = submit(ctx, a, &types.MsgPause{From: pauser, Denom: testDenom})
+	require.ErrorIs(t, err, types.ErrAdminProposal)
+
+	submitted, err := submit(ctx, a, updatePauser)
+	require.NoError(t, err)
+	require.False(t, submitted.Executed)
+	require.Equal(t, pauser, getPauser())
+
+	proposal, found := k.GetAdminProposal(ctx, testDenom, submitted.Id)
+	require.True(t, found)
+	require.Equal(t, a, proposal.Proposer)
+	require.Equal(t, []string{a}, proposal.Approvals)
+	require.Equal(t, now.Add(time.Hour), proposal.Expiry)
+
+	resp, err := k.AdminProposalAll(sdk.WrapSDKContext(ctx), &types.QueryAllAdminProposalRequest{Denom: testDenom})
+	require.NoError(t, err)
+	require.Len(t, resp.AdminProposal, 1)
+
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = approve(cacheCtx, a, submitted.Id)
+	require.ErrorIs(t, err, types.ErrAdminProposal)
+	_, err = approve(cacheCtx, owner, submitted.Id)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = approve(cacheCtx, b, 100)
+	require.ErrorIs(t, err, types.ErrAdminProposal)
+
+	// the second approval reaches the threshold and executes the proposal
+	approved, err := approve(ctx, b, submitted.Id)
+	require.NoError(t, err)
+	require.True(t, approved.Executed)
+	require.Equal(t, newPauser, getPauser())
+	_, found = k.GetAdminProposal(ctx, testDenom, submitted.Id)
+	require.False(t, found)
+	require.Equal(t, &types.EventAdminProposalExecuted{
+		Denom:      testDenom,
+		Id:         submitted.Id,
+		MsgTypeURL: sdk.MsgTypeURL(updatePauser),
+	}, lastEvent(t, ctx))
+
+	// the proposal is rejected once the remaining approvers can no longer reach the threshold
+	k.SetPaused(ctx, types.Paused{Denom: testDenom, Paused: true, Mint: true})
+	submitted, err = submit(ctx, a, &types.MsgUnpause{From: newPauser, Denom: testDenom})
+	require.NoError(t, err)
+
+	rejected, err := reject(ctx, b, submitted.Id)
+	require.NoError(t, err)
+	require.False(t, rejected.Rejected)
+	rejected, err = reject(ctx, c, submitted.Id)
+	require.NoError(t, err)
+	require.True(t, rejected.Rejected)
+	_, found = k.GetAdminProposal(ctx, testDenom, submitted.Id)
+	require.False(t, found)
+	require.True(t, k.GetPaused(ctx, testDenom).Mint)
+
+	// proposals can not be voted on once they expire, and are removed at the end of the block
+	submitted, err = submit(ctx, a, &types.MsgUnpause{From: newPauser, Denom: testDenom})
+	require.NoError(t, err)
+
+	expiredCtx := ctx.WithBlockTime(now.Add(time.Hour))
+	cacheCtx, _ = expiredCtx.CacheContext()
+	_, err = approve(cacheCtx, b, submitted.Id)
+	require.ErrorIs(t, err, types.ErrAdminProposal)
+	require.NoError(t, k.ExpireAdminProposals(expiredCtx))
+	_, found = k.GetAdminProposal(ctx, testDenom, submitted.Id)
+	require.False(t, found)
+
+	// a proposal whose message fails when executed is removed without applying any change
+	address := sample.AccAddress()
+	pauserProposal, err := submit(ctx, a, types.NewMsgUpdatePauser(owner, address, testDenom))
+	require.NoError(t, err)
+	blacklisterProposal, err := submit(ctx, a, types.NewMsgUpdateBlacklister(owner, address, testDenom))
+	require.NoError(t, err)
+
+	approved, err = approve(ctx, b, blacklisterProposal.Id)
+	require.NoError(t, err)
+	require.True(t, approved.Executed)
+
+	approved, err = approve(ctx, b, pauserProposal.Id)
+	require.NoError(t, err)
+	require.False(t, approved.Executed)
+	require.Equal(t, newPauser, getPauser())
+	_, found = k.GetAdminProposal(ctx, testDenom, pauserProposal.Id)
+	require.False(t, found)
+	failed, ok := lastEvent(t, ctx).(*types.EventAdminProposalFailed)
+	require.True(t, ok)
+	require.Equal(t, pauserProposal.Id, failed.Id)
+	require.Contains(t, failed.Error, types.ErrAlreadyPrivileged.Error())
+
+	// the quorum itself is changed through a proposal, after which a single approval is enough
+	submitted, err = submit(ctx, a, types.NewMsgSetAdminQuorum(owner, testDenom, []string{a, b}, 1, time.Hour))
+	require.NoError(t, err)
+	approved, err = approve(ctx, c, submitted.Id)
+	require.NoError(t, err)
+	require.True(t, approved.Executed)
+	quorum, _ = k.GetAdminQuorum(ctx, testDenom)
+	require.Equal(t, uint32(1), quorum.Threshold)
+
+	submitted, err = submit(ctx, b, types.NewMsgUpdatePauser(owner, pauser, testDenom))
+	require.NoError(t, err)
+	require.True(t, submitted.Executed)
+	require.Equal(t, pauser, getPauser())
+
+	// removing the quorum restores direct role rotations
+	submitted, err = submit(ctx, a, types.NewMsgSetAdminQuorum(owner, testDenom, nil, 0, 0))
+	require.NoError(t, err)
+	require.True(t, submitted.Executed)
+	_, found = k.GetAdminQuorum(ctx, testDenom)
+	require.False(t, found)
+
+	_, err = server.UpdatePauser(sdk.WrapSDKContext(ctx), types.NewMsgUpdatePauser(owner, newPauser, testDenom))
+	require.NoError(t, err)
+}
+
+func TestAdminProposalOfOtherDenom(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	const otherDenom = "uother"
+	attacker, approver, otherOwner := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	for _, denom := range []string{testDenom, otherDenom} {
+		k.SetMintingDenom(ctx, types.MintingDenom{Denom: denom})
+		k.SetPaused(ctx, types.Paused{Denom: denom})
+	}
+	k.SetOwner(ctx, types.Owner{Address: attacker, Denom: testDenom})
+	k.SetOwner(ctx, types.Owner{Address: otherOwner, Denom: otherDenom})
+	k.SetAdminQuorum(ctx, types.AdminQuorum{Denom: testDenom, Approvers: []string{attacker}, Threshold: 1, VotingPeriod: time.Hour})
+
+	// a message of another denom can not be approved by the quorum of this denom, even when the
+	// submission skipped its basic validation, e.g. in an authz exec
+	takeover := types.NewMsgUpdateOwner(otherOwner, attacker, otherDenom)
+	submitMsg, err := types.NewMsgSubmitAdminProposal(attacker, testDenom, takeover)
+	require.NoError(t, err)
+	_, err = server.SubmitAdminProposal(wctx, submitMsg)
+	require.ErrorIs(t, err, types.ErrAdminProposal)
+
+	_, found := k.GetPendingOwner(ctx, otherDenom)
+	require.False(t, found)
+
+	// nor can a stored proposal of another denom be executed
+	k.SetAdminQuorum(ctx, types.AdminQuorum{Denom: testDenom, Approvers: []string{attacker, approver}, Threshold: 2, VotingPeriod: time.Hour})
+	k.AppendAdminProposal(ctx, types.AdminProposal{
+		Denom:      testDenom,
+		Msg:        submitMsg.Msg,
+		Proposer:   attacker,
+		Approvals:  []string{attacker},
+		SubmitTime: ctx.BlockTime(),
+		Expiry:     ctx.BlockTime().Add(time.Hour),
+	})
+
+	res, err := server.Approve(wctx, types.NewMsgApprove(approver, testDenom, 0))
+	require.NoError(t, err)
+	require.False(t, res.Executed)
+
+	_, found = k.GetPendingOwner(ctx, otherDenom)
+	require.False(t, found)
+}
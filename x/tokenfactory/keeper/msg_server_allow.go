@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) Allow(goCtx context.Context, msg *types.MsgAllow) (*types.MsgAllowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAllowlister(ctx, msg.Denom, msg.From); err != nil {
+		return nil, err
+	}
+
+	_, addressBz, err := bech32.DecodeAndConvert(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetAllowlisted(ctx, msg.Denom, addressBz); found {
+		return nil, types.ErrUserAllowlisted
+	}
+
+	k.SetAllowlisted(ctx, types.Allowlisted{
+		AddressBz:     addressBz,
+		Denom:         msg.Denom,
+		Allowlister:   msg.From,
+		AllowlistedAt: ctx.BlockTime(),
+	})
+	k.recordAudit(ctx, msg.Denom, types.AuditActionAllow, msg.From, msg.Address, "", "")
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAllowlisted{
+		Denom:       msg.Denom,
+		Address:     msg.Address,
+		Allowlister: msg.From,
+	})
+
+	return &types.MsgAllowResponse{}, err
+}
+
+// validateAllowlister returns an error unless address is the allowlister of the denom.
+func (k Keeper) validateAllowlister(ctx sdk.Context, denom string, address string) error {
+	allowlister, found := k.GetAllowlister(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUserNotFound, "allowlister is not set")
+	}
+
+	if allowlister.Address != address {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the allowlister")
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestUpdateAllowlister(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner, pauser, allowlister := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	k.SetOwner(ctx, types.Owner{Address: owner, Denom: testDenom})
+	k.SetPauser(ctx, types.Pauser{Address: pauser, Denom: testDenom})
+
+	_, err := server.UpdateAllowlister(wctx, types.NewMsgUpdateAllowlister(allowlister, allowlister, testDenom))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.UpdateAllowlister(wctx, types.NewMsgUpdateAllowlister(owner, pauser, testDenom))
+	require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
+
+	_, err = server.UpdateAllowlister(wctx, types.NewMsgUpdateAllowlister(owner, allowlister, testDenom))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventRoleUpdated{
+		Denom:     testDenom,
+		Role:      types.RoleAllowlister,
+		Address:   allowlister,
+		UpdatedBy: owner,
+	}, lastEvent(t, ctx))
+
+	rst, found := k.GetAllowlister(ctx, testDenom)
+	require.True(t, found)
+	require.Equal(t, types.Allowlister{Address: allowlister, Denom: testDenom}, rst)
+
+	// the allowlister can not be assigned another role
+	_, err = server.UpdatePauser(wctx, types.NewMsgUpdatePauser(owner, allowlister, testDenom))
+	require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
+
+	// updating the allowlister is an admin action once the denom has an admin quorum
+	k.SetAdminQuorum(ctx, types.AdminQuorum{Denom: testDenom, Approvers: []string{sample.AccAddress()}, Threshold: 1, VotingPeriod: time.Hour})
+	_, err = server.UpdateAllowlister(wctx, types.NewMsgUpdateAllowlister(owner, sample.AccAddress(), testDenom))
+	require.ErrorIs(t, err, types.ErrAdminProposalRequired)
+	require.True(t, types.IsAdminProposalMsg(&types.MsgUpdateAllowlister{}))
+}
+
+func TestAllowlist(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, keepertest.NewMockSupplyBankKeeper())
+	server := keeper.NewMsgServerImpl(k)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner, allowlister, minter := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	user := sample.TestAccount()
+
+	coin := sdk.NewCoin(testDenom, sdk.NewInt(10))
+	mint := func(address string) error {
+		_, err := server.Mint(wctx, types.NewMsgMint(minter, address, coin))
+		return err
+	}
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+	k.SetOwner(ctx, types.Owner{Address: owner, Denom: testDenom})
+	k.SetMinters(ctx, types.Minters{Address: minter, Allowance: sdk.NewCoin(testDenom, sdk.NewInt(1000)), Denom: testDenom})
+
+	// any address can receive mints while the allowlist mode is disabled
+	require.NoError(t, mint(user.Address))
+	require.NoError(t, k.CheckAllowlisted(ctx, testDenom, user.Address))
+
+	_, err := server.SetAllowlistMode(wctx, types.NewMsgSetAllowlistMode(allowlister, testDenom, true))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.SetAllowlistMode(wctx, types.NewMsgSetAllowlistMode(owner, "uother", true))
+	require.ErrorIs(t, err, types.ErrDenomNotFound)
+
+	_, err = server.SetAllowlistMode(wctx, types.NewMsgSetAllowlistMode(owner, testDenom, true))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventAllowlistModeSet{Denom: testDenom, Enabled: true, UpdatedBy: owner}, lastEvent(t, ctx))
+	require.True(t, k.GetAllowlistMode(ctx, testDenom).Enabled)
+
+	err = mint(user.Address)
+	require.ErrorIs(t, err, types.ErrNotAllowlisted)
+
+	_, err = server.Allow(wctx, types.NewMsgAllow(allowlister, user.Address, testDenom))
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+
+	_, err = server.UpdateAllowlister(wctx, types.NewMsgUpdateAllowlister(owner, allowlister, testDenom))
+	require.NoError(t, err)
+
+	_, err = server.Allow(wctx, types.NewMsgAllow(owner, user.Address, testDenom))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.Allow(wctx, types.NewMsgAllow(allowlister, user.Address, testDenom))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventAllowlisted{Denom: testDenom, Address: user.Address, Allowlister: allowlister}, lastEvent(t, ctx))
+
+	_, err = server.Allow(wctx, types.NewMsgAllow(allowlister, user.Address, testDenom))
+	require.ErrorIs(t, err, types.ErrUserAllowlisted)
+
+	require.NoError(t, mint(user.Address))
+
+	// the allowlist of a denom does not apply to other denoms
+	require.NoError(t, k.CheckAllowlisted(ctx, "uother", sample.AccAddress()))
+
+	res, err := k.Allowlisted(wctx, &types.QueryGetAllowlistedRequest{Denom: testDenom, Address: user.Address})
+	require.NoError(t, err)
+	require.Equal(t, types.Allowlisted{AddressBz: user.AddressBz, Denom: testDenom, Allowlister: allowlister, AllowlistedAt: now}, res.Allowlisted)
+
+	all, err := k.AllowlistedAll(wctx, &types.QueryAllAllowlistedRequest{Denom: testDenom, Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, []types.Allowlisted{res.Allowlisted}, all.Allowlisted)
+	require.Equal(t, uint64(1), all.Pagination.Total)
+
+	mode, err := k.AllowlistMode(wctx, &types.QueryGetAllowlistModeRequest{Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, types.AllowlistMode{Denom: testDenom, Enabled: true}, mode.AllowlistMode)
+
+	_, err = k.AllowlistMode(wctx, &types.QueryGetAllowlistModeRequest{Denom: "uother"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	_, err = server.Disallow(wctx, types.NewMsgDisallow(allowlister, user.Address, testDenom))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventDisallowed{Denom: testDenom, Address: user.Address, Allowlister: allowlister}, lastEvent(t, ctx))
+
+	_, err = server.Disallow(wctx, types.NewMsgDisallow(allowlister, user.Address, testDenom))
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+
+	err = mint(user.Address)
+	require.ErrorIs(t, err, types.ErrNotAllowlisted)
+
+	_, err = k.Allowlisted(wctx, &types.QueryGetAllowlistedRequest{Denom: testDenom, Address: user.Address})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	_, err = server.SetAllowlistMode(wctx, types.NewMsgSetAllowlistMode(owner, testDenom, false))
+	require.NoError(t, err)
+	require.NoError(t, mint(user.Address))
+
+	// every change to the allowlist is recorded in the audit log
+	var actions []types.AuditAction
+	for _, entry := range k.GetAllAuditLogEntries(ctx) {
+		actions = append(actions, entry.Action)
+	}
+	require.Equal(t, []types.AuditAction{
+		types.AuditActionSetAllowlistMode,
+		types.AuditActionUpdateAllowlister,
+		types.AuditActionAllow,
+		types.AuditActionDisallow,
+		types.AuditActionSetAllowlistMode,
+	}, actions)
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) Approve(goCtx context.Context, msg *types.MsgApprove) (*types.MsgApproveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	quorum, proposal, err := k.getVotableAdminProposal(ctx, msg.From, msg.Denom, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal.Approvals = append(proposal.Approvals, msg.From)
+	k.SetAdminProposal(ctx, proposal)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAdminProposalVoted{
+		Denom:      msg.Denom,
+		Id:         msg.Id,
+		Voter:      msg.From,
+		Approved:   true,
+		Approvals:  countVotes(quorum, proposal.Approvals),
+		Rejections: countVotes(quorum, proposal.Rejections),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	executed := false
+	if countVotes(quorum, proposal.Approvals) >= quorum.Threshold {
+		executed, err = k.executeAdminProposal(ctx, proposal)
+	}
+
+	return &types.MsgApproveResponse{Executed: executed}, err
+}
+
+// getVotableAdminProposal returns the quorum and a pending proposal of a denom, if the voter is an
+// approver that has not voted on the proposal yet.
+func (k msgServer) getVotableAdminProposal(ctx sdk.Context, voter string, denom string, id uint64) (types.AdminQuorum, types.AdminProposal, error) {
+	quorum, found := k.GetAdminQuorum(ctx, denom)
+	if !found {
+		return quorum, types.AdminProposal{}, sdkerrors.Wrapf(types.ErrAdminProposal, "%s has no admin quorum", denom)
+	}
+
+	if !quorum.IsApprover(voter) {
+		return quorum, types.AdminProposal{}, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not an approver")
+	}
+
+	proposal, found := k.GetAdminProposal(ctx, denom, id)
+	if !found {
+		return quorum, proposal, sdkerrors.Wrapf(types.ErrAdminProposal, "admin proposal %d not found", id)
+	}
+
+	if !ctx.BlockTime().Before(proposal.Expiry) {
+		return quorum, proposal, sdkerrors.Wrapf(types.ErrAdminProposal, "admin proposal %d has expired", id)
+	}
+
+	if proposal.HasVoted(voter) {
+		return quorum, proposal, sdkerrors.Wrapf(types.ErrAdminProposal, "already voted on admin proposal %d", id)
+	}
+
+	return quorum, proposal, nil
+}
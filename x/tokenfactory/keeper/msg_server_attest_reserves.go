@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AttestReserves(goCtx context.Context, msg *types.MsgAttestReserves) (*types.MsgAttestReservesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom := msg.Reserves.Denom
+
+	if !k.MintingDenomSet(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrReserveAttestation, "%s is not a minting denom", denom)
+	}
+
+	attestor, found := k.GetAttestor(ctx, denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "attestor is not set")
+	}
+
+	if attestor.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the attestor")
+	}
+
+	if msg.Timestamp.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrReserveAttestation, "attestation timestamp %s is in the future", msg.Timestamp.Format(time.RFC3339))
+	}
+
+	if latest, found := k.GetLatestReserveAttestation(ctx, denom); found && !msg.Timestamp.After(latest.Timestamp) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrReserveAttestation,
+			"attestation timestamp %s is not after the latest attestation at %s",
+			msg.Timestamp.Format(time.RFC3339), latest.Timestamp.Format(time.RFC3339),
+		)
+	}
+
+	id := k.AppendReserveAttestation(ctx, types.ReserveAttestation{
+		Attestor:   msg.From,
+		Reserves:   msg.Reserves,
+		Timestamp:  msg.Timestamp,
+		Height:     ctx.BlockHeight(),
+		SubmitTime: ctx.BlockTime(),
+	})
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventReservesAttested{
+		Id:          id,
+		Attestor:    msg.From,
+		Reserves:    msg.Reserves,
+		Timestamp:   msg.Timestamp,
+		TotalSupply: k.bankKeeper.GetSupply(ctx, denom),
+	})
+
+	return &types.MsgAttestReservesResponse{Id: id}, err
+}
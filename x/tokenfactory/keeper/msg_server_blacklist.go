@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
@@ -13,32 +14,72 @@ import (
 func (k msgServer) Blacklist(goCtx context.Context, msg *types.MsgBlacklist) (*types.MsgBlacklistResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	blacklister, found := k.GetBlacklister(ctx)
+	if err := k.validateBlacklister(ctx, msg.Denom, msg.From); err != nil {
+		return nil, err
+	}
+
+	if err := k.blacklist(ctx, msg.Denom, msg.Address, msg.From, msg.Reason, msg.CaseID, msg.Expiry); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBlacklistResponse{}, nil
+}
+
+// validateBlacklister returns an error unless address is the blacklister of the denom.
+func (k Keeper) validateBlacklister(ctx sdk.Context, denom string, address string) error {
+	blacklister, found := k.GetBlacklister(ctx, denom)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "blacklister is not set")
+		return sdkerrors.Wrapf(types.ErrUserNotFound, "blacklister is not set")
 	}
 
-	if blacklister.Address != msg.From {
-		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the blacklister")
+	if blacklister.Address != address {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the blacklister")
 	}
 
-	_, addressBz, err := bech32.DecodeAndConvert(msg.Address)
+	return nil
+}
+
+// blacklist records an address as blacklisted for a denom and emits an EventBlacklisted, or returns
+// ErrUserBlacklisted if it already is.
+func (k Keeper) blacklist(ctx sdk.Context, denom, address, blacklister, reason, caseID string, expiry *time.Time) error {
+	_, addressBz, err := bech32.DecodeAndConvert(address)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found := k.GetBlacklisted(ctx, denom, addressBz)
 	if found {
-		return nil, types.ErrUserBlacklisted
+		return types.ErrUserBlacklisted
 	}
 
+	if expiry != nil && !expiry.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrExpiry, "blacklist expiry %s has already passed", expiry.Format(time.RFC3339))
+	}
+
+	blockTime := ctx.BlockTime()
 	blacklisted := types.Blacklisted{
-		AddressBz: addressBz,
+		AddressBz:     addressBz,
+		Denom:         denom,
+		Reason:        reason,
+		CaseID:        caseID,
+		Blacklister:   blacklister,
+		BlacklistedAt: &blockTime,
+		Expiry:        expiry,
 	}
 
 	k.SetBlacklisted(ctx, blacklisted)
+	k.recordAudit(ctx, denom, types.AuditActionBlacklist, blacklister, address, "", reason)
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	if err := k.afterBlacklist(ctx, denom, address); err != nil {
+		return err
+	}
 
-	return &types.MsgBlacklistResponse{}, err
+	return ctx.EventManager().EmitTypedEvent(&types.EventBlacklisted{
+		Denom:       denom,
+		Address:     address,
+		Reason:      reason,
+		CaseID:      caseID,
+		Expiry:      expiry,
+		Blacklister: blacklister,
+	})
 }
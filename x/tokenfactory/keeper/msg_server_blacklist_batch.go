@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) BlacklistBatch(goCtx context.Context, msg *types.MsgBlacklistBatch) (*types.MsgBlacklistBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateBlacklister(ctx, msg.Denom, msg.From); err != nil {
+		return nil, err
+	}
+
+	results := make([]types.BatchResult, 0, len(msg.Addresses))
+	for _, address := range msg.Addresses {
+		err := k.blacklist(ctx, msg.Denom, address, msg.From, msg.Reason, msg.CaseID, msg.Expiry)
+		if msg.Idempotent && sdkerrors.IsOf(err, types.ErrUserBlacklisted) {
+			results = append(results, types.BatchResult{Address: address, Status: types.BatchResultSkipped})
+			continue
+		}
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to blacklist %s", address)
+		}
+
+		results = append(results, types.BatchResult{Address: address, Status: types.BatchResultApplied})
+	}
+
+	return &types.MsgBlacklistBatchResponse{Results: results}, nil
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestBlacklistBatch(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	blacklister := sample.AccAddress()
+	k.SetBlacklister(ctx, types.Blacklister{Address: blacklister, Denom: testDenom})
+
+	accounts := []sample.Account{sample.TestAccount(), sample.TestAccount(), sample.TestAccount()}
+	addresses := []string{accounts[0].Address, accounts[1].Address, accounts[2].Address}
+	isBlacklisted := func(account sample.Account) bool {
+		_, found := k.GetBlacklisted(ctx, testDenom, account.AddressBz)
+		return found
+	}
+
+	_, err := server.BlacklistBatch(wctx, types.NewMsgBlacklistBatch(sample.AccAddress(), addresses, testDenom, "", "", nil, false))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.Blacklist(wctx, types.NewMsgBlacklist(blacklister, addresses[1], testDenom, "", "", nil))
+	require.NoError(t, err)
+
+	// without the idempotent mode the whole batch fails on an address that is already blacklisted,
+	// run on a cached context as the failed transaction would be reverted
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = server.BlacklistBatch(sdk.WrapSDKContext(cacheCtx), types.NewMsgBlacklistBatch(blacklister, addresses, testDenom, "sanctions", "ofac-1", nil, false))
+	require.ErrorIs(t, err, types.ErrUserBlacklisted)
+
+	resp, err := server.BlacklistBatch(wctx, types.NewMsgBlacklistBatch(blacklister, addresses, testDenom, "sanctions", "ofac-1", nil, true))
+	require.NoError(t, err)
+	require.Equal(t, []types.BatchResult{
+		{Address: addresses[0], Status: types.BatchResultApplied},
+		{Address: addresses[1], Status: types.BatchResultSkipped},
+		{Address: addresses[2], Status: types.BatchResultApplied},
+	}, resp.Results)
+
+	for _, account := range accounts {
+		require.True(t, isBlacklisted(account))
+	}
+	blacklisted, _ := k.GetBlacklisted(ctx, testDenom, accounts[0].AddressBz)
+	require.Equal(t, "sanctions", blacklisted.Reason)
+	require.Equal(t, "ofac-1", blacklisted.CaseID)
+
+	_, err = server.UnblacklistBatch(wctx, types.NewMsgUnblacklistBatch(sample.AccAddress(), addresses, testDenom, false))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	unblacklistResp, err := server.UnblacklistBatch(wctx, types.NewMsgUnblacklistBatch(blacklister, addresses[:2], testDenom, false))
+	require.NoError(t, err)
+	require.Equal(t, []types.BatchResult{
+		{Address: addresses[0], Status: types.BatchResultApplied},
+		{Address: addresses[1], Status: types.BatchResultApplied},
+	}, unblacklistResp.Results)
+
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = server.UnblacklistBatch(sdk.WrapSDKContext(cacheCtx), types.NewMsgUnblacklistBatch(blacklister, addresses, testDenom, false))
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+
+	unblacklistResp, err = server.UnblacklistBatch(wctx, types.NewMsgUnblacklistBatch(blacklister, addresses, testDenom, true))
+	require.NoError(t, err)
+	require.Equal(t, []types.BatchResult{
+		{Address: addresses[0], Status: types.BatchResultSkipped},
+		{Address: addresses[1], Status: types.BatchResultSkipped},
+		{Address: addresses[2], Status: types.BatchResultApplied},
+	}, unblacklistResp.Results)
+
+	for _, account := range accounts {
+		require.False(t, isBlacklisted(account))
+	}
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestBlacklistMetadata(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	blacklister := sample.AccAddress()
+	k.SetBlacklister(ctx, types.Blacklister{Address: blacklister, Denom: testDenom})
+
+	account := sample.TestAccount()
+	past := now.Add(-time.Second)
+	_, err := server.Blacklist(wctx, types.NewMsgBlacklist(blacklister, account.Address, testDenom, "fraud", "case-1", &past))
+	require.ErrorIs(t, err, types.ErrExpiry)
+
+	expiry := now.Add(time.Hour)
+	_, err = server.Blacklist(wctx, types.NewMsgBlacklist(blacklister, account.Address, testDenom, "fraud", "case-1", &expiry))
+	require.NoError(t, err)
+
+	blacklisted, found := k.GetBlacklisted(ctx, testDenom, account.AddressBz)
+	require.True(t, found)
+	require.Equal(t, types.Blacklisted{
+		AddressBz:     account.AddressBz,
+		Denom:         testDenom,
+		Reason:        "fraud",
+		CaseID:        "case-1",
+		Blacklister:   blacklister,
+		BlacklistedAt: &now,
+		Expiry:        &expiry,
+	}, blacklisted)
+}
+
+func TestUnblacklistExpired(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	soon, later := now.Add(time.Hour), now.Add(2*time.Hour)
+
+	expiring := sample.TestAccount()
+	expiringLater := sample.TestAccount()
+	permanent := sample.TestAccount()
+	unblacklisted := sample.TestAccount()
+
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: expiring.AddressBz, Denom: testDenom, Reason: "sanctions", Expiry: &soon})
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: expiringLater.AddressBz, Denom: testDenom, Expiry: &later})
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: permanent.AddressBz, Denom: testDenom})
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: unblacklisted.AddressBz, Denom: testDenom, Expiry: &soon})
+	k.RemoveBlacklisted(ctx, testDenom, unblacklisted.AddressBz)
+
+	isBlacklisted := func(account sample.Account) bool {
+		_, found := k.GetBlacklisted(ctx, testDenom, account.AddressBz)
+		return found
+	}
+
+	ctx = ctx.WithBlockTime(soon.Add(-time.Second))
+	require.NoError(t, k.UnblacklistExpired(ctx))
+	require.True(t, isBlacklisted(expiring))
+
+	ctx = ctx.WithBlockTime(soon).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.UnblacklistExpired(ctx))
+	require.False(t, isBlacklisted(expiring))
+	require.True(t, isBlacklisted(expiringLater))
+	require.True(t, isBlacklisted(permanent))
+
+	events := ctx.EventManager().ABCIEvents()
+	require.Len(t, events, 1)
+	event, err := sdk.ParseTypedEvent(events[0])
+	require.NoError(t, err)
+	require.Equal(t, &types.EventBlacklistExpired{
+		Address: expiring.Address,
+		Denom:   testDenom,
+		Reason:  "sanctions",
+	}, event)
+
+	// extending the expiry moves the address out of the expired range
+	extended := later.Add(time.Hour)
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: expiringLater.AddressBz, Denom: testDenom, Expiry: &extended})
+
+	ctx = ctx.WithBlockTime(later)
+	require.NoError(t, k.UnblacklistExpired(ctx))
+	require.True(t, isBlacklisted(expiringLater))
+
+	ctx = ctx.WithBlockTime(extended)
+	require.NoError(t, k.UnblacklistExpired(ctx))
+	require.False(t, isBlacklisted(expiringLater))
+	require.True(t, isBlacklisted(permanent))
+}
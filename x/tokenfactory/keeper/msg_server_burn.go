@@ -16,31 +16,37 @@ func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBu
 }
 
 func (k Keeper) Burn(ctx sdk.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
-	_, found := k.GetMinters(ctx, msg.From)
+	denom := msg.Amount.Denom
+
+	if !k.MintingDenomSet(ctx, denom) {
+		return nil, sdkerrors.Wrap(types.ErrBurn, "burning denom is incorrect")
+	}
+
+	_, found := k.GetMinters(ctx, denom, msg.From)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrBurn, "%v: you are not a minter", types.ErrUnauthorized)
 	}
 
+	if err := k.checkReference(ctx, denom, msg.From, types.ReferenceBurn, msg.ReferenceID); err != nil {
+		return nil, err
+	}
+
 	_, addressBz, err := bech32.DecodeAndConvert(msg.From)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found = k.GetBlacklisted(ctx, denom, addressBz)
 	if found {
 		return nil, sdkerrors.Wrap(types.ErrBurn, "minter address is blacklisted")
 	}
 
-	mintingDenom := k.GetMintingDenom(ctx)
-
-	if msg.Amount.Denom != mintingDenom.Denom {
-		return nil, sdkerrors.Wrap(types.ErrBurn, "burning denom is incorrect")
+	if k.GetPaused(ctx, denom).IsPaused(types.PauseBurn) {
+		return nil, sdkerrors.Wrap(types.ErrBurn, "burning is paused")
 	}
 
-	paused := k.GetPaused(ctx)
-
-	if paused.Paused {
-		return nil, sdkerrors.Wrap(types.ErrBurn, "burning is paused")
+	if err := k.CheckFrozen(ctx, msg.From, msg.Amount); err != nil {
+		return nil, err
 	}
 
 	minterAddress, err := sdk.AccAddressFromBech32(msg.From)
@@ -59,7 +65,19 @@ func (k Keeper) Burn(ctx sdk.Context, msg *types.MsgBurn) (*types.MsgBurnRespons
 		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
 	}
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	k.recordReference(ctx, denom, msg.From, types.ReferenceBurn, msg.ReferenceID)
+	k.recordBurned(ctx, msg.From, msg.Amount)
+
+	if err := k.afterBurn(ctx, msg.From, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventBurned{
+		Burner:      msg.From,
+		Amount:      msg.Amount,
+		TotalSupply: k.bankKeeper.GetSupply(ctx, denom),
+		ReferenceID: msg.ReferenceID,
+	})
 
 	return &types.MsgBurnResponse{}, err
 }
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CancelPauseSchedule(goCtx context.Context, msg *types.MsgCancelPauseSchedule) (*types.MsgCancelPauseScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pauser, found := k.GetPauser(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pauser is not set")
+	}
+
+	if pauser.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pauser")
+	}
+
+	schedule, found := k.GetPauseSchedule(ctx, msg.Denom, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrPauseSchedule, "pause schedule %d does not exist", msg.Id)
+	}
+
+	if schedule.Active {
+		// Cancelling an active schedule unpauses its operations, which requires the admin quorum.
+		if err := k.requireAdminProposal(ctx, msg.Denom); err != nil {
+			return nil, err
+		}
+
+		if err := k.endPauseSchedule(ctx, schedule); err != nil {
+			return nil, err
+		}
+	} else {
+		k.DeletePauseSchedule(ctx, msg.Denom, msg.Id)
+	}
+	k.recordAudit(ctx, msg.Denom, types.AuditActionCancelPauseSchedule, msg.From, strconv.FormatUint(msg.Id, 10), formatPauseSchedule(schedule), "")
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventPauseScheduleCancelled{
+		Denom:  msg.Denom,
+		Id:     msg.Id,
+		Active: schedule.Active,
+		Pauser: msg.From,
+	})
+
+	return &types.MsgCancelPauseScheduleResponse{}, err
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 
@@ -12,35 +13,42 @@ import (
 func (k msgServer) ConfigureMinter(goCtx context.Context, msg *types.MsgConfigureMinter) (*types.MsgConfigureMinterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	mintingDenom := k.GetMintingDenom(ctx)
+	denom := msg.Allowance.Denom
 
-	if msg.Allowance.Denom != mintingDenom.Denom {
+	if !k.MintingDenomSet(ctx, denom) {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
 	}
 
-	minterController, found := k.GetMinterController(ctx, msg.From)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "minter controller not found")
+	if _, found := k.GetMinterController(ctx, denom, msg.From, msg.Address); !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a controller of this minter")
 	}
 
-	if msg.From != minterController.Controller {
-		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a controller of this minter")
+	if msg.Expiry != nil && !msg.Expiry.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrExpiry, "allowance expiry %s has already passed", msg.Expiry.Format(time.RFC3339))
 	}
 
-	if msg.Address != minterController.Minter {
-		return nil, sdkerrors.Wrapf(
-			types.ErrUnauthorized,
-			"minter address ≠ minter controller's minter address, (%s≠%s)",
-			msg.Address, minterController.Minter,
-		)
+	previousAllowance := sdk.NewCoin(denom, sdk.ZeroInt())
+	var previousAuditValue string
+	if minter, found := k.GetMinters(ctx, denom, msg.Address); found {
+		previousAllowance = minter.Allowance
+		previousAuditValue = minter.Allowance.String()
 	}
 
 	k.SetMinters(ctx, types.Minters{
 		Address:   msg.Address,
 		Allowance: msg.Allowance,
+		Denom:     denom,
+		Expiry:    msg.Expiry,
+	})
+	k.recordAudit(ctx, denom, types.AuditActionConfigureMinter, msg.From, msg.Address, previousAuditValue, msg.Allowance.String())
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMinterConfigured{
+		Minter:            msg.Address,
+		Controller:        msg.From,
+		PreviousAllowance: previousAllowance,
+		Allowance:         msg.Allowance,
+		Expiry:            msg.Expiry,
 	})
-
-	err := ctx.EventManager().EmitTypedEvent(msg)
 
 	return &types.MsgConfigureMinterResponse{}, err
 }
@@ -12,7 +12,7 @@ import (
 func (k msgServer) ConfigureMinterController(goCtx context.Context, msg *types.MsgConfigureMinterController) (*types.MsgConfigureMinterControllerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	masterMinter, found := k.GetMasterMinter(ctx)
+	masterMinter, found := k.GetMasterMinter(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "master minter is not set")
 	}
@@ -21,12 +21,33 @@ func (k msgServer) ConfigureMinterController(goCtx context.Context, msg *types.M
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the master minter")
 	}
 
+	if err := k.requireAdminProposal(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	var previousMinter string
+	if existing, found := k.GetControllerByMinter(ctx, msg.Denom, msg.Minter); found {
+		if existing.Controller != msg.Controller {
+			return nil, sdkerrors.Wrapf(types.ErrMinterControllerSet, "minter %s is controlled by %s", msg.Minter, existing.Controller)
+		}
+		previousMinter = msg.Minter
+	}
+
 	controller := types.MinterController{
 		Minter:     msg.Minter,
 		Controller: msg.Controller,
+		Denom:      msg.Denom,
 	}
 
 	k.SetMinterController(ctx, controller)
+	k.recordAudit(ctx, msg.Denom, types.AuditActionConfigureMinterController, msg.From, msg.Controller, previousMinter, msg.Minter)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMinterControllerConfigured{
+		Denom:        msg.Denom,
+		Controller:   msg.Controller,
+		Minter:       msg.Minter,
+		MasterMinter: msg.From,
+	})
 
-	return &types.MsgConfigureMinterControllerResponse{}, nil
+	return &types.MsgConfigureMinterControllerResponse{}, err
 }
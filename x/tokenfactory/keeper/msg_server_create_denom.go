@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority := k.authorityKeeper.GetAuthority(ctx)
+	if authority != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the authority")
+	}
+
+	denom := msg.Metadata.Base
+
+	if k.MintingDenomSet(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrDenomExists, "%s is already a minting denom", denom)
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return nil, sdkerrors.Wrapf(types.ErrDenomExists, "denom metadata for %s is already set", denom)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: denom})
+	k.SetPaused(ctx, types.Paused{Paused: false, Denom: denom})
+	k.SetOwner(ctx, types.Owner{Address: msg.Owner, Denom: denom})
+	k.recordAudit(ctx, denom, types.AuditActionCreateDenom, msg.From, msg.Owner, "", msg.Owner)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventDenomCreated{
+		Denom:     denom,
+		Owner:     msg.Owner,
+		Authority: msg.From,
+	})
+
+	return &types.MsgCreateDenomResponse{}, err
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestCreateDenom(t *testing.T) {
+	bankKeeper := keepertest.NewMockMetadataBankKeeper()
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+	metadata := banktypes.Metadata{
+		Description: "test token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: testDenom, Exponent: 0},
+			{Denom: "token", Exponent: 6},
+		},
+		Base:    testDenom,
+		Display: "token",
+	}
+
+	_, err := server.CreateDenom(wctx, types.NewMsgCreateDenom(sample.AccAddress(), metadata, owner))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.CreateDenom(wctx, types.NewMsgCreateDenom(keepertest.TokenfactoryAuthority, metadata, owner))
+	require.NoError(t, err)
+
+	require.True(t, k.MintingDenomSet(ctx, testDenom))
+	require.False(t, k.GetPaused(ctx, testDenom).Paused)
+
+	rst, found := k.GetOwner(ctx, testDenom)
+	require.True(t, found)
+	require.Equal(t, owner, rst.Address)
+
+	_, found = bankKeeper.GetDenomMetaData(ctx, testDenom)
+	require.True(t, found)
+
+	entries := k.GetAllAuditLogEntries(ctx)
+	require.Len(t, entries, 1)
+	require.Equal(t, types.AuditActionCreateDenom, entries[0].Action)
+	require.Equal(t, keepertest.TokenfactoryAuthority, entries[0].Actor)
+	require.Equal(t, owner, entries[0].NewValue)
+
+	_, err = server.CreateDenom(wctx, types.NewMsgCreateDenom(keepertest.TokenfactoryAuthority, metadata, owner))
+	require.ErrorIs(t, err, types.ErrDenomExists)
+
+	// denoms that already exist in the bank module can not be taken over
+	metadata.Base = "uexisting"
+	metadata.DenomUnits[0].Denom = "uexisting"
+	bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	_, err = server.CreateDenom(wctx, types.NewMsgCreateDenom(keepertest.TokenfactoryAuthority, metadata, owner))
+	require.ErrorIs(t, err, types.ErrDenomExists)
+	require.False(t, k.MintingDenomSet(ctx, "uexisting"))
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) DecreaseMinterAllowance(goCtx context.Context, msg *types.MsgDecreaseMinterAllowance) (*types.MsgDecreaseMinterAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom := msg.Decrement.Denom
+
+	if !k.MintingDenomSet(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
+	}
+
+	if _, found := k.GetMinterController(ctx, denom, msg.From, msg.Address); !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a controller of this minter")
+	}
+
+	minter, found := k.GetMinters(ctx, denom, msg.Address)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "a minter with a given address doesn't exist")
+	}
+
+	if minter.Allowance.IsLT(msg.Decrement) {
+		return nil, sdkerrors.Wrapf(types.ErrAllowance, "decrement (%s) is greater than the allowance (%s)", msg.Decrement, minter.Allowance)
+	}
+
+	previousAllowance := minter.Allowance
+	minter.Allowance = minter.Allowance.Sub(msg.Decrement)
+
+	k.SetMinters(ctx, minter)
+	k.recordAudit(ctx, denom, types.AuditActionDecreaseMinterAllowance, msg.From, msg.Address, previousAllowance.String(), minter.Allowance.String())
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMinterAllowanceDecreased{
+		Minter:            msg.Address,
+		Controller:        msg.From,
+		PreviousAllowance: previousAllowance,
+		Allowance:         minter.Allowance,
+	})
+
+	return &types.MsgDecreaseMinterAllowanceResponse{}, err
+}
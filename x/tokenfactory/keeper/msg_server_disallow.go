@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) Disallow(goCtx context.Context, msg *types.MsgDisallow) (*types.MsgDisallowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAllowlister(ctx, msg.Denom, msg.From); err != nil {
+		return nil, err
+	}
+
+	_, addressBz, err := bech32.DecodeAndConvert(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetAllowlisted(ctx, msg.Denom, addressBz); !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "the specified address is not allowlisted")
+	}
+
+	k.RemoveAllowlisted(ctx, msg.Denom, addressBz)
+	k.recordAudit(ctx, msg.Denom, types.AuditActionDisallow, msg.From, msg.Address, "", "")
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDisallowed{
+		Denom:       msg.Denom,
+		Address:     msg.Address,
+		Allowlister: msg.From,
+	})
+
+	return &types.MsgDisallowResponse{}, err
+}
@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// lastEvent returns the typed event that was emitted last.
+func lastEvent(t *testing.T, ctx sdk.Context) proto.Message {
+	t.Helper()
+
+	events := ctx.EventManager().ABCIEvents()
+	require.NotEmpty(t, events)
+
+	event, err := sdk.ParseTypedEvent(events[len(events)-1])
+	require.NoError(t, err)
+
+	return event
+}
+
+func TestTypedEvents(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, keepertest.NewMockSupplyBankKeeper())
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner, newOwner, masterMinter, controller := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	minter, pauser, blacklister, user := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(testDenom, sdk.NewInt(amount))
+	}
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetOwner(ctx, types.Owner{Address: owner, Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+
+	_, err := server.UpdateMasterMinter(wctx, &types.MsgUpdateMasterMinter{From: owner, Address: masterMinter, Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventRoleUpdated{
+		Denom:     testDenom,
+		Role:      types.RoleMasterMinter,
+		Address:   masterMinter,
+		UpdatedBy: owner,
+	}, lastEvent(t, ctx))
+
+	newPauser := sample.AccAddress()
+	_, err = server.UpdatePauser(wctx, &types.MsgUpdatePauser{From: owner, Address: newPauser, Denom: testDenom})
+	require.NoError(t, err)
+	_, err = server.UpdatePauser(wctx, &types.MsgUpdatePauser{From: owner, Address: pauser, Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventRoleUpdated{
+		Denom:           testDenom,
+		Role:            types.RolePauser,
+		PreviousAddress: newPauser,
+		Address:         pauser,
+		UpdatedBy:       owner,
+	}, lastEvent(t, ctx))
+
+	_, err = server.UpdateBlacklister(wctx, &types.MsgUpdateBlacklister{From: owner, Address: blacklister, Denom: testDenom})
+	require.NoError(t, err)
+
+	_, err = server.ConfigureMinterController(wctx, &types.MsgConfigureMinterController{From: masterMinter, Controller: controller, Minter: minter, Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterControllerConfigured{
+		Denom:        testDenom,
+		Controller:   controller,
+		Minter:       minter,
+		MasterMinter: masterMinter,
+	}, lastEvent(t, ctx))
+
+	_, err = server.ConfigureMinter(wctx, &types.MsgConfigureMinter{From: controller, Address: minter, Allowance: coin(100)})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterConfigured{
+		Minter:            minter,
+		Controller:        controller,
+		PreviousAllowance: coin(0),
+		Allowance:         coin(100),
+	}, lastEvent(t, ctx))
+
+	_, err = server.Mint(wctx, &types.MsgMint{From: minter, Address: minter, Amount: coin(40)})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinted{
+		Minter:             minter,
+		Recipient:          minter,
+		Amount:             coin(40),
+		RemainingAllowance: coin(60),
+		TotalSupply:        coin(40),
+	}, lastEvent(t, ctx))
+
+	_, err = server.Burn(wctx, &types.MsgBurn{From: minter, Amount: coin(15)})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventBurned{
+		Burner:      minter,
+		Amount:      coin(15),
+		TotalSupply: coin(25),
+	}, lastEvent(t, ctx))
+
+	_, err = server.SetMaxSupply(wctx, types.NewMsgSetMaxSupply(owner, coin(1000)))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMaxSupplySet{
+		Denom:             testDenom,
+		Owner:             owner,
+		PreviousMaxSupply: coin(0),
+		MaxSupply:         coin(1000),
+		TotalSupply:       coin(25),
+	}, lastEvent(t, ctx))
+
+	_, err = server.Blacklist(wctx, types.NewMsgBlacklist(blacklister, user, testDenom, "fraud", "case-1", nil))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventBlacklisted{
+		Denom:       testDenom,
+		Address:     user,
+		Reason:      "fraud",
+		CaseID:      "case-1",
+		Blacklister: blacklister,
+	}, lastEvent(t, ctx))
+
+	_, err = server.Unblacklist(wctx, &types.MsgUnblacklist{From: blacklister, Address: user, Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventUnblacklisted{
+		Denom:       testDenom,
+		Address:     user,
+		Reason:      "fraud",
+		Blacklister: blacklister,
+	}, lastEvent(t, ctx))
+
+	operations := []types.PauseOperation{types.PauseMint}
+	_, err = server.Pause(wctx, types.NewMsgPause(pauser, testDenom, operations))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventPaused{
+		Denom:      testDenom,
+		Pauser:     pauser,
+		Operations: operations,
+		Paused:     types.Paused{Denom: testDenom, Paused: true, Mint: true},
+	}, lastEvent(t, ctx))
+
+	_, err = server.Unpause(wctx, types.NewMsgUnpause(pauser, testDenom, nil))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventUnpaused{
+		Denom:      testDenom,
+		Pauser:     pauser,
+		Operations: []types.PauseOperation{},
+		Paused:     types.Paused{Denom: testDenom},
+	}, lastEvent(t, ctx))
+
+	_, err = server.RemoveMinter(wctx, &types.MsgRemoveMinter{From: controller, Address: minter, Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterRemoved{
+		Minter:            minter,
+		Controller:        controller,
+		PreviousAllowance: coin(60),
+	}, lastEvent(t, ctx))
+
+	_, err = server.RemoveMinterController(wctx, &types.MsgRemoveMinterController{From: masterMinter, Controller: controller, Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterControllerRemoved{
+		Denom:        testDenom,
+		Controller:   controller,
+		Minter:       minter,
+		MasterMinter: masterMinter,
+	}, lastEvent(t, ctx))
+
+	_, err = server.UpdateOwner(wctx, &types.MsgUpdateOwner{From: owner, Address: newOwner, Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventRoleUpdated{
+		Denom:     testDenom,
+		Role:      types.RolePendingOwner,
+		Address:   newOwner,
+		UpdatedBy: owner,
+	}, lastEvent(t, ctx))
+
+	_, err = server.AcceptOwner(wctx, &types.MsgAcceptOwner{From: newOwner, Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventRoleUpdated{
+		Denom:           testDenom,
+		Role:            types.RoleOwner,
+		PreviousAddress: owner,
+		Address:         newOwner,
+		UpdatedBy:       newOwner,
+	}, lastEvent(t, ctx))
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) FreezeAmount(goCtx context.Context, msg *types.MsgFreezeAmount) (*types.MsgFreezeAmountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denom := msg.Amount.Denom
+
+	if !k.MintingDenomSet(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrDenomNotFound, "%s is not a minting denom", denom)
+	}
+
+	if err := k.validateBlacklister(ctx, denom, msg.From); err != nil {
+		return nil, err
+	}
+
+	_, addressBz, err := bech32.DecodeAndConvert(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	frozen := k.GetFrozenAmount(ctx, denom, addressBz)
+	previous := frozen.Amount
+
+	frozen.Amount = frozen.Amount.Add(msg.Amount)
+	k.SetFrozenAmount(ctx, frozen)
+	k.recordAudit(ctx, denom, types.AuditActionFreezeAmount, msg.From, msg.Address, previous.String(), frozen.Amount.String())
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAmountFrozen{
+		Address:     msg.Address,
+		Amount:      msg.Amount,
+		Frozen:      frozen.Amount,
+		Blacklister: msg.From,
+	})
+
+	return &types.MsgFreezeAmountResponse{}, err
+}
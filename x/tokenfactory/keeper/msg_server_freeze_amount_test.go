@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestFreezeAmount(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, keepertest.NewMockSupplyBankKeeper())
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	blacklister, minter := sample.AccAddress(), sample.AccAddress()
+	user, other := sample.TestAccount(), sample.TestAccount()
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(testDenom, sdk.NewInt(amount))
+	}
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+
+	params := types.DefaultParams()
+	params.MinRedemptionAmount = sdk.NewInt(1)
+	k.SetParams(ctx, params)
+	k.SetMinters(ctx, types.Minters{Address: minter, Allowance: coin(1000), Denom: testDenom})
+
+	_, err := server.Mint(wctx, types.NewMsgMint(minter, minter, coin(100)))
+	require.NoError(t, err)
+	_, err = server.Mint(wctx, types.NewMsgMint(minter, user.Address, coin(100)))
+	require.NoError(t, err)
+
+	_, err = server.FreezeAmount(wctx, types.NewMsgFreezeAmount(blacklister, user.Address, coin(50)))
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+
+	k.SetBlacklister(ctx, types.Blacklister{Address: blacklister, Denom: testDenom})
+
+	_, err = server.FreezeAmount(wctx, types.NewMsgFreezeAmount(minter, user.Address, coin(50)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.FreezeAmount(wctx, types.NewMsgFreezeAmount(blacklister, user.Address, sdk.NewInt64Coin("uother", 50)))
+	require.ErrorIs(t, err, types.ErrDenomNotFound)
+
+	_, err = server.FreezeAmount(wctx, types.NewMsgFreezeAmount(blacklister, user.Address, coin(50)))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventAmountFrozen{Address: user.Address, Amount: coin(50), Frozen: coin(50), Blacklister: blacklister}, lastEvent(t, ctx))
+
+	// freezing again adds to the frozen amount
+	_, err = server.FreezeAmount(wctx, types.NewMsgFreezeAmount(blacklister, user.Address, coin(20)))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventAmountFrozen{Address: user.Address, Amount: coin(20), Frozen: coin(70), Blacklister: blacklister}, lastEvent(t, ctx))
+
+	_, err = server.FreezeAmount(wctx, types.NewMsgFreezeAmount(blacklister, minter, coin(90)))
+	require.NoError(t, err)
+
+	require.Equal(t, types.FrozenAmount{AddressBz: user.AddressBz, Amount: coin(70)}, k.GetFrozenAmount(ctx, testDenom, user.AddressBz))
+	require.Equal(t, coin(160), k.GetTotalFrozen(ctx, testDenom))
+
+	// only the balance above the frozen amount can be sent
+	require.NoError(t, k.CheckFrozen(ctx, user.Address, coin(30)))
+	require.ErrorIs(t, k.CheckFrozen(ctx, user.Address, coin(31)), types.ErrFrozenAmount)
+	require.NoError(t, k.CheckFrozen(ctx, other.Address, coin(100)))
+	require.NoError(t, k.CheckFrozen(ctx, user.Address, sdk.NewInt64Coin("uother", 100)))
+
+	// the frozen amount can neither be burned nor redeemed
+	_, err = server.Burn(wctx, types.NewMsgBurn(minter, coin(11)))
+	require.ErrorIs(t, err, types.ErrFrozenAmount)
+	_, err = server.Burn(wctx, types.NewMsgBurn(minter, coin(10)))
+	require.NoError(t, err)
+
+	_, err = server.RequestRedemption(wctx, types.NewMsgRequestRedemption(user.Address, coin(31), "iban:DE00"))
+	require.ErrorIs(t, err, types.ErrFrozenAmount)
+	_, err = server.RequestRedemption(wctx, types.NewMsgRequestRedemption(user.Address, coin(30), "iban:DE00"))
+	require.NoError(t, err)
+
+	res, err := k.FrozenAmount(wctx, &types.QueryGetFrozenAmountRequest{Denom: testDenom, Address: user.Address})
+	require.NoError(t, err)
+	require.Equal(t, types.FrozenAmount{AddressBz: user.AddressBz, Amount: coin(70)}, res.FrozenAmount)
+
+	res, err = k.FrozenAmount(wctx, &types.QueryGetFrozenAmountRequest{Denom: testDenom, Address: other.Address})
+	require.NoError(t, err)
+	require.Equal(t, types.FrozenAmount{AddressBz: other.AddressBz, Amount: coin(0)}, res.FrozenAmount)
+
+	_, err = k.FrozenAmount(wctx, &types.QueryGetFrozenAmountRequest{Denom: "uother", Address: user.Address})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	all, err := k.FrozenAmountAll(wctx, &types.QueryAllFrozenAmountRequest{Denom: testDenom, Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), all.Pagination.Total)
+	require.ElementsMatch(t, k.GetAllFrozenAmounts(ctx), all.FrozenAmount)
+
+	total, err := k.TotalFrozen(wctx, &types.QueryTotalFrozenRequest{Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, coin(160), total.Amount)
+
+	_, err = server.UnfreezeAmount(wctx, types.NewMsgUnfreezeAmount(minter, user.Address, coin(20)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.UnfreezeAmount(wctx, types.NewMsgUnfreezeAmount(blacklister, user.Address, coin(71)))
+	require.ErrorIs(t, err, types.ErrFrozenAmount)
+
+	_, err = server.UnfreezeAmount(wctx, types.NewMsgUnfreezeAmount(blacklister, user.Address, coin(20)))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventAmountUnfrozen{Address: user.Address, Amount: coin(20), Frozen: coin(50), Blacklister: blacklister}, lastEvent(t, ctx))
+	require.Equal(t, coin(140), k.GetTotalFrozen(ctx, testDenom))
+	require.NoError(t, k.CheckFrozen(ctx, user.Address, coin(20)))
+
+	// an address is no longer listed once all of its frozen amount is released
+	_, err = server.UnfreezeAmount(wctx, types.NewMsgUnfreezeAmount(blacklister, user.Address, coin(50)))
+	require.NoError(t, err)
+	require.Equal(t, coin(90), k.GetTotalFrozen(ctx, testDenom))
+	require.Len(t, k.GetAllFrozenAmounts(ctx), 1)
+	require.NoError(t, k.CheckFrozen(ctx, user.Address, coin(70)))
+
+	// every change to a frozen amount is recorded in the audit log
+	entries := k.GetAllAuditLogEntries(ctx)
+	require.Len(t, entries, 5)
+	require.Equal(t, types.AuditActionFreezeAmount, entries[1].Action)
+	require.Equal(t, "50"+testDenom, entries[1].OldValue)
+	require.Equal(t, "70"+testDenom, entries[1].NewValue)
+	require.Equal(t, types.AuditActionUnfreezeAmount, entries[4].Action)
+	require.Equal(t, user.Address, entries[4].Target)
+	require.Equal(t, "0"+testDenom, entries[4].NewValue)
+}
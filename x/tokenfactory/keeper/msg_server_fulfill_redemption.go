@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) FulfillRedemption(goCtx context.Context, msg *types.MsgFulfillRedemption) (*types.MsgFulfillRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redemption, err := k.getPendingRedemption(ctx, msg.From, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := redemption.Amount.Denom
+
+	for _, address := range []string{msg.From, redemption.Requester} {
+		_, addressBz, err := bech32.DecodeAndConvert(address)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, found := k.GetBlacklisted(ctx, denom, addressBz); found {
+			return nil, sdkerrors.Wrapf(types.ErrRedemption, "%s is blacklisted", address)
+		}
+	}
+
+	if k.GetPaused(ctx, denom).IsPaused(types.PauseBurn) {
+		return nil, sdkerrors.Wrap(types.ErrRedemption, "burning is paused")
+	}
+
+	redemption.Resolver = msg.From
+	redemption.PayoutReference = msg.PayoutReference
+	if _, err := k.resolveRedemption(ctx, redemption, types.RedemptionStatusFulfilled); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRedemptionFulfilled{
+		Id:              redemption.Id,
+		Requester:       redemption.Requester,
+		Minter:          msg.From,
+		Amount:          redemption.Amount,
+		PayoutReference: msg.PayoutReference,
+		TotalSupply:     k.bankKeeper.GetSupply(ctx, denom),
+	})
+
+	return &types.MsgFulfillRedemptionResponse{}, err
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) IncreaseMinterAllowance(goCtx context.Context, msg *types.MsgIncreaseMinterAllowance) (*types.MsgIncreaseMinterAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom := msg.Increment.Denom
+
+	if !k.MintingDenomSet(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
+	}
+
+	if _, found := k.GetMinterController(ctx, denom, msg.From, msg.Address); !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a controller of this minter")
+	}
+
+	// a minter that has not been configured yet starts from a zero allowance
+	minter, found := k.GetMinters(ctx, denom, msg.Address)
+	if !found {
+		minter = types.Minters{
+			Address:   msg.Address,
+			Allowance: sdk.NewCoin(denom, sdk.ZeroInt()),
+			Denom:     denom,
+		}
+	}
+
+	previousAllowance := minter.Allowance
+	minter.Allowance = minter.Allowance.Add(msg.Increment)
+
+	k.SetMinters(ctx, minter)
+	k.recordAudit(ctx, denom, types.AuditActionIncreaseMinterAllowance, msg.From, msg.Address, previousAllowance.String(), minter.Allowance.String())
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMinterAllowanceIncreased{
+		Minter:            msg.Address,
+		Controller:        msg.From,
+		PreviousAllowance: previousAllowance,
+		Allowance:         minter.Allowance,
+	})
+
+	return &types.MsgIncreaseMinterAllowanceResponse{}, err
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
@@ -17,17 +18,27 @@ func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMi
 }
 
 func (k Keeper) Mint(ctx sdk.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
-	minter, found := k.GetMinters(ctx, msg.From)
+	denom := msg.Amount.Denom
+
+	if !k.MintingDenomSet(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
+	}
+
+	minter, found := k.GetMinters(ctx, denom, msg.From)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a minter")
 	}
 
+	if err := k.checkReference(ctx, denom, msg.From, types.ReferenceMint, msg.ReferenceID); err != nil {
+		return nil, err
+	}
+
 	_, addressBz, err := bech32.DecodeAndConvert(msg.From)
 	if err != nil {
 		return nil, err
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found = k.GetBlacklisted(ctx, denom, addressBz)
 	if found {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minter address is blacklisted")
 	}
@@ -37,31 +48,66 @@ func (k Keeper) Mint(ctx sdk.Context, msg *types.MsgMint) (*types.MsgMintRespons
 		return nil, err
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found = k.GetBlacklisted(ctx, denom, addressBz)
 	if found {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "receiver address is blacklisted")
 	}
 
-	mintingDenom := k.GetMintingDenom(ctx)
+	if err := k.CheckAllowlisted(ctx, denom, msg.Address); err != nil {
+		return nil, err
+	}
 
-	if msg.Amount.Denom != mintingDenom.Denom {
-		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
+	if minter.Expiry != nil && !ctx.BlockTime().Before(*minter.Expiry) {
+		return nil, sdkerrors.Wrapf(types.ErrMint, "minter allowance expired at %s", minter.Expiry.Format(time.RFC3339))
 	}
 
 	if minter.Allowance.IsLT(msg.Amount) {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minting amount is greater than the allowance")
 	}
 
-	paused := k.GetPaused(ctx)
-
-	if paused.Paused {
+	if k.GetPaused(ctx, denom).IsPaused(types.PauseMint) {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minting is paused")
 	}
 
+	if supplyCap, found := k.GetSupplyCap(ctx, denom); found {
+		supply := k.bankKeeper.GetSupply(ctx, denom)
+		if supplyCap.MaxSupply.IsLT(supply.Add(msg.Amount)) {
+			return nil, sdkerrors.Wrapf(
+				types.ErrSupplyCap,
+				"minting %s would exceed the max supply of %s, at most %s can be minted",
+				msg.Amount, supplyCap.MaxSupply, SupplyCapHeadroom(supplyCap, supply),
+			)
+		}
+	}
+
+	if err := k.checkReserves(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// the amount is checked against the limit of the minter and the limit across all minters
+	var windows []*types.MintRateLimitWindow
+	for _, address := range []string{msg.From, ""} {
+		window, err := k.applyMintRateLimit(ctx, address, msg.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if window != nil {
+			windows = append(windows, window)
+		}
+	}
+
+	if err := k.beforeMint(ctx, msg.From, msg.Address, msg.Amount); err != nil {
+		return nil, err
+	}
+
 	minter.Allowance = minter.Allowance.Sub(msg.Amount)
 
 	k.SetMinters(ctx, minter)
 
+	for _, window := range windows {
+		k.SetMintRateLimitWindow(ctx, *window)
+	}
+
 	amount := sdk.NewCoins(msg.Amount)
 
 	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, amount); err != nil {
@@ -74,7 +120,21 @@ func (k Keeper) Mint(ctx sdk.Context, msg *types.MsgMint) (*types.MsgMintRespons
 		return nil, sdkerrors.Wrap(types.ErrSendCoinsToAccount, err.Error())
 	}
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	k.recordReference(ctx, denom, msg.From, types.ReferenceMint, msg.ReferenceID)
+	k.recordMinted(ctx, msg.From, msg.Amount)
+
+	if err := k.afterMint(ctx, msg.From, msg.Address, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMinted{
+		Minter:             msg.From,
+		Recipient:          msg.Address,
+		Amount:             msg.Amount,
+		RemainingAllowance: minter.Allowance,
+		TotalSupply:        k.bankKeeper.GetSupply(ctx, denom),
+		ReferenceID:        msg.ReferenceID,
+	})
 
 	return &types.MsgMintResponse{}, err
 }
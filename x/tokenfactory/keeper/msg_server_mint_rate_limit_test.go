@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestMintRateLimit(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+
+	owner := sample.AccAddress()
+	masterMinter := sample.AccAddress()
+	minter1 := sample.AccAddress()
+	minter2 := sample.AccAddress()
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(testDenom, sdk.NewInt(amount))
+	}
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Paused: false, Denom: testDenom})
+	k.SetOwner(ctx, types.Owner{Address: owner, Denom: testDenom})
+	k.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter, Denom: testDenom})
+	k.SetMinters(ctx, types.Minters{Address: minter1, Allowance: coin(1000), Denom: testDenom})
+	k.SetMinters(ctx, types.Minters{Address: minter2, Allowance: coin(1000), Denom: testDenom})
+
+	mint := func(ctx sdk.Context, minter string, amount int64) error {
+		_, err := server.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(minter, sample.AccAddress(), coin(amount)))
+		return err
+	}
+
+	_, err := server.SetMintRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgSetMintRateLimit(sample.AccAddress(), minter1, time.Hour, coin(50)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// the master minter limits a single minter, the owner limits all minters
+	_, err = server.SetMintRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgSetMintRateLimit(masterMinter, minter1, time.Hour, coin(50)))
+	require.NoError(t, err)
+	_, err = server.SetMintRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgSetMintRateLimit(owner, "", time.Hour, coin(80)))
+	require.NoError(t, err)
+
+	require.NoError(t, mint(ctx, minter1, 30))
+
+	err = mint(ctx, minter1, 30)
+	require.ErrorIs(t, err, types.ErrMintRateLimit)
+	require.Contains(t, err.Error(), "minter "+minter1+" can mint at most 20"+testDenom)
+	// mints are counted in buckets of a 24th of the window, the first of which frees up an hour after its end
+	require.Contains(t, err.Error(), "2024-01-01T01:02:30Z")
+
+	require.NoError(t, mint(ctx, minter2, 40))
+
+	err = mint(ctx, minter2, 20)
+	require.ErrorIs(t, err, types.ErrMintRateLimit)
+	require.Contains(t, err.Error(), "all minters can mint at most 10"+testDenom)
+
+	// failed mints are not counted against the limits
+	resp, err := k.MintRateLimit(sdk.WrapSDKContext(ctx), &types.QueryGetMintRateLimitRequest{Denom: testDenom, Minter: minter1})
+	require.NoError(t, err)
+	require.Equal(t, coin(30), resp.Minted)
+	require.Equal(t, coin(20), resp.Remaining)
+	require.Equal(t, start.Add(time.Hour+150*time.Second), *resp.ResetTime)
+
+	resp, err = k.MintRateLimit(sdk.WrapSDKContext(ctx), &types.QueryGetMintRateLimitRequest{Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, coin(70), resp.Minted)
+	require.Equal(t, coin(10), resp.Remaining)
+
+	ctx = ctx.WithBlockTime(start.Add(30 * time.Minute))
+	require.NoError(t, mint(ctx, minter1, 10))
+
+	// the window rolls with the block time, so the earlier mints still count an hour after they were made
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	err = mint(ctx, minter1, 10)
+	require.ErrorIs(t, err, types.ErrMintRateLimit)
+	require.Contains(t, err.Error(), "all minters can mint at most 0"+testDenom)
+
+	ctx = ctx.WithBlockTime(start.Add(time.Hour + 150*time.Second))
+	resp, err = k.MintRateLimit(sdk.WrapSDKContext(ctx), &types.QueryGetMintRateLimitRequest{Denom: testDenom, Minter: minter1})
+	require.NoError(t, err)
+	require.Equal(t, coin(10), resp.Minted)
+	require.Equal(t, coin(40), resp.Remaining)
+	require.Equal(t, start.Add(90*time.Minute+150*time.Second), *resp.ResetTime)
+
+	require.NoError(t, mint(ctx, minter1, 40))
+	require.ErrorIs(t, mint(ctx, minter1, 1), types.ErrMintRateLimit)
+
+	_, err = server.RemoveMintRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgRemoveMintRateLimit(minter1, minter1, testDenom))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.RemoveMintRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgRemoveMintRateLimit(owner, minter1, testDenom))
+	require.NoError(t, err)
+
+	_, err = server.RemoveMintRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgRemoveMintRateLimit(owner, minter1, testDenom))
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+
+	require.NoError(t, mint(ctx, minter1, 30))
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestMinterAllowance(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	controller := sample.AccAddress()
+	minter := sample.AccAddress()
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: minter, Denom: testDenom})
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(testDenom, sdk.NewInt(amount))
+	}
+
+	_, err := server.IncreaseMinterAllowance(wctx, types.NewMsgIncreaseMinterAllowance(sample.AccAddress(), minter, coin(10)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.IncreaseMinterAllowance(wctx, types.NewMsgIncreaseMinterAllowance(controller, sample.AccAddress(), coin(10)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.DecreaseMinterAllowance(wctx, types.NewMsgDecreaseMinterAllowance(controller, minter, coin(10)))
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+
+	// increasing the allowance of an unconfigured minter starts from zero
+	_, err = server.IncreaseMinterAllowance(wctx, types.NewMsgIncreaseMinterAllowance(controller, minter, coin(10)))
+	require.NoError(t, err)
+
+	_, err = server.IncreaseMinterAllowance(wctx, types.NewMsgIncreaseMinterAllowance(controller, minter, coin(5)))
+	require.NoError(t, err)
+
+	rst, found := k.GetMinters(ctx, testDenom, minter)
+	require.True(t, found)
+	require.Equal(t, coin(15), rst.Allowance)
+
+	_, err = server.DecreaseMinterAllowance(wctx, types.NewMsgDecreaseMinterAllowance(controller, minter, coin(20)))
+	require.ErrorIs(t, err, types.ErrAllowance)
+
+	_, err = server.DecreaseMinterAllowance(wctx, types.NewMsgDecreaseMinterAllowance(controller, minter, coin(15)))
+	require.NoError(t, err)
+
+	rst, found = k.GetMinters(ctx, testDenom, minter)
+	require.True(t, found)
+	require.True(t, rst.Allowance.IsZero())
+
+	events := ctx.EventManager().ABCIEvents()
+	event, err := sdk.ParseTypedEvent(events[len(events)-1])
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterAllowanceDecreased{
+		Minter:            minter,
+		Controller:        controller,
+		PreviousAllowance: coin(15),
+		Allowance:         coin(0),
+	}, event)
+
+	_, err = server.IncreaseMinterAllowance(wctx, types.NewMsgIncreaseMinterAllowance(controller, minter, sdk.NewCoin("uother", sdk.NewInt(1))))
+	require.ErrorIs(t, err, types.ErrMint)
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestMinterControllerManagesMultipleMinters(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	masterMinter := sample.AccAddress()
+	controller := sample.AccAddress()
+	minter1 := sample.AccAddress()
+	minter2 := sample.AccAddress()
+	allowance := sdk.NewCoin(testDenom, sdk.NewInt(10))
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter, Denom: testDenom})
+
+	for _, minter := range []string{minter1, minter2} {
+		_, err := server.ConfigureMinterController(wctx, types.NewMsgConfigureMinterController(masterMinter, controller, minter, testDenom))
+		require.NoError(t, err)
+	}
+	require.Len(t, k.GetMintersByController(ctx, testDenom, controller), 2)
+
+	// a minter has a single controller, which can be reconfigured but not joined by another one
+	_, err := server.ConfigureMinterController(wctx, types.NewMsgConfigureMinterController(masterMinter, controller, minter1, testDenom))
+	require.NoError(t, err)
+	_, err = server.ConfigureMinterController(wctx, types.NewMsgConfigureMinterController(masterMinter, sample.AccAddress(), minter1, testDenom))
+	require.ErrorIs(t, err, types.ErrMinterControllerSet)
+
+	for _, minter := range []string{minter1, minter2} {
+		_, err := server.ConfigureMinter(wctx, types.NewMsgConfigureMinter(controller, minter, allowance, nil))
+		require.NoError(t, err)
+	}
+
+	_, err = server.ConfigureMinter(wctx, types.NewMsgConfigureMinter(controller, sample.AccAddress(), allowance, nil))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// removing a single pair keeps the controller's other minters
+	_, err = server.RemoveMinterController(wctx, types.NewMsgRemoveMinterController(masterMinter, controller, testDenom, minter1))
+	require.NoError(t, err)
+
+	_, err = server.RemoveMinter(wctx, types.NewMsgRemoveMinter(controller, minter1, testDenom))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.RemoveMinter(wctx, types.NewMsgRemoveMinter(controller, minter2, testDenom))
+	require.NoError(t, err)
+
+	_, err = server.RemoveMinterController(wctx, types.NewMsgRemoveMinterController(masterMinter, controller, testDenom, minter1))
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+
+	// removing without a minter removes the controller from all of its minters
+	_, err = server.RemoveMinterController(wctx, types.NewMsgRemoveMinterController(masterMinter, controller, testDenom, ""))
+	require.NoError(t, err)
+	_, found := k.GetControllerByMinter(ctx, testDenom, minter2)
+	require.False(t, found)
+
+	_, err = server.RemoveMinterController(wctx, types.NewMsgRemoveMinterController(masterMinter, controller, testDenom, ""))
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestConfigureMinterExpiry(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, keepertest.NewMockSupplyBankKeeper())
+	server := keeper.NewMsgServerImpl(k)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	controller := sample.AccAddress()
+	minter := sample.AccAddress()
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(testDenom, sdk.NewInt(amount))
+	}
+	mint := func(ctx sdk.Context, amount int64) error {
+		_, err := server.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(minter, sample.AccAddress(), coin(amount)))
+		return err
+	}
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Paused: false, Denom: testDenom})
+	k.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: minter, Denom: testDenom})
+
+	past := now.Add(-time.Second)
+	_, err := server.ConfigureMinter(sdk.WrapSDKContext(ctx), types.NewMsgConfigureMinter(controller, minter, coin(100), &past))
+	require.ErrorIs(t, err, types.ErrExpiry)
+
+	expiry := now.Add(time.Hour)
+	_, err = server.ConfigureMinter(sdk.WrapSDKContext(ctx), types.NewMsgConfigureMinter(controller, minter, coin(100), &expiry))
+	require.NoError(t, err)
+
+	rst, found := k.GetMinters(ctx, testDenom, minter)
+	require.True(t, found)
+	require.Equal(t, types.Minters{Address: minter, Allowance: coin(100), Denom: testDenom, Expiry: &expiry}, rst)
+
+	require.NoError(t, mint(ctx.WithBlockTime(expiry.Add(-time.Second)), 10))
+
+	// the allowance is unusable from its expiry on, even before it is swept at the end of the block
+	err = mint(ctx.WithBlockTime(expiry), 10)
+	require.ErrorIs(t, err, types.ErrMint)
+	require.Contains(t, err.Error(), "minter allowance expired")
+
+	// reconfiguring without an expiry makes the allowance permanent
+	_, err = server.ConfigureMinter(sdk.WrapSDKContext(ctx), types.NewMsgConfigureMinter(controller, minter, coin(50), nil))
+	require.NoError(t, err)
+
+	require.NoError(t, k.ExpireMinterAllowances(ctx.WithBlockTime(expiry)))
+	require.NoError(t, mint(ctx.WithBlockTime(expiry), 10))
+
+	rst, found = k.GetMinters(ctx, testDenom, minter)
+	require.True(t, found)
+	require.Equal(t, types.Minters{Address: minter, Allowance: coin(40), Denom: testDenom}, rst)
+}
+
+func TestExpireMinterAllowances(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	soon, later := now.Add(time.Hour), now.Add(2*time.Hour)
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(testDenom, sdk.NewInt(amount))
+	}
+
+	expiring := sample.AccAddress()
+	expiringLater := sample.AccAddress()
+	permanent := sample.AccAddress()
+	removed := sample.AccAddress()
+
+	k.SetMinters(ctx, types.Minters{Address: expiring, Allowance: coin(100), Denom: testDenom, Expiry: &soon})
+	k.SetMinters(ctx, types.Minters{Address: expiringLater, Allowance: coin(100), Denom: testDenom, Expiry: &later})
+	k.SetMinters(ctx, types.Minters{Address: permanent, Allowance: coin(100), Denom: testDenom})
+	k.SetMinters(ctx, types.Minters{Address: removed, Allowance: coin(100), Denom: testDenom, Expiry: &soon})
+	k.RemoveMinters(ctx, testDenom, removed)
+
+	allowance := func(address string) sdk.Coin {
+		minter, found := k.GetMinters(ctx, testDenom, address)
+		require.True(t, found)
+		return minter.Allowance
+	}
+
+	ctx = ctx.WithBlockTime(soon.Add(-time.Second))
+	require.NoError(t, k.ExpireMinterAllowances(ctx))
+	require.Equal(t, coin(100), allowance(expiring))
+
+	ctx = ctx.WithBlockTime(soon).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.ExpireMinterAllowances(ctx))
+	require.Equal(t, coin(0), allowance(expiring))
+	require.Equal(t, coin(100), allowance(expiringLater))
+	require.Equal(t, coin(100), allowance(permanent))
+
+	_, found := k.GetMinters(ctx, testDenom, removed)
+	require.False(t, found)
+
+	rst, _ := k.GetMinters(ctx, testDenom, expiring)
+	require.Nil(t, rst.Expiry)
+
+	events := ctx.EventManager().ABCIEvents()
+	require.Len(t, events, 1)
+	event, err := sdk.ParseTypedEvent(events[0])
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterAllowanceExpired{
+		Minter:           expiring,
+		Denom:            testDenom,
+		ExpiredAllowance: coin(100),
+		Expiry:           soon,
+	}, event)
+
+	// extending the expiry moves the minter out of the expired range
+	extended := later.Add(time.Hour)
+	k.SetMinters(ctx, types.Minters{Address: expiringLater, Allowance: coin(100), Denom: testDenom, Expiry: &extended})
+
+	ctx = ctx.WithBlockTime(later)
+	require.NoError(t, k.ExpireMinterAllowances(ctx))
+	require.Equal(t, coin(100), allowance(expiringLater))
+
+	ctx = ctx.WithBlockTime(extended)
+	require.NoError(t, k.ExpireMinterAllowances(ctx))
+	require.Equal(t, coin(0), allowance(expiringLater))
+	require.Equal(t, coin(100), allowance(permanent))
+}
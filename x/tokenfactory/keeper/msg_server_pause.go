@@ -12,7 +12,7 @@ import (
 func (k msgServer) Pause(goCtx context.Context, msg *types.MsgPause) (*types.MsgPauseResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	pauser, found := k.GetPauser(ctx)
+	pauser, found := k.GetPauser(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pauser is not set")
 	}
@@ -21,13 +21,24 @@ func (k msgServer) Pause(goCtx context.Context, msg *types.MsgPause) (*types.Msg
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pauser")
 	}
 
-	paused := types.Paused{
-		Paused: true,
-	}
+	paused := k.GetPaused(ctx, msg.Denom)
+	previousOperations := types.FormatPauseOperations(paused.PausedOperations())
+	paused.SetOperations(msg.Operations, true)
 
 	k.SetPaused(ctx, paused)
+	k.releasePauseOperations(ctx, msg.Denom, msg.Operations)
+	k.recordAudit(ctx, msg.Denom, types.AuditActionPause, msg.From, "", previousOperations, types.FormatPauseOperations(paused.PausedOperations()))
+
+	if err := k.afterPauseChange(ctx, msg.Denom, paused); err != nil {
+		return nil, err
+	}
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventPaused{
+		Denom:      msg.Denom,
+		Pauser:     msg.From,
+		Operations: msg.Operations,
+		Paused:     paused,
+	})
 
 	return &types.MsgPauseResponse{}, err
 }
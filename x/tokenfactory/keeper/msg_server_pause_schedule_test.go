@@ -0,0 +1,259 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestSchedulePause(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+
+	pauser := sample.AccAddress()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(now)
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Paused: false, Denom: testDenom})
+	k.SetPauser(ctx, types.Pauser{Address: pauser, Denom: testDenom})
+
+	schedule := func(ctx sdk.Context, msg *types.MsgSchedulePause) (uint64, error) {
+		resp, err := server.SchedulePause(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return 0, err
+		}
+		return resp.Id, nil
+	}
+	endBlock := func(height int64, blockTime time.Time) {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(blockTime)
+		require.NoError(t, k.ApplyPauseSchedules(ctx))
+	}
+
+	_, err := schedule(ctx, types.NewMsgSchedulePause(sample.AccAddress(), testDenom, nil, 20, nil, 30, nil))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = schedule(ctx, types.NewMsgSchedulePause(pauser, testDenom, nil, 10, nil, 30, nil))
+	require.ErrorIs(t, err, types.ErrPauseSchedule)
+
+	past := now.Add(-time.Minute)
+	_, err = schedule(ctx, types.NewMsgSchedulePause(pauser, testDenom, nil, 20, nil, 0, &past))
+	require.ErrorIs(t, err, types.ErrPauseSchedule)
+
+	// minting is paused from height 20 to 30, every operation from one hour to two hours from now
+	mintID, err := schedule(ctx, types.NewMsgSchedulePause(pauser, testDenom, []types.PauseOperation{types.PauseMint}, 20, nil, 30, nil))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), mintID)
+
+	start, end := now.Add(time.Hour), now.Add(2*time.Hour)
+	allID, err := schedule(ctx, types.NewMsgSchedulePause(pauser, testDenom, nil, 0, &start, 0, &end))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), allID)
+
+	resp, err := k.PauseScheduleAll(sdk.WrapSDKContext(ctx), &types.QueryAllPauseScheduleRequest{Denom: testDenom})
+	require.NoError(t, err)
+	require.Len(t, resp.PauseSchedule, 2)
+
+	endBlock(19, now)
+	require.False(t, k.GetPaused(ctx, testDenom).Paused)
+
+	endBlock(20, now)
+	require.Equal(t, types.Paused{Paused: true, Denom: testDenom, Mint: true}, k.GetPaused(ctx, testDenom))
+	mintSchedule, found := k.GetPauseSchedule(ctx, testDenom, mintID)
+	require.True(t, found)
+	require.True(t, mintSchedule.Active)
+
+	// both windows overlap, so minting stays paused when the first one ends
+	endBlock(25, start)
+	require.Equal(t, types.Paused{
+		Paused:      true,
+		Denom:       testDenom,
+		Mint:        true,
+		Burn:        true,
+		Transfer:    true,
+		IBCOutbound: true,
+	}, k.GetPaused(ctx, testDenom))
+
+	endBlock(30, start.Add(time.Minute))
+	require.True(t, k.GetPaused(ctx, testDenom).Mint)
+	_, found = k.GetPauseSchedule(ctx, testDenom, mintID)
+	require.False(t, found)
+
+	endBlock(40, end)
+	require.Equal(t, types.Paused{Denom: testDenom}, k.GetPaused(ctx, testDenom))
+
+	_, err = k.PauseSchedule(sdk.WrapSDKContext(ctx), &types.QueryGetPauseScheduleRequest{Denom: testDenom, Id: allID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCancelPauseSchedule(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+
+	pauser := sample.AccAddress()
+	ctx = ctx.WithBlockHeight(10)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Paused: false, Denom: testDenom})
+	k.SetPauser(ctx, types.Pauser{Address: pauser, Denom: testDenom})
+
+	k.SetPauseSchedule(ctx, types.PauseSchedule{Denom: testDenom, Id: 0, StartHeight: 20, EndHeight: 30})
+	k.SetPauseSchedule(ctx, types.PauseSchedule{
+		Denom:            testDenom,
+		Id:               1,
+		Operations:       []types.PauseOperation{types.PauseBurn},
+		StartHeight:      5,
+		EndHeight:        30,
+		Active:           true,
+		PausedOperations: []types.PauseOperation{types.PauseBurn},
+	})
+	k.SetPaused(ctx, types.Paused{Paused: true, Denom: testDenom, Burn: true})
+
+	_, err := server.CancelPauseSchedule(wctx, types.NewMsgCancelPauseSchedule(sample.AccAddress(), testDenom, 0))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.CancelPauseSchedule(wctx, types.NewMsgCancelPauseSchedule(pauser, testDenom, 2))
+	require.ErrorIs(t, err, types.ErrPauseSchedule)
+
+	// cancelling a pending schedule leaves the paused state untouched
+	_, err = server.CancelPauseSchedule(wctx, types.NewMsgCancelPauseSchedule(pauser, testDenom, 0))
+	require.NoError(t, err)
+	_, found := k.GetPauseSchedule(ctx, testDenom, 0)
+	require.False(t, found)
+	require.True(t, k.GetPaused(ctx, testDenom).Burn)
+
+	// cancelling an active schedule lifts its pause
+	_, err = server.CancelPauseSchedule(wctx, types.NewMsgCancelPauseSchedule(pauser, testDenom, 1))
+	require.NoError(t, err)
+	require.Equal(t, types.Paused{Denom: testDenom}, k.GetPaused(ctx, testDenom))
+	require.Empty(t, k.GetAllPauseSchedules(ctx))
+}
+
+func TestPauseScheduleKeepsManualPauses(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+
+	pauser := sample.AccAddress()
+	ctx = ctx.WithBlockHeight(10)
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+	k.SetPauser(ctx, types.Pauser{Address: pauser, Denom: testDenom})
+
+	endBlock := func(height int64) {
+		ctx = ctx.WithBlockHeight(height)
+		require.NoError(t, k.ApplyPauseSchedules(ctx))
+	}
+
+	// minting was paused before the window starts, burning is paused during the window
+	_, err := server.Pause(sdk.WrapSDKContext(ctx), types.NewMsgPause(pauser, testDenom, []types.PauseOperation{types.PauseMint}))
+	require.NoError(t, err)
+	_, err = server.SchedulePause(sdk.WrapSDKContext(ctx), types.NewMsgSchedulePause(pauser, testDenom, []types.PauseOperation{types.PauseMint, types.PauseBurn, types.PauseTransfer}, 20, nil, 30, nil))
+	require.NoError(t, err)
+
+	endBlock(20)
+	schedule, found := k.GetPauseSchedule(ctx, testDenom, 0)
+	require.True(t, found)
+	require.Equal(t, []types.PauseOperation{types.PauseBurn, types.PauseTransfer}, schedule.PausedOperations)
+
+	_, err = server.Pause(sdk.WrapSDKContext(ctx), types.NewMsgPause(pauser, testDenom, []types.PauseOperation{types.PauseBurn}))
+	require.NoError(t, err)
+
+	// only transfers are unpaused once the window ends
+	endBlock(30)
+	require.Equal(t, types.Paused{Paused: true, Denom: testDenom, Mint: true, Burn: true}, k.GetPaused(ctx, testDenom))
+}
+
+func TestPauseScheduleWithAdminQuorum(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+
+	pauser, approver := sample.AccAddress(), sample.AccAddress()
+	ctx = ctx.WithBlockHeight(5)
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+	k.SetPauser(ctx, types.Pauser{Address: pauser, Denom: testDenom})
+	k.SetAdminQuorum(ctx, types.AdminQuorum{Denom: testDenom, Approvers: []string{approver}, Threshold: 1, VotingPeriod: time.Hour})
+
+	submit := func(msg types.AdminMsg) {
+		submitMsg, err := types.NewMsgSubmitAdminProposal(approver, testDenom, msg)
+		require.NoError(t, err)
+		res, err := server.SubmitAdminProposal(sdk.WrapSDKContext(ctx), submitMsg)
+		require.NoError(t, err)
+		require.True(t, res.Executed)
+	}
+	endBlock := func(height int64) {
+		ctx = ctx.WithBlockHeight(height)
+		require.NoError(t, k.ApplyPauseSchedules(ctx))
+	}
+
+	// a schedule unpauses its operations when it ends, so it can only be created with the approval of the quorum
+	schedule := types.NewMsgSchedulePause(pauser, testDenom, []types.PauseOperation{types.PauseMint}, 10, nil, 30, nil)
+	_, err := server.SchedulePause(sdk.WrapSDKContext(ctx), schedule)
+	require.ErrorIs(t, err, types.ErrAdminProposalRequired)
+
+	submit(schedule)
+	submit(types.NewMsgSchedulePause(pauser, testDenom, []types.PauseOperation{types.PauseBurn}, 10, nil, 30, nil))
+
+	endBlock(10)
+	require.Equal(t, types.Paused{Paused: true, Denom: testDenom, Mint: true, Burn: true}, k.GetPaused(ctx, testDenom))
+
+	// an active schedule can only be cancelled with the approval of the quorum
+	cancel := types.NewMsgCancelPauseSchedule(pauser, testDenom, 0)
+	_, err = server.CancelPauseSchedule(sdk.WrapSDKContext(ctx), cancel)
+	require.ErrorIs(t, err, types.ErrAdminProposalRequired)
+
+	submit(cancel)
+	_, found := k.GetPauseSchedule(ctx, testDenom, 0)
+	require.False(t, found)
+	require.Equal(t, types.Paused{Paused: true, Denom: testDenom, Burn: true}, k.GetPaused(ctx, testDenom))
+
+	// the approved end of the other schedule is honoured
+	endBlock(30)
+	require.Empty(t, k.GetAllPauseSchedules(ctx))
+	require.Equal(t, types.Paused{Denom: testDenom}, k.GetPaused(ctx, testDenom))
+
+	event, ok := lastEvent(t, ctx).(*types.EventPauseScheduleEnded)
+	require.True(t, ok)
+	require.Equal(t, []types.PauseOperation{types.PauseBurn}, event.Operations)
+}
+
+func TestApplyPauseSchedulesOnlyIteratesDueSchedules(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+
+	start := ctx.BlockTime().Add(time.Hour)
+	k.SetPauseSchedule(ctx, types.PauseSchedule{Denom: testDenom, Id: 0, StartHeight: 20, EndHeight: 30})
+	k.SetPauseSchedule(ctx, types.PauseSchedule{Denom: testDenom, Id: 1, StartTime: &start, EndHeight: 40})
+
+	// deleting a schedule removes its index entry, so it is not applied
+	k.DeletePauseSchedule(ctx, testDenom, 0)
+	require.NoError(t, k.ApplyPauseSchedules(ctx.WithBlockHeight(20)))
+	require.False(t, k.GetPaused(ctx, testDenom).Paused)
+
+	// the time index is used for a start time, and the height index for the end height once active
+	ctx = ctx.WithBlockHeight(21).WithBlockTime(start)
+	require.NoError(t, k.ApplyPauseSchedules(ctx))
+	require.True(t, k.GetPaused(ctx, testDenom).Paused)
+
+	require.NoError(t, k.ApplyPauseSchedules(ctx.WithBlockHeight(39)))
+	require.True(t, k.GetPaused(ctx, testDenom).Paused)
+
+	require.NoError(t, k.ApplyPauseSchedules(ctx.WithBlockHeight(40)))
+	require.False(t, k.GetPaused(ctx, testDenom).Paused)
+	require.Empty(t, k.GetAllPauseSchedules(ctx))
+}
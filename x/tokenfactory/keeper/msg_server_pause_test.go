@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestPauseOperations(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, keepertest.NewMockSupplyBankKeeper())
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	pauser := sample.AccAddress()
+	minter := sample.AccAddress()
+	coin := sdk.NewCoin(testDenom, sdk.NewInt(10))
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Paused: false, Denom: testDenom})
+	k.SetPauser(ctx, types.Pauser{Address: pauser, Denom: testDenom})
+	k.SetMinters(ctx, types.Minters{Address: minter, Allowance: sdk.NewCoin(testDenom, sdk.NewInt(1000)), Denom: testDenom})
+
+	_, err := server.Pause(wctx, types.NewMsgPause(minter, testDenom, nil))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// pausing minting only leaves burning enabled
+	_, err = server.Pause(wctx, types.NewMsgPause(pauser, testDenom, []types.PauseOperation{types.PauseMint}))
+	require.NoError(t, err)
+	require.Equal(t, types.Paused{Paused: true, Denom: testDenom, Mint: true}, k.GetPaused(ctx, testDenom))
+
+	_, err = server.Mint(wctx, types.NewMsgMint(minter, minter, coin))
+	require.ErrorIs(t, err, types.ErrMint)
+
+	k.SetPaused(ctx, types.Paused{Paused: false, Denom: testDenom})
+	_, err = server.Mint(wctx, types.NewMsgMint(minter, minter, coin))
+	require.NoError(t, err)
+
+	_, err = server.Pause(wctx, types.NewMsgPause(pauser, testDenom, []types.PauseOperation{types.PauseMint}))
+	require.NoError(t, err)
+	_, err = server.Burn(wctx, types.NewMsgBurn(minter, sdk.NewCoin(testDenom, sdk.NewInt(1))))
+	require.NoError(t, err)
+
+	// pausing every operation
+	_, err = server.Pause(wctx, types.NewMsgPause(pauser, testDenom, nil))
+	require.NoError(t, err)
+	require.Equal(t, types.Paused{
+		Paused:      true,
+		Denom:       testDenom,
+		Mint:        true,
+		Burn:        true,
+		Transfer:    true,
+		IBCOutbound: true,
+	}, k.GetPaused(ctx, testDenom))
+
+	_, err = server.Burn(wctx, types.NewMsgBurn(minter, sdk.NewCoin(testDenom, sdk.NewInt(1))))
+	require.ErrorIs(t, err, types.ErrBurn)
+
+	// unpausing some operations keeps the aggregate flag set
+	_, err = server.Unpause(wctx, types.NewMsgUnpause(pauser, testDenom, []types.PauseOperation{types.PauseMint, types.PauseBurn}))
+	require.NoError(t, err)
+	require.Equal(t, types.Paused{
+		Paused:      true,
+		Denom:       testDenom,
+		Transfer:    true,
+		IBCOutbound: true,
+	}, k.GetPaused(ctx, testDenom))
+
+	_, err = server.Burn(wctx, types.NewMsgBurn(minter, sdk.NewCoin(testDenom, sdk.NewInt(1))))
+	require.NoError(t, err)
+
+	_, err = server.Unpause(wctx, types.NewMsgUnpause(pauser, testDenom, nil))
+	require.NoError(t, err)
+
+	resp, err := k.Paused(wctx, &types.QueryGetPausedRequest{Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, types.Paused{Denom: testDenom}, resp.Paused)
+}
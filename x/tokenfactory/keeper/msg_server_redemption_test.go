@@ -0,0 +1,272 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestRedemptions(t *testing.T) {
+	bankKeeper := keepertest.NewMockSupplyBankKeeper()
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	server := keeper.NewMsgServerImpl(k)
+
+	minter, user, other := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(testDenom, sdk.NewInt(amount))
+	}
+	balance := func(address string) sdk.Int {
+		return bankKeeper.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(address)).AmountOf(testDenom)
+	}
+	requireInvariant := func() {
+		msg, broken := keeper.ModuleAccountBalanceInvariant(k)(ctx)
+		require.False(t, broken, msg)
+	}
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+	params := types.DefaultParams()
+	params.MinRedemptionAmount = sdk.NewInt(10)
+	k.SetParams(ctx, params)
+	k.SetMinters(ctx, types.Minters{Address: minter, Allowance: coin(1000), Denom: testDenom})
+
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).UTC())
+	wctx := sdk.WrapSDKContext(ctx)
+
+	_, err := server.Mint(wctx, types.NewMsgMint(minter, user, coin(100)))
+	require.NoError(t, err)
+
+	// the tokens are escrowed in the module account
+	res, err := server.RequestRedemption(wctx, types.NewMsgRequestRedemption(user, coin(30), "iban:DE00"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Id)
+	require.Equal(t, sdk.NewInt(70), balance(user))
+	requireInvariant()
+
+	redemption, found := k.GetRedemption(ctx, res.Id)
+	require.True(t, found)
+	require.True(t, redemption.IsPending())
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultRedemptionTimeout), *redemption.Timeout)
+	require.Equal(t, &types.EventRedemptionRequested{
+		Id:                 res.Id,
+		Requester:          user,
+		Amount:             coin(30),
+		PayoutInstructions: "iban:DE00",
+		Timeout:            redemption.Timeout,
+	}, lastEvent(t, ctx))
+
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = server.RequestRedemption(sdk.WrapSDKContext(cacheCtx), types.NewMsgRequestRedemption(user, coin(71), "iban:DE00"))
+	require.ErrorIs(t, err, types.ErrRedemption)
+
+	_, err = server.RequestRedemption(wctx, types.NewMsgRequestRedemption(user, sdk.NewInt64Coin("uother", 10), "iban:DE00"))
+	require.ErrorIs(t, err, types.ErrRedemption)
+
+	// requests below the minimum redemption amount are rejected
+	_, err = server.RequestRedemption(wctx, types.NewMsgRequestRedemption(user, coin(9), "iban:DE00"))
+	require.ErrorIs(t, err, types.ErrRedemption)
+
+	// only minters of the denom can resolve a redemption
+	_, err = server.FulfillRedemption(wctx, types.NewMsgFulfillRedemption(other, res.Id, ""))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = server.RejectRedemption(wctx, types.NewMsgRejectRedemption(other, res.Id, "kyc"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// fulfilling burns the escrowed tokens
+	_, err = server.FulfillRedemption(wctx, types.NewMsgFulfillRedemption(minter, res.Id, "wire-1"))
+	require.NoError(t, err)
+	require.Equal(t, coin(70), bankKeeper.GetSupply(ctx, testDenom))
+	requireInvariant()
+
+	redemption, _ = k.GetRedemption(ctx, res.Id)
+	require.Equal(t, types.RedemptionStatusFulfilled, redemption.Status)
+	require.Equal(t, minter, redemption.Resolver)
+	require.Equal(t, "wire-1", redemption.PayoutReference)
+	require.Equal(t, &types.EventRedemptionFulfilled{
+		Id:              res.Id,
+		Requester:       user,
+		Minter:          minter,
+		Amount:          coin(30),
+		PayoutReference: "wire-1",
+		TotalSupply:     coin(70),
+	}, lastEvent(t, ctx))
+
+	// a resolved redemption can not be resolved again
+	_, err = server.RejectRedemption(wctx, types.NewMsgRejectRedemption(minter, res.Id, "kyc"))
+	require.ErrorIs(t, err, types.ErrRedemption)
+	_, err = server.FulfillRedemption(wctx, types.NewMsgFulfillRedemption(minter, 5, ""))
+	require.ErrorIs(t, err, types.ErrRedemption)
+
+	// rejecting refunds the escrowed tokens
+	res, err = server.RequestRedemption(wctx, types.NewMsgRequestRedemption(user, coin(20), "iban:DE00"))
+	require.NoError(t, err)
+	_, err = server.RejectRedemption(wctx, types.NewMsgRejectRedemption(minter, res.Id, "kyc"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(70), balance(user))
+	requireInvariant()
+
+	redemption, _ = k.GetRedemption(ctx, res.Id)
+	require.Equal(t, types.RedemptionStatusRejected, redemption.Status)
+	require.Equal(t, "kyc", redemption.Reason)
+
+	// redemptions of a blacklisted requester can not be fulfilled but can be rejected
+	res, err = server.RequestRedemption(wctx, types.NewMsgRequestRedemption(user, coin(10), "iban:DE00"))
+	require.NoError(t, err)
+	k.SetBlacklisted(ctx, types.Blacklisted{Denom: testDenom, AddressBz: sdk.MustAccAddressFromBech32(user)})
+	_, err = server.FulfillRedemption(wctx, types.NewMsgFulfillRedemption(minter, res.Id, ""))
+	require.ErrorIs(t, err, types.ErrRedemption)
+	_, err = server.RequestRedemption(wctx, types.NewMsgRequestRedemption(user, coin(10), "iban:DE00"))
+	require.ErrorIs(t, err, types.ErrRedemption)
+	k.RemoveBlacklisted(ctx, testDenom, sdk.MustAccAddressFromBech32(user))
+
+	// requests and fulfillments are paused along with burning
+	k.SetPaused(ctx, types.Paused{Denom: testDenom, Paused: true, Burn: true})
+	_, err = server.FulfillRedemption(wctx, types.NewMsgFulfillRedemption(minter, res.Id, ""))
+	require.ErrorIs(t, err, types.ErrRedemption)
+	_, err = server.RequestRedemption(wctx, types.NewMsgRequestRedemption(user, coin(10), "iban:DE00"))
+	require.ErrorIs(t, err, types.ErrRedemption)
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+
+	// pending redemptions are refunded once they time out
+	ctx = ctx.WithBlockTime(redemption.RequestTime.Add(types.DefaultRedemptionTimeout))
+	require.NoError(t, k.ExpireRedemptions(ctx))
+	require.Equal(t, sdk.NewInt(70), balance(user))
+	redemption, _ = k.GetRedemption(ctx, res.Id)
+	require.Equal(t, types.RedemptionStatusExpired, redemption.Status)
+	requireInvariant()
+}
+
+func TestRedemptionsOfBlacklistedRequester(t *testing.T) {
+	bankKeeper := keepertest.NewMockSupplyBankKeeper()
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	server := keeper.NewMsgServerImpl(k)
+
+	blacklister, minter, user := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(testDenom, sdk.NewInt(amount))
+	}
+	balance := func(address string) sdk.Int {
+		return bankKeeper.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(address)).AmountOf(testDenom)
+	}
+	requireInvariant := func() {
+		msg, broken := keeper.ModuleAccountBalanceInvariant(k)(ctx)
+		require.False(t, broken, msg)
+	}
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+	k.SetBlacklister(ctx, types.Blacklister{Address: blacklister, Denom: testDenom})
+	params := types.DefaultParams()
+	params.MinRedemptionAmount = sdk.NewInt(1)
+	k.SetParams(ctx, params)
+	k.SetMinters(ctx, types.Minters{Address: minter, Allowance: coin(1000), Denom: testDenom})
+
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).UTC())
+	wctx := sdk.WrapSDKContext(ctx)
+
+	_, err := server.Mint(wctx, types.NewMsgMint(minter, user, coin(100)))
+	require.NoError(t, err)
+
+	rejected, err := server.RequestRedemption(wctx, types.NewMsgRequestRedemption(user, coin(30), "iban:DE00"))
+	require.NoError(t, err)
+	expired, err := server.RequestRedemption(wctx, types.NewMsgRequestRedemption(user, coin(20), "iban:DE00"))
+	require.NoError(t, err)
+
+	_, err = server.Blacklist(wctx, types.NewMsgBlacklist(blacklister, user, testDenom, "sanctions", "", nil))
+	require.NoError(t, err)
+
+	// the refund of a rejected redemption is held while the requester is blacklisted
+	_, err = server.RejectRedemption(wctx, types.NewMsgRejectRedemption(minter, rejected.Id, "sanctions"))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventRedemptionRejected{
+		Id:        rejected.Id,
+		Requester: user,
+		Minter:    minter,
+		Amount:    coin(30),
+		Reason:    "sanctions",
+		Held:      true,
+	}, lastEvent(t, ctx))
+	require.Equal(t, sdk.NewInt(50), balance(user))
+	requireInvariant()
+
+	redemption, _ := k.GetRedemption(ctx, rejected.Id)
+	require.Equal(t, types.RedemptionStatusHeld, redemption.Status)
+	require.Nil(t, redemption.ResolveTime)
+
+	// so is the refund of a timed out redemption
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultRedemptionTimeout))
+	require.NoError(t, k.ExpireRedemptions(ctx))
+	require.Equal(t, &types.EventRedemptionExpired{
+		Id:        expired.Id,
+		Requester: user,
+		Amount:    coin(20),
+		Held:      true,
+	}, lastEvent(t, ctx))
+	require.Equal(t, sdk.NewInt(50), balance(user))
+	requireInvariant()
+
+	redemption, _ = k.GetRedemption(ctx, expired.Id)
+	require.Equal(t, types.RedemptionStatusHeld, redemption.Status)
+
+	// held redemptions can not be resolved by a minter
+	_, err = server.RejectRedemption(sdk.WrapSDKContext(ctx), types.NewMsgRejectRedemption(minter, rejected.Id, "sanctions"))
+	require.ErrorIs(t, err, types.ErrRedemption)
+
+	// unblacklisting the requester refunds the held redemptions
+	_, err = server.Unblacklist(sdk.WrapSDKContext(ctx), types.NewMsgUnblacklist(blacklister, user, testDenom))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(100), balance(user))
+	requireInvariant()
+
+	redemption, _ = k.GetRedemption(ctx, rejected.Id)
+	require.Equal(t, types.RedemptionStatusRejected, redemption.Status)
+	require.Equal(t, ctx.BlockTime(), *redemption.ResolveTime)
+	redemption, _ = k.GetRedemption(ctx, expired.Id)
+	require.Equal(t, types.RedemptionStatusExpired, redemption.Status)
+	require.Equal(t, ctx.BlockTime(), *redemption.ResolveTime)
+}
+
+func TestPruneRedemptions(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, keepertest.NewMockSupplyBankKeeper())
+
+	start := time.Unix(0, 0).UTC()
+	resolved := start.Add(time.Hour)
+
+	k.SetRedemption(ctx, types.Redemption{Id: 0, Requester: sample.AccAddress(), Status: types.RedemptionStatusFulfilled, RequestTime: start, ResolveTime: &start})
+	k.SetRedemption(ctx, types.Redemption{Id: 1, Requester: sample.AccAddress(), Status: types.RedemptionStatusRejected, RequestTime: start, ResolveTime: &resolved})
+	k.SetRedemption(ctx, types.Redemption{Id: 2, Requester: sample.AccAddress(), Status: types.RedemptionStatusPending, RequestTime: start})
+	k.SetRedemption(ctx, types.Redemption{Id: 3, Requester: sample.AccAddress(), Status: types.RedemptionStatusHeld, RequestTime: start})
+
+	// redemptions are kept until the retention has passed since they were resolved
+	k.PruneRedemptions(ctx.WithBlockTime(start.Add(types.DefaultRedemptionRetention)))
+	require.Len(t, k.GetAllRedemptions(ctx), 4)
+
+	k.PruneRedemptions(ctx.WithBlockTime(start.Add(types.DefaultRedemptionRetention + time.Nanosecond)))
+	_, found := k.GetRedemption(ctx, 0)
+	require.False(t, found)
+	require.Len(t, k.GetAllRedemptions(ctx), 3)
+
+	// escrowed redemptions are never pruned
+	k.PruneRedemptions(ctx.WithBlockTime(resolved.Add(10 * types.DefaultRedemptionRetention)))
+	list := k.GetAllRedemptions(ctx)
+	require.Len(t, list, 2)
+	require.Equal(t, uint64(2), list[0].Id)
+	require.Equal(t, uint64(3), list[1].Id)
+
+	// a zero retention disables pruning
+	params := types.DefaultParams()
+	params.RedemptionRetention = 0
+	k.SetParams(ctx, params)
+	k.SetRedemption(ctx, types.Redemption{Id: 4, Requester: sample.AccAddress(), Status: types.RedemptionStatusExpired, RequestTime: start, ResolveTime: &start})
+	k.PruneRedemptions(ctx.WithBlockTime(resolved.Add(10 * types.DefaultRedemptionRetention)))
+	require.Len(t, k.GetAllRedemptions(ctx), 3)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) Reject(goCtx context.Context, msg *types.MsgReject) (*types.MsgRejectResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	quorum, proposal, err := k.getVotableAdminProposal(ctx, msg.From, msg.Denom, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal.Rejections = append(proposal.Rejections, msg.From)
+	k.SetAdminProposal(ctx, proposal)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAdminProposalVoted{
+		Denom:      msg.Denom,
+		Id:         msg.Id,
+		Voter:      msg.From,
+		Approved:   false,
+		Approvals:  countVotes(quorum, proposal.Approvals),
+		Rejections: countVotes(quorum, proposal.Rejections),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the proposal is rejected once the approvers that have not rejected it can no longer reach the threshold
+	if uint32(len(quorum.Approvers))-countVotes(quorum, proposal.Rejections) >= quorum.Threshold {
+		return &types.MsgRejectResponse{}, nil
+	}
+
+	k.DeleteAdminProposal(ctx, msg.Denom, msg.Id)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAdminProposalRejected{
+		Denom: msg.Denom,
+		Id:    msg.Id,
+	})
+
+	return &types.MsgRejectResponse{Rejected: true}, err
+}
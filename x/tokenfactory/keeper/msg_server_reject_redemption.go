@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RejectRedemption(goCtx context.Context, msg *types.MsgRejectRedemption) (*types.MsgRejectRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redemption, err := k.getPendingRedemption(ctx, msg.From, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	redemption.Resolver = msg.From
+	redemption.Reason = msg.Reason
+	held, err := k.resolveRedemption(ctx, redemption, types.RedemptionStatusRejected)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRedemptionRejected{
+		Id:        redemption.Id,
+		Requester: redemption.Requester,
+		Minter:    msg.From,
+		Amount:    redemption.Amount,
+		Reason:    msg.Reason,
+		Held:      held,
+	})
+
+	return &types.MsgRejectRedemptionResponse{}, err
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RemoveMintRateLimit(goCtx context.Context, msg *types.MsgRemoveMintRateLimit) (*types.MsgRemoveMintRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateMintRateLimitAuthority(ctx, msg.Denom, msg.From); err != nil {
+		return nil, err
+	}
+
+	mintRateLimit, found := k.GetMintRateLimit(ctx, msg.Denom, msg.Minter)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "mint rate limit is not set")
+	}
+
+	k.DeleteMintRateLimit(ctx, msg.Denom, msg.Minter)
+	k.recordAudit(ctx, msg.Denom, types.AuditActionRemoveMintRateLimit, msg.From, msg.Minter, formatMintRateLimit(mintRateLimit), "")
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMintRateLimitRemoved{
+		Denom:         msg.Denom,
+		Minter:        msg.Minter,
+		PreviousLimit: mintRateLimit.Limit,
+		UpdatedBy:     msg.From,
+	})
+
+	return &types.MsgRemoveMintRateLimitResponse{}, err
+}
@@ -12,31 +12,23 @@ import (
 func (k msgServer) RemoveMinter(goCtx context.Context, msg *types.MsgRemoveMinter) (*types.MsgRemoveMinterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	minterController, found := k.GetMinterController(ctx, msg.From)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "minter controller not found")
-	}
-
-	if msg.From != minterController.Controller {
+	if _, found := k.GetMinterController(ctx, msg.Denom, msg.From, msg.Address); !found {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a controller of this minter")
 	}
 
-	if msg.Address != minterController.Minter {
-		return nil, sdkerrors.Wrapf(
-			types.ErrUnauthorized,
-			"minter address ≠ minter controller's minter address, (%s≠%s)",
-			msg.Address, minterController.Minter,
-		)
-	}
-
-	minter, found := k.GetMinters(ctx, msg.Address)
+	minter, found := k.GetMinters(ctx, msg.Denom, msg.Address)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "a minter with a given address doesn't exist")
 	}
 
-	k.RemoveMinters(ctx, minter.Address)
+	k.RemoveMinters(ctx, minter.Denom, minter.Address)
+	k.recordAudit(ctx, msg.Denom, types.AuditActionRemoveMinter, msg.From, msg.Address, minter.Allowance.String(), "")
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMinterRemoved{
+		Minter:            msg.Address,
+		Controller:        msg.From,
+		PreviousAllowance: minter.Allowance,
+	})
 
 	return &types.MsgRemoveMinterResponse{}, err
 }
@@ -12,7 +12,7 @@ import (
 func (k msgServer) RemoveMinterController(goCtx context.Context, msg *types.MsgRemoveMinterController) (*types.MsgRemoveMinterControllerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	masterMinter, found := k.GetMasterMinter(ctx)
+	masterMinter, found := k.GetMasterMinter(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "master minter is not set")
 	}
@@ -21,12 +21,48 @@ func (k msgServer) RemoveMinterController(goCtx context.Context, msg *types.MsgR
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the master minter")
 	}
 
-	_, found = k.GetMinterController(ctx, msg.Controller)
-	if !found {
+	if err := k.requireAdminProposal(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	// an empty minter removes the controller from all of its minters
+	if msg.Minter != "" {
+		_, found = k.GetMinterController(ctx, msg.Denom, msg.Controller, msg.Minter)
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "minter controller with a given address (%s) doesn't control minter (%s)", msg.Controller, msg.Minter)
+		}
+
+		k.DeleteMinterController(ctx, msg.Denom, msg.Controller, msg.Minter)
+		k.recordAudit(ctx, msg.Denom, types.AuditActionRemoveMinterController, msg.From, msg.Controller, msg.Minter, "")
+
+		err := ctx.EventManager().EmitTypedEvent(&types.EventMinterControllerRemoved{
+			Denom:        msg.Denom,
+			Controller:   msg.Controller,
+			Minter:       msg.Minter,
+			MasterMinter: msg.From,
+		})
+
+		return &types.MsgRemoveMinterControllerResponse{}, err
+	}
+
+	minterControllers := k.GetMintersByController(ctx, msg.Denom, msg.Controller)
+	if len(minterControllers) == 0 {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "minter controller with a given address (%s) doesn't exist", msg.Controller)
 	}
 
-	k.DeleteMinterController(ctx, msg.Controller)
+	for _, minterController := range minterControllers {
+		k.DeleteMinterController(ctx, msg.Denom, minterController.Controller, minterController.Minter)
+		k.recordAudit(ctx, msg.Denom, types.AuditActionRemoveMinterController, msg.From, minterController.Controller, minterController.Minter, "")
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventMinterControllerRemoved{
+			Denom:        msg.Denom,
+			Controller:   minterController.Controller,
+			Minter:       minterController.Minter,
+			MasterMinter: msg.From,
+		}); err != nil {
+			return nil, err
+		}
+	}
 
 	return &types.MsgRemoveMinterControllerResponse{}, nil
 }
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgRequestRedemption) (*types.MsgRequestRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom := msg.Amount.Denom
+
+	if !k.MintingDenomSet(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrRedemption, "%s is not a minting denom", denom)
+	}
+
+	params := k.GetParams(ctx)
+	if msg.Amount.Amount.LT(params.MinRedemptionAmount) {
+		return nil, sdkerrors.Wrapf(types.ErrRedemption, "amount is below the minimum redemption amount of %s", params.MinRedemptionAmount)
+	}
+
+	_, addressBz, err := bech32.DecodeAndConvert(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	_, found := k.GetBlacklisted(ctx, denom, addressBz)
+	if found {
+		return nil, sdkerrors.Wrap(types.ErrRedemption, "requester address is blacklisted")
+	}
+
+	if k.GetPaused(ctx, denom).IsPaused(types.PauseBurn) {
+		return nil, sdkerrors.Wrap(types.ErrRedemption, "burning is paused")
+	}
+
+	if err := k.CheckFrozen(ctx, msg.From, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(addressBz), types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrRedemption, err.Error())
+	}
+
+	redemption := types.Redemption{
+		Requester:          msg.From,
+		Amount:             msg.Amount,
+		PayoutInstructions: msg.PayoutInstructions,
+		Status:             types.RedemptionStatusPending,
+		RequestTime:        ctx.BlockTime(),
+	}
+	if timeout := params.RedemptionTimeout; timeout > 0 {
+		expiry := ctx.BlockTime().Add(timeout)
+		redemption.Timeout = &expiry
+	}
+	redemption.Id = k.AppendRedemption(ctx, redemption)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRedemptionRequested{
+		Id:                 redemption.Id,
+		Requester:          redemption.Requester,
+		Amount:             redemption.Amount,
+		PayoutInstructions: redemption.PayoutInstructions,
+		Timeout:            redemption.Timeout,
+	})
+
+	return &types.MsgRequestRedemptionResponse{Id: redemption.Id}, err
+}
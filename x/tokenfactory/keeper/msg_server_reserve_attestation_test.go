@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestUpdateAttestor(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner, pauser, attestor := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	k.SetOwner(ctx, types.Owner{Address: owner, Denom: testDenom})
+	k.SetPauser(ctx, types.Pauser{Address: pauser, Denom: testDenom})
+
+	_, err := server.UpdateAttestor(wctx, types.NewMsgUpdateAttestor(attestor, attestor, testDenom))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.UpdateAttestor(wctx, types.NewMsgUpdateAttestor(owner, pauser, testDenom))
+	require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
+
+	_, err = server.UpdateAttestor(wctx, types.NewMsgUpdateAttestor(owner, attestor, testDenom))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventRoleUpdated{
+		Denom:     testDenom,
+		Role:      types.RoleAttestor,
+		Address:   attestor,
+		UpdatedBy: owner,
+	}, lastEvent(t, ctx))
+
+	rst, found := k.GetAttestor(ctx, testDenom)
+	require.True(t, found)
+	require.Equal(t, types.Attestor{Address: attestor, Denom: testDenom}, rst)
+
+	// the attestor can not be assigned another role
+	_, err = server.UpdatePauser(wctx, types.NewMsgUpdatePauser(owner, attestor, testDenom))
+	require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
+
+	// updating the attestor is an admin action once the denom has an admin quorum
+	k.SetAdminQuorum(ctx, types.AdminQuorum{Denom: testDenom, Approvers: []string{sample.AccAddress()}, Threshold: 1, VotingPeriod: time.Hour})
+	_, err = server.UpdateAttestor(wctx, types.NewMsgUpdateAttestor(owner, sample.AccAddress(), testDenom))
+	require.ErrorIs(t, err, types.ErrAdminProposalRequired)
+	require.True(t, types.IsAdminProposalMsg(&types.MsgUpdateAttestor{}))
+}
+
+func TestReserveAttestationGating(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, keepertest.NewMockSupplyBankKeeper())
+	server := keeper.NewMsgServerImpl(k)
+
+	owner, attestor, minter := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(testDenom, sdk.NewInt(amount))
+	}
+
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	mint := func(ctx sdk.Context, amount int64) error {
+		_, err := server.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(minter, sample.AccAddress(), coin(amount)))
+		return err
+	}
+	attest := func(reserves int64, timestamp time.Time) (uint64, error) {
+		res, err := server.AttestReserves(wctx, types.NewMsgAttestReserves(attestor, coin(reserves), timestamp))
+		if err != nil {
+			return 0, err
+		}
+		return res.Id, nil
+	}
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Denom: testDenom})
+	k.SetOwner(ctx, types.Owner{Address: owner, Denom: testDenom})
+	k.SetMinters(ctx, types.Minters{Address: minter, Allowance: coin(1000), Denom: testDenom})
+	k.SetParams(ctx, types.NewParams(types.DefaultAuditLogRetention, types.DefaultReferenceIDRetention, types.DefaultRedemptionTimeout, 35*24*time.Hour, types.DefaultMinRedemptionAmount, types.DefaultRedemptionRetention))
+
+	// mints are not gated until the denom has an attestor
+	require.NoError(t, mint(ctx, 50))
+
+	_, err := attest(100, now)
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+
+	_, err = server.UpdateAttestor(wctx, types.NewMsgUpdateAttestor(owner, attestor, testDenom))
+	require.NoError(t, err)
+
+	err = mint(ctx, 1)
+	require.ErrorIs(t, err, types.ErrInsufficientReserves)
+	require.Contains(t, err.Error(), "no reserves")
+
+	_, err = server.AttestReserves(wctx, types.NewMsgAttestReserves(minter, coin(100), now))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = attest(100, now.Add(time.Second))
+	require.ErrorIs(t, err, types.ErrReserveAttestation)
+
+	_, err = server.AttestReserves(wctx, types.NewMsgAttestReserves(attestor, sdk.NewInt64Coin("uother", 100), now))
+	require.ErrorIs(t, err, types.ErrReserveAttestation)
+
+	january := now.Add(-24 * time.Hour)
+	id, err := attest(100, january)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), id)
+	require.Equal(t, &types.EventReservesAttested{
+		Id:          0,
+		Attestor:    attestor,
+		Reserves:    coin(100),
+		Timestamp:   january,
+		TotalSupply: coin(50),
+	}, lastEvent(t, ctx))
+
+	// attestations can not go back in time
+	_, err = attest(200, january)
+	require.ErrorIs(t, err, types.ErrReserveAttestation)
+
+	// the supply after the mint must be covered by the reserves
+	err = mint(ctx, 51)
+	require.ErrorIs(t, err, types.ErrInsufficientReserves)
+	require.NoError(t, mint(ctx, 30))
+
+	res, err := k.CollateralizationRatio(wctx, &types.QueryCollateralizationRatioRequest{Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, coin(80), res.Supply)
+	require.Equal(t, sdk.MustNewDecFromStr("1.25"), res.Ratio)
+	require.False(t, res.Stale)
+
+	// no mints are allowed once the latest attestation is older than the maximum age
+	stale := ctx.WithBlockTime(january.Add(35*24*time.Hour + time.Second))
+	err = mint(stale, 1)
+	require.ErrorIs(t, err, types.ErrInsufficientReserves)
+	require.Contains(t, err.Error(), "older than")
+
+	res, err = k.CollateralizationRatio(sdk.WrapSDKContext(stale), &types.QueryCollateralizationRatioRequest{Denom: testDenom})
+	require.NoError(t, err)
+	require.True(t, res.Stale)
+
+	id, err = attest(200, now)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+	require.NoError(t, mint(ctx, 100))
+
+	all, err := k.ReserveAttestationAll(wctx, &types.QueryAllReserveAttestationRequest{Denom: testDenom, Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), all.Pagination.Total)
+	require.Equal(t, []types.ReserveAttestation{
+		{Id: 0, Attestor: attestor, Reserves: coin(100), Timestamp: january, Height: ctx.BlockHeight(), SubmitTime: now},
+		{Id: 1, Attestor: attestor, Reserves: coin(200), Timestamp: now, Height: ctx.BlockHeight(), SubmitTime: now},
+	}, all.ReserveAttestation)
+
+	single, err := k.ReserveAttestation(wctx, &types.QueryGetReserveAttestationRequest{Denom: testDenom, Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, all.ReserveAttestation[1], single.ReserveAttestation)
+
+	_, err = k.ReserveAttestation(wctx, &types.QueryGetReserveAttestationRequest{Denom: "uother", Id: 1})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	_, err = k.CollateralizationRatio(wctx, &types.QueryCollateralizationRatioRequest{Denom: "uother"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+}
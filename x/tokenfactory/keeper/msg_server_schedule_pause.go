@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SchedulePause(goCtx context.Context, msg *types.MsgSchedulePause) (*types.MsgSchedulePauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pauser, found := k.GetPauser(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pauser is not set")
+	}
+
+	if pauser.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pauser")
+	}
+
+	// The schedule unpauses its operations when its window ends, which requires the admin quorum.
+	if err := k.requireAdminProposal(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	schedule := msg.PauseSchedule()
+	if schedule.StartReached(ctx.BlockHeight(), ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrPauseSchedule, "start of the pause window has already been reached")
+	}
+	if schedule.EndReached(ctx.BlockHeight(), ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrPauseSchedule, "end of the pause window has already been reached")
+	}
+
+	id := k.AppendPauseSchedule(ctx, schedule)
+	schedule.Id = id
+	k.recordAudit(ctx, msg.Denom, types.AuditActionSchedulePause, msg.From, strconv.FormatUint(id, 10), "", formatPauseSchedule(schedule))
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventPauseScheduled{
+		PauseSchedule: schedule,
+		Pauser:        msg.From,
+	})
+
+	return &types.MsgSchedulePauseResponse{Id: id}, err
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetAdminQuorum(goCtx context.Context, msg *types.MsgSetAdminQuorum) (*types.MsgSetAdminQuorumResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	if err := k.requireAdminProposal(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	previousQuorum, _ := k.GetAdminQuorum(ctx, msg.Denom)
+	quorum := msg.AdminQuorum()
+
+	if len(quorum.Approvers) == 0 {
+		// pending proposals can no longer be approved without a quorum
+		for _, proposal := range k.GetAllAdminProposals(ctx) {
+			if proposal.Denom == msg.Denom {
+				k.DeleteAdminProposal(ctx, proposal.Denom, proposal.Id)
+			}
+		}
+		k.DeleteAdminQuorum(ctx, msg.Denom)
+	} else {
+		k.Keeper.SetAdminQuorum(ctx, quorum)
+	}
+
+	k.recordAudit(ctx, msg.Denom, types.AuditActionSetAdminQuorum, msg.From, "", formatAdminQuorum(previousQuorum), formatAdminQuorum(quorum))
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventAdminQuorumUpdated{
+		PreviousQuorum: previousQuorum,
+		Quorum:         quorum,
+		UpdatedBy:      msg.From,
+	})
+
+	return &types.MsgSetAdminQuorumResponse{}, err
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetAllowlistMode(goCtx context.Context, msg *types.MsgSetAllowlistMode) (*types.MsgSetAllowlistModeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.MintingDenomSet(ctx, msg.Denom) {
+		return nil, sdkerrors.Wrapf(types.ErrDenomNotFound, "%s is not a minting denom", msg.Denom)
+	}
+
+	owner, found := k.GetOwner(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	if err := k.requireAdminProposal(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	previous := k.GetAllowlistMode(ctx, msg.Denom)
+
+	k.Keeper.SetAllowlistMode(ctx, types.AllowlistMode{Denom: msg.Denom, Enabled: msg.Enabled})
+	k.recordAudit(ctx, msg.Denom, types.AuditActionSetAllowlistMode, msg.From, "", strconv.FormatBool(previous.Enabled), strconv.FormatBool(msg.Enabled))
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventAllowlistModeSet{
+		Denom:     msg.Denom,
+		Enabled:   msg.Enabled,
+		UpdatedBy: msg.From,
+	})
+
+	return &types.MsgSetAllowlistModeResponse{}, err
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetMaxSupply(goCtx context.Context, msg *types.MsgSetMaxSupply) (*types.MsgSetMaxSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom := msg.MaxSupply.Denom
+
+	owner, found := k.GetOwner(ctx, denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	previousMaxSupply := sdk.NewCoin(denom, sdk.ZeroInt())
+	if supplyCap, found := k.GetSupplyCap(ctx, denom); found {
+		previousMaxSupply = supplyCap.MaxSupply
+	}
+
+	if msg.MaxSupply.IsZero() {
+		k.DeleteSupplyCap(ctx, denom)
+	} else {
+		k.SetSupplyCap(ctx, types.SupplyCap{
+			Denom:     denom,
+			MaxSupply: msg.MaxSupply,
+		})
+	}
+	k.recordAudit(ctx, denom, types.AuditActionSetMaxSupply, msg.From, "", previousMaxSupply.String(), msg.MaxSupply.String())
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMaxSupplySet{
+		Denom:             denom,
+		Owner:             msg.From,
+		PreviousMaxSupply: previousMaxSupply,
+		MaxSupply:         msg.MaxSupply,
+		TotalSupply:       k.bankKeeper.GetSupply(ctx, denom),
+	})
+
+	return &types.MsgSetMaxSupplyResponse{}, err
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetMintRateLimit(goCtx context.Context, msg *types.MsgSetMintRateLimit) (*types.MsgSetMintRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom := msg.Limit.Denom
+
+	if !k.MintingDenomSet(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
+	}
+
+	if err := k.validateMintRateLimitAuthority(ctx, denom, msg.From); err != nil {
+		return nil, err
+	}
+
+	previousLimit := sdk.NewCoin(denom, sdk.ZeroInt())
+	var previousAuditValue string
+	if mintRateLimit, found := k.GetMintRateLimit(ctx, denom, msg.Minter); found {
+		previousLimit = mintRateLimit.Limit
+		previousAuditValue = formatMintRateLimit(mintRateLimit)
+	}
+
+	mintRateLimit := types.MintRateLimit{
+		Denom:  denom,
+		Minter: msg.Minter,
+		Window: msg.Window,
+		Limit:  msg.Limit,
+	}
+
+	k.Keeper.SetMintRateLimit(ctx, mintRateLimit)
+	k.recordAudit(ctx, denom, types.AuditActionSetMintRateLimit, msg.From, msg.Minter, previousAuditValue, formatMintRateLimit(mintRateLimit))
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMintRateLimitSet{
+		Denom:         denom,
+		Minter:        msg.Minter,
+		Window:        msg.Window,
+		PreviousLimit: previousLimit,
+		Limit:         msg.Limit,
+		UpdatedBy:     msg.From,
+	})
+
+	return &types.MsgSetMintRateLimitResponse{}, err
+}
+
+// validateMintRateLimitAuthority ensures that address is either the master minter or the owner of denom.
+func (k msgServer) validateMintRateLimitAuthority(ctx sdk.Context, denom string, address string) error {
+	if masterMinter, found := k.GetMasterMinter(ctx, denom); found && masterMinter.Address == address {
+		return nil
+	}
+
+	if owner, found := k.GetOwner(ctx, denom); found && owner.Address == address {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the master minter or the owner")
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SubmitAdminProposal(goCtx context.Context, msg *types.MsgSubmitAdminProposal) (*types.MsgSubmitAdminProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	quorum, found := k.GetAdminQuorum(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAdminProposal, "%s has no admin quorum", msg.Denom)
+	}
+
+	if !quorum.IsApprover(msg.From) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not an approver")
+	}
+
+	adminMsg, err := msg.GetAdminMsg()
+	if err != nil {
+		return nil, err
+	}
+
+	// the message is checked here as well, since messages nested in an authz exec are not validated
+	if adminMsg.GetDenom() != msg.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrAdminProposal, "proposed message is for denom %s", adminMsg.GetDenom())
+	}
+
+	// reject proposals that could not be executed in the current state, e.g. because the message is
+	// not signed by the holder of the role it requires
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.runAdminMsg(cacheCtx, msg.Denom, adminMsg); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrAdminProposal, "proposed message fails: %s", err)
+	}
+
+	proposal := types.AdminProposal{
+		Denom:      msg.Denom,
+		Msg:        msg.Msg,
+		Proposer:   msg.From,
+		Approvals:  []string{msg.From},
+		SubmitTime: ctx.BlockTime(),
+		Expiry:     ctx.BlockTime().Add(quorum.VotingPeriod),
+	}
+	proposal.Id = k.AppendAdminProposal(ctx, proposal)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAdminProposalSubmitted{
+		Proposal: proposal,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	executed := false
+	if countVotes(quorum, proposal.Approvals) >= quorum.Threshold {
+		executed, err = k.executeAdminProposal(ctx, proposal)
+	}
+
+	return &types.MsgSubmitAdminProposalResponse{Id: proposal.Id, Executed: executed}, err
+}
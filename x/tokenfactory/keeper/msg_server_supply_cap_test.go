@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestSupplyCapMint(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, keepertest.NewMockSupplyBankKeeper())
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+	minter := sample.AccAddress()
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(testDenom, sdk.NewInt(amount))
+	}
+	mint := func(amount int64) error {
+		_, err := server.Mint(wctx, types.NewMsgMint(minter, sample.AccAddress(), coin(amount)))
+		return err
+	}
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Paused: false, Denom: testDenom})
+	k.SetOwner(ctx, types.Owner{Address: owner, Denom: testDenom})
+	k.SetMinters(ctx, types.Minters{Address: minter, Allowance: coin(1000), Denom: testDenom})
+
+	_, err := k.SupplyCap(wctx, &types.QuerySupplyCapRequest{Denom: testDenom})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	require.NoError(t, mint(60))
+
+	_, err = server.SetMaxSupply(wctx, types.NewMsgSetMaxSupply(minter, coin(100)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.SetMaxSupply(wctx, types.NewMsgSetMaxSupply(owner, coin(100)))
+	require.NoError(t, err)
+
+	resp, err := k.SupplyCap(wctx, &types.QuerySupplyCapRequest{Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, types.SupplyCap{Denom: testDenom, MaxSupply: coin(100)}, resp.SupplyCap)
+	require.Equal(t, coin(60), resp.Supply)
+	require.Equal(t, coin(40), resp.Headroom)
+
+	err = mint(41)
+	require.ErrorIs(t, err, types.ErrSupplyCap)
+	require.Contains(t, err.Error(), "at most 40"+testDenom)
+
+	require.NoError(t, mint(40))
+
+	resp, err = k.SupplyCap(wctx, &types.QuerySupplyCapRequest{Denom: testDenom})
+	require.NoError(t, err)
+	require.True(t, resp.Headroom.IsZero())
+
+	// lowering the cap below the current supply leaves no headroom
+	_, err = server.SetMaxSupply(wctx, types.NewMsgSetMaxSupply(owner, coin(50)))
+	require.NoError(t, err)
+
+	resp, err = k.SupplyCap(wctx, &types.QuerySupplyCapRequest{Denom: testDenom})
+	require.NoError(t, err)
+	require.True(t, resp.Headroom.IsZero())
+	require.ErrorIs(t, mint(1), types.ErrSupplyCap)
+
+	// a zero max supply removes the cap
+	_, err = server.SetMaxSupply(wctx, types.NewMsgSetMaxSupply(owner, coin(0)))
+	require.NoError(t, err)
+
+	_, found := k.GetSupplyCap(ctx, testDenom)
+	require.False(t, found)
+	require.NoError(t, mint(1))
+}
@@ -13,28 +13,45 @@ import (
 func (k msgServer) Unblacklist(goCtx context.Context, msg *types.MsgUnblacklist) (*types.MsgUnblacklistResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	blacklister, found := k.GetBlacklister(ctx)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "blacklister is not set")
+	if err := k.validateBlacklister(ctx, msg.Denom, msg.From); err != nil {
+		return nil, err
 	}
 
-	if blacklister.Address != msg.From {
-		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the blacklister")
+	if err := k.unblacklist(ctx, msg.Denom, msg.Address, msg.From); err != nil {
+		return nil, err
 	}
 
-	_, addressBz, err := bech32.DecodeAndConvert(msg.Address)
+	return &types.MsgUnblacklistResponse{}, nil
+}
+
+// unblacklist removes an address from the blacklist of a denom and emits an EventUnblacklisted, or
+// returns ErrUserNotFound if it is not blacklisted.
+func (k Keeper) unblacklist(ctx sdk.Context, denom, address, blacklister string) error {
+	_, addressBz, err := bech32.DecodeAndConvert(address)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	blacklisted, found := k.GetBlacklisted(ctx, addressBz)
+	blacklisted, found := k.GetBlacklisted(ctx, denom, addressBz)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "the specified address is not blacklisted")
+		return sdkerrors.Wrapf(types.ErrUserNotFound, "the specified address is not blacklisted")
 	}
 
-	k.RemoveBlacklisted(ctx, blacklisted.AddressBz)
+	k.RemoveBlacklisted(ctx, blacklisted.Denom, blacklisted.AddressBz)
+	k.recordAudit(ctx, denom, types.AuditActionUnblacklist, blacklister, address, blacklisted.Reason, "")
+
+	if err := k.releaseHeldRedemptions(ctx, denom, address); err != nil {
+		return err
+	}
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	if err := k.afterUnblacklist(ctx, denom, address); err != nil {
+		return err
+	}
 
-	return &types.MsgUnblacklistResponse{}, err
+	return ctx.EventManager().EmitTypedEvent(&types.EventUnblacklisted{
+		Denom:       denom,
+		Address:     address,
+		Reason:      blacklisted.Reason,
+		Blacklister: blacklister,
+	})
 }
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) UnblacklistBatch(goCtx context.Context, msg *types.MsgUnblacklistBatch) (*types.MsgUnblacklistBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateBlacklister(ctx, msg.Denom, msg.From); err != nil {
+		return nil, err
+	}
+
+	results := make([]types.BatchResult, 0, len(msg.Addresses))
+	for _, address := range msg.Addresses {
+		err := k.unblacklist(ctx, msg.Denom, address, msg.From)
+		if msg.Idempotent && sdkerrors.IsOf(err, types.ErrUserNotFound) {
+			results = append(results, types.BatchResult{Address: address, Status: types.BatchResultSkipped})
+			continue
+		}
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to unblacklist %s", address)
+		}
+
+		results = append(results, types.BatchResult{Address: address, Status: types.BatchResultApplied})
+	}
+
+	return &types.MsgUnblacklistBatchResponse{Results: results}, nil
+}
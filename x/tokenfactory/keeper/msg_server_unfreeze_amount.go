@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) UnfreezeAmount(goCtx context.Context, msg *types.MsgUnfreezeAmount) (*types.MsgUnfreezeAmountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denom := msg.Amount.Denom
+
+	if !k.MintingDenomSet(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrDenomNotFound, "%s is not a minting denom", denom)
+	}
+
+	if err := k.validateBlacklister(ctx, denom, msg.From); err != nil {
+		return nil, err
+	}
+
+	_, addressBz, err := bech32.DecodeAndConvert(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	frozen := k.GetFrozenAmount(ctx, denom, addressBz)
+	previous := frozen.Amount
+
+	if frozen.Amount.IsLT(msg.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrFrozenAmount, "can not unfreeze %s, only %s is frozen", msg.Amount, frozen.Amount)
+	}
+
+	frozen.Amount = frozen.Amount.Sub(msg.Amount)
+	k.SetFrozenAmount(ctx, frozen)
+	k.recordAudit(ctx, denom, types.AuditActionUnfreezeAmount, msg.From, msg.Address, previous.String(), frozen.Amount.String())
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAmountUnfrozen{
+		Address:     msg.Address,
+		Amount:      msg.Amount,
+		Frozen:      frozen.Amount,
+		Blacklister: msg.From,
+	})
+
+	return &types.MsgUnfreezeAmountResponse{}, err
+}
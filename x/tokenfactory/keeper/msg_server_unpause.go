@@ -12,7 +12,7 @@ import (
 func (k msgServer) Unpause(goCtx context.Context, msg *types.MsgUnpause) (*types.MsgUnpauseResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	pauser, found := k.GetPauser(ctx)
+	pauser, found := k.GetPauser(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pauser is not set")
 	}
@@ -21,13 +21,27 @@ func (k msgServer) Unpause(goCtx context.Context, msg *types.MsgUnpause) (*types
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pauser")
 	}
 
-	paused := types.Paused{
-		Paused: false,
+	if err := k.requireAdminProposal(ctx, msg.Denom); err != nil {
+		return nil, err
 	}
 
+	paused := k.GetPaused(ctx, msg.Denom)
+	previousOperations := types.FormatPauseOperations(paused.PausedOperations())
+	paused.SetOperations(msg.Operations, false)
+
 	k.SetPaused(ctx, paused)
+	k.recordAudit(ctx, msg.Denom, types.AuditActionUnpause, msg.From, "", previousOperations, types.FormatPauseOperations(paused.PausedOperations()))
+
+	if err := k.afterPauseChange(ctx, msg.Denom, paused); err != nil {
+		return nil, err
+	}
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventUnpaused{
+		Denom:      msg.Denom,
+		Pauser:     msg.From,
+		Operations: msg.Operations,
+		Paused:     paused,
+	})
 
 	return &types.MsgUnpauseResponse{}, err
 }
@@ -12,7 +12,7 @@ import (
 func (k msgServer) UpdateBlacklister(goCtx context.Context, msg *types.MsgUpdateBlacklister) (*types.MsgUpdateBlacklisterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner, found := k.GetOwner(ctx)
+	owner, found := k.GetOwner(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
 	}
@@ -21,19 +21,33 @@ func (k msgServer) UpdateBlacklister(goCtx context.Context, msg *types.MsgUpdate
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
 	}
 
+	if err := k.requireAdminProposal(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
 	// ensure that the specified address is not already assigned to a privileged role
-	err := k.ValidatePrivileges(ctx, msg.Address)
+	err := k.ValidatePrivileges(ctx, msg.Denom, msg.Address)
 	if err != nil {
 		return nil, err
 	}
 
+	previous, _ := k.GetBlacklister(ctx, msg.Denom)
+
 	blacklister := types.Blacklister{
 		Address: msg.Address,
+		Denom:   msg.Denom,
 	}
 
 	k.SetBlacklister(ctx, blacklister)
-
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	k.recordAudit(ctx, msg.Denom, types.AuditActionUpdateBlacklister, msg.From, msg.Address, previous.Address, msg.Address)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRoleUpdated{
+		Denom:           msg.Denom,
+		Role:            types.RoleBlacklister,
+		PreviousAddress: previous.Address,
+		Address:         msg.Address,
+		UpdatedBy:       msg.From,
+	})
 
 	return &types.MsgUpdateBlacklisterResponse{}, err
 }
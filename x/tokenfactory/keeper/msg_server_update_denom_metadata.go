@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) UpdateDenomMetadata(goCtx context.Context, msg *types.MsgUpdateDenomMetadata) (*types.MsgUpdateDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom := msg.Metadata.Base
+
+	if !k.MintingDenomSet(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrDenomNotFound, "%s is not a minting denom", denom)
+	}
+
+	owner, found := k.GetOwner(ctx, denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	previous, _ := k.bankKeeper.GetDenomMetaData(ctx, denom)
+
+	k.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
+	k.recordAudit(ctx, denom, types.AuditActionUpdateDenomMetadata, msg.From, "", previous.String(), msg.Metadata.String())
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventDenomMetadataUpdated{
+		Denom:            denom,
+		PreviousMetadata: previous,
+		Metadata:         msg.Metadata,
+		UpdatedBy:        msg.From,
+	})
+
+	return &types.MsgUpdateDenomMetadataResponse{}, err
+}
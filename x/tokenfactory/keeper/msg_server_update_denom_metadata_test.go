@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestUpdateDenomMetadata(t *testing.T) {
+	bankKeeper := keepertest.NewMockMetadataBankKeeper()
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+	previous := banktypes.Metadata{
+		Description: "test token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: testDenom, Exponent: 0, Aliases: []string{"microtoken"}},
+			{Denom: "token", Exponent: 6, Aliases: []string{"TOKEN"}},
+		},
+		Base:    testDenom,
+		Display: "token",
+	}
+
+	_, err := server.CreateDenom(wctx, types.NewMsgCreateDenom(keepertest.TokenfactoryAuthority, previous, owner))
+	require.NoError(t, err)
+
+	metadata := banktypes.Metadata{
+		Description: "renamed test token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: testDenom, Exponent: 0, Aliases: []string{"microtoken"}},
+			{Denom: "newtoken", Exponent: 6, Aliases: []string{"mnewtoken"}},
+		},
+		Base:    testDenom,
+		Display: "newtoken",
+		Name:    "New Token",
+		Symbol:  "NEW",
+	}
+
+	_, err = server.UpdateDenomMetadata(wctx, types.NewMsgUpdateDenomMetadata(sample.AccAddress(), metadata))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	other := metadata
+	other.Base = "uother"
+	other.DenomUnits = []*banktypes.DenomUnit{{Denom: "uother", Exponent: 0}}
+	other.Display = "uother"
+	_, err = server.UpdateDenomMetadata(wctx, types.NewMsgUpdateDenomMetadata(owner, other))
+	require.ErrorIs(t, err, types.ErrDenomNotFound)
+	_, found := bankKeeper.GetDenomMetaData(ctx, "uother")
+	require.False(t, found)
+
+	_, err = server.UpdateDenomMetadata(wctx, types.NewMsgUpdateDenomMetadata(owner, metadata))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventDenomMetadataUpdated{
+		Denom:            testDenom,
+		PreviousMetadata: previous,
+		Metadata:         metadata,
+		UpdatedBy:        owner,
+	}, lastEvent(t, ctx))
+
+	rst, found := bankKeeper.GetDenomMetaData(ctx, testDenom)
+	require.True(t, found)
+	require.Equal(t, metadata, rst)
+
+	entries := k.GetAllAuditLogEntries(ctx)
+	require.Len(t, entries, 2)
+	require.Equal(t, types.AuditActionUpdateDenomMetadata, entries[1].Action)
+	require.Equal(t, owner, entries[1].Actor)
+	require.Equal(t, previous.String(), entries[1].OldValue)
+	require.Equal(t, metadata.String(), entries[1].NewValue)
+}
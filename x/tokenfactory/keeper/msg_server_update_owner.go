@@ -12,7 +12,7 @@ import (
 func (k msgServer) UpdateOwner(goCtx context.Context, msg *types.MsgUpdateOwner) (*types.MsgUpdateOwnerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner, found := k.GetOwner(ctx)
+	owner, found := k.GetOwner(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
 	}
@@ -21,17 +21,30 @@ func (k msgServer) UpdateOwner(goCtx context.Context, msg *types.MsgUpdateOwner)
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
 	}
 
+	if err := k.requireAdminProposal(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
 	// ensure that the specified address is not already assigned to a privileged role
-	err := k.ValidatePrivileges(ctx, msg.Address)
+	err := k.ValidatePrivileges(ctx, msg.Denom, msg.Address)
 	if err != nil {
 		return nil, err
 	}
 
+	previousPendingOwner, _ := k.GetPendingOwner(ctx, msg.Denom)
+
 	owner.Address = msg.Address
 
 	k.SetPendingOwner(ctx, owner)
-
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	k.recordAudit(ctx, msg.Denom, types.AuditActionUpdateOwner, msg.From, msg.Address, msg.From, msg.Address)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRoleUpdated{
+		Denom:           msg.Denom,
+		Role:            types.RolePendingOwner,
+		PreviousAddress: previousPendingOwner.Address,
+		Address:         msg.Address,
+		UpdatedBy:       msg.From,
+	})
 
 	return &types.MsgUpdateOwnerResponse{}, err
 }
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) WipeBlacklistedBalance(goCtx context.Context, msg *types.MsgWipeBlacklistedBalance) (*types.MsgWipeBlacklistedBalanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	_, addressBz, err := bech32.DecodeAndConvert(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	_, found = k.GetBlacklisted(ctx, msg.Denom, addressBz)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "the specified address is not blacklisted")
+	}
+
+	address := sdk.AccAddress(addressBz)
+
+	// locked vesting coins can not be moved, so only the spendable balance is wiped
+	amount := sdk.NewCoin(msg.Denom, k.bankKeeper.SpendableCoins(ctx, address).AmountOf(msg.Denom))
+	if amount.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrBurn, "the specified address has no %s balance", msg.Denom)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, address, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
+	}
+	k.recordAudit(ctx, msg.Denom, types.AuditActionWipeBlacklistedBalance, msg.From, msg.Address, amount.String(), msg.CaseReference)
+
+	// nothing is left to freeze once the balance is wiped
+	if frozen := k.GetFrozenAmount(ctx, msg.Denom, addressBz); !frozen.Amount.IsZero() {
+		previous := frozen.Amount
+		frozen.Amount = sdk.NewCoin(msg.Denom, sdk.ZeroInt())
+		k.SetFrozenAmount(ctx, frozen)
+		k.recordAudit(ctx, msg.Denom, types.AuditActionUnfreezeAmount, msg.From, msg.Address, previous.String(), frozen.Amount.String())
+	}
+
+	k.recordWiped(ctx, amount)
+
+	if err := k.afterBurn(ctx, msg.From, amount); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventBlacklistedBalanceWiped{
+		Address:       msg.Address,
+		Amount:        amount,
+		CaseReference: msg.CaseReference,
+		Owner:         msg.From,
+	})
+
+	return &types.MsgWipeBlacklistedBalanceResponse{Amount: amount}, err
+}
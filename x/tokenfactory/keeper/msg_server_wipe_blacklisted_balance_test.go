@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestWipeBlacklistedBalance(t *testing.T) {
+	bankKeeper := keepertest.NewMockSupplyBankKeeper()
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	server := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+	blacklisted := sample.AccAddress()
+	blacklistedAcc := sdk.MustAccAddressFromBech32(blacklisted)
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetOwner(ctx, types.Owner{Address: owner, Denom: testDenom})
+
+	coins := sdk.NewCoins(sdk.NewCoin(testDenom, sdk.NewInt(100)), sdk.NewCoin("other", sdk.NewInt(5)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, blacklistedAcc, coins))
+
+	_, err := server.WipeBlacklistedBalance(wctx, types.NewMsgWipeBlacklistedBalance(owner, blacklisted, testDenom, "case-1"))
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklistedAcc, Denom: testDenom})
+	k.SetFrozenAmount(ctx, types.FrozenAmount{AddressBz: blacklistedAcc, Amount: sdk.NewCoin(testDenom, sdk.NewInt(40))})
+
+	_, err = server.WipeBlacklistedBalance(wctx, types.NewMsgWipeBlacklistedBalance(blacklisted, blacklisted, testDenom, "case-1"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	resp, err := server.WipeBlacklistedBalance(wctx, types.NewMsgWipeBlacklistedBalance(owner, blacklisted, testDenom, "case-1"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(testDenom, sdk.NewInt(100)), resp.Amount)
+
+	require.True(t, bankKeeper.SpendableCoins(ctx, blacklistedAcc).AmountOf(testDenom).IsZero())
+	require.Equal(t, sdk.NewInt(5), bankKeeper.SpendableCoins(ctx, blacklistedAcc).AmountOf("other"))
+	require.True(t, bankKeeper.GetSupply(ctx, testDenom).IsZero())
+	require.True(t, k.GetFrozenAmount(ctx, testDenom, blacklistedAcc).Amount.IsZero())
+	require.True(t, k.GetTotalFrozen(ctx, testDenom).IsZero())
+	// the wipe is only counted across all minters, as neither the owner nor the holder burned it as a minter
+	require.Equal(t, sdk.NewCoin(testDenom, sdk.NewInt(100)), k.GetMintStats(ctx, testDenom, "").Burned)
+	require.True(t, k.GetMintStats(ctx, testDenom, owner).Burned.IsZero())
+	require.True(t, k.GetMintStats(ctx, testDenom, blacklisted).Burned.IsZero())
+	require.Len(t, k.GetAllMintStats(ctx), 1)
+
+	events := ctx.EventManager().ABCIEvents()
+	event, err := sdk.ParseTypedEvent(events[len(events)-1])
+	require.NoError(t, err)
+	require.Equal(t, &types.EventBlacklistedBalanceWiped{
+		Address:       blacklisted,
+		Amount:        sdk.NewCoin(testDenom, sdk.NewInt(100)),
+		CaseReference: "case-1",
+		Owner:         owner,
+	}, event)
+
+	// there is nothing left to wipe
+	_, err = server.WipeBlacklistedBalance(wctx, types.NewMsgWipeBlacklistedBalance(owner, blacklisted, testDenom, "case-1"))
+	require.ErrorIs(t, err, types.ErrBurn)
+}
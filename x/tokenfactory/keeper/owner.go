@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,14 +11,14 @@ import (
 func (k Keeper) SetOwner(ctx sdk.Context, owner types.Owner) {
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshal(&owner)
-	store.Set(types.KeyPrefix(types.OwnerKey), b)
+	store.Set(types.DenomPrefix(types.OwnerKey, owner.Denom), b)
 }
 
 // GetOwner returns owner
-func (k Keeper) GetOwner(ctx sdk.Context) (val types.Owner, found bool) {
+func (k Keeper) GetOwner(ctx sdk.Context, denom string) (val types.Owner, found bool) {
 	store := ctx.KVStore(k.storeKey)
 
-	b := store.Get(types.KeyPrefix(types.OwnerKey))
+	b := store.Get(types.DenomPrefix(types.OwnerKey, denom))
 	if b == nil {
 		return val, false
 	}
@@ -26,24 +27,40 @@ func (k Keeper) GetOwner(ctx sdk.Context) (val types.Owner, found bool) {
 	return val, true
 }
 
+// GetAllOwners returns the owner of every denom
+func (k Keeper) GetAllOwners(ctx sdk.Context) (list []types.Owner) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OwnerKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Owner
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
 // SetPendingOwner set pending owner in the store
 func (k Keeper) SetPendingOwner(ctx sdk.Context, owner types.Owner) {
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshal(&owner)
-	store.Set(types.KeyPrefix(types.PendingOwnerKey), b)
+	store.Set(types.DenomPrefix(types.PendingOwnerKey, owner.Denom), b)
 }
 
 // DeletePendingOwner deletes the pending owner in the store
-func (k Keeper) DeletePendingOwner(ctx sdk.Context) {
+func (k Keeper) DeletePendingOwner(ctx sdk.Context, denom string) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.KeyPrefix(types.PendingOwnerKey))
+	store.Delete(types.DenomPrefix(types.PendingOwnerKey, denom))
 }
 
 // GetPendingOwner returns pending owner
-func (k Keeper) GetPendingOwner(ctx sdk.Context) (val types.Owner, found bool) {
+func (k Keeper) GetPendingOwner(ctx sdk.Context, denom string) (val types.Owner, found bool) {
 	store := ctx.KVStore(k.storeKey)
 
-	b := store.Get(types.KeyPrefix(types.PendingOwnerKey))
+	b := store.Get(types.DenomPrefix(types.PendingOwnerKey, denom))
 	if b == nil {
 		return val, false
 	}
@@ -14,21 +14,21 @@ func TestOwnerGet(t *testing.T) {
 
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 
-	owner := types.Owner{Address: "1"}
+	owner := types.Owner{Address: "1", Denom: testDenom}
 	keeper.SetOwner(ctx, owner)
 
-	rst, found := keeper.GetOwner(ctx)
+	rst, found := keeper.GetOwner(ctx, testDenom)
 	require.True(t, found)
 	require.Equal(t,
 		owner,
 		nullify.Fill(&rst),
 	)
 
-	newOwner := types.Owner{Address: "2"}
+	newOwner := types.Owner{Address: "2", Denom: testDenom}
 
 	keeper.SetPendingOwner(ctx, newOwner)
 
-	rst, found = keeper.GetPendingOwner(ctx)
+	rst, found = keeper.GetPendingOwner(ctx, testDenom)
 	require.True(t, found)
 	require.Equal(t,
 		newOwner,
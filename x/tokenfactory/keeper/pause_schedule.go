@@ -0,0 +1,335 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// GetPauseScheduleCount returns the number of pause schedules ever created, which is the id of the next one
+func (k Keeper) GetPauseScheduleCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(types.KeyPrefix(types.PauseScheduleCountKey))
+	if b == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(b)
+}
+
+// SetPauseScheduleCount sets the number of pause schedules ever created
+func (k Keeper) SetPauseScheduleCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.PauseScheduleCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// AppendPauseSchedule stores a new pause schedule under the next id and returns that id
+func (k Keeper) AppendPauseSchedule(ctx sdk.Context, schedule types.PauseSchedule) uint64 {
+	count := k.GetPauseScheduleCount(ctx)
+
+	schedule.Id = count
+	k.SetPauseSchedule(ctx, schedule)
+	k.SetPauseScheduleCount(ctx, count+1)
+
+	return count
+}
+
+// SetPauseSchedule set a specific pauseSchedule in the store from its index, along with its entry in the
+// height or time index
+func (k Keeper) SetPauseSchedule(ctx sdk.Context, schedule types.PauseSchedule) {
+	if existing, found := k.GetPauseSchedule(ctx, schedule.Denom, schedule.Id); found {
+		k.removePauseScheduleIndex(ctx, existing)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PauseScheduleKeyPrefix))
+	b := k.cdc.MustMarshal(&schedule)
+	key := types.PauseScheduleKey(schedule.Denom, schedule.Id)
+	store.Set(key, b)
+
+	indexStore, indexKey := k.pauseScheduleIndex(ctx, schedule)
+	indexStore.Set(indexKey, key)
+}
+
+// GetPauseSchedule returns a pauseSchedule from its index
+func (k Keeper) GetPauseSchedule(ctx sdk.Context, denom string, id uint64) (val types.PauseSchedule, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PauseScheduleKeyPrefix))
+
+	b := store.Get(types.PauseScheduleKey(denom, id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// DeletePauseSchedule removes a pauseSchedule, along with its entry in the height or time index, from the store
+func (k Keeper) DeletePauseSchedule(ctx sdk.Context, denom string, id uint64) {
+	if existing, found := k.GetPauseSchedule(ctx, denom, id); found {
+		k.removePauseScheduleIndex(ctx, existing)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PauseScheduleKeyPrefix))
+	store.Delete(types.PauseScheduleKey(denom, id))
+}
+
+// pauseScheduleIndex returns the index store and key a schedule is indexed under, which is the start
+// of its window while it is pending and the end of its window once it is active.
+func (k Keeper) pauseScheduleIndex(ctx sdk.Context, schedule types.PauseSchedule) (sdk.KVStore, []byte) {
+	height, t := schedule.StartHeight, schedule.StartTime
+	if schedule.Active {
+		height, t = schedule.EndHeight, schedule.EndTime
+	}
+
+	if t != nil {
+		return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PauseScheduleByTimeKeyPrefix)),
+			types.PauseScheduleByTimeKey(*t, schedule.Denom, schedule.Id)
+	}
+
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PauseScheduleByHeightKeyPrefix)),
+		types.PauseScheduleByHeightKey(height, schedule.Denom, schedule.Id)
+}
+
+func (k Keeper) removePauseScheduleIndex(ctx sdk.Context, schedule types.PauseSchedule) {
+	indexStore, indexKey := k.pauseScheduleIndex(ctx, schedule)
+	indexStore.Delete(indexKey)
+}
+
+// getPauseSchedulesOfDenom returns all pauseSchedule of a denom
+func (k Keeper) getPauseSchedulesOfDenom(ctx sdk.Context, denom string) (list []types.PauseSchedule) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PauseScheduleKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomKey(denom))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PauseSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllPauseSchedules returns all pauseSchedule
+func (k Keeper) GetAllPauseSchedules(ctx sdk.Context) (list []types.PauseSchedule) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PauseScheduleKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PauseSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ApplyPauseSchedules pauses the operations of every schedule whose window has started and unpauses
+// those of every schedule whose window has ended. It is called at the end of every block, and only
+// iterates the schedules that are due. A schedule that fails to apply is retried in the next block,
+// without affecting the others.
+func (k Keeper) ApplyPauseSchedules(ctx sdk.Context) error {
+	var keys [][]byte
+
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PauseScheduleByHeightKeyPrefix))
+	iterator := heightStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())+1))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Value())
+	}
+	iterator.Close()
+
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PauseScheduleByTimeKeyPrefix))
+	iterator = timeStore.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Value())
+	}
+	iterator.Close()
+
+	var errs []error
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PauseScheduleKeyPrefix))
+	for _, key := range keys {
+		var schedule types.PauseSchedule
+		k.cdc.MustUnmarshal(store.Get(key), &schedule)
+
+		if err := runCached(ctx, func(ctx sdk.Context) error {
+			return k.applyPauseSchedule(ctx, schedule)
+		}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (k Keeper) applyPauseSchedule(ctx sdk.Context, schedule types.PauseSchedule) error {
+	height, blockTime := ctx.BlockHeight(), ctx.BlockTime()
+
+	if !schedule.Active && schedule.StartReached(height, blockTime) {
+		if err := k.startPauseSchedule(ctx, schedule); err != nil {
+			return err
+		}
+		schedule.Active = true
+	}
+
+	if schedule.Active && schedule.EndReached(height, blockTime) {
+		return k.endPauseSchedule(ctx, schedule)
+	}
+
+	return nil
+}
+
+// startPauseSchedule pauses the operations of a schedule and marks it as active. The schedule keeps
+// track of the operations it paused, so that it does not unpause those that were already paused.
+func (k Keeper) startPauseSchedule(ctx sdk.Context, schedule types.PauseSchedule) error {
+	paused := k.GetPaused(ctx, schedule.Denom)
+	previousOperations := types.FormatPauseOperations(paused.PausedOperations())
+
+	schedule.PausedOperations = nil
+	for _, op := range pauseScheduleOperations(schedule) {
+		if !paused.IsPaused(op) {
+			schedule.PausedOperations = append(schedule.PausedOperations, op)
+		}
+	}
+
+	if len(schedule.PausedOperations) > 0 {
+		paused.SetOperations(schedule.PausedOperations, true)
+		k.SetPaused(ctx, paused)
+		k.recordAudit(ctx, schedule.Denom, types.AuditActionPause, moduleActor(), "", previousOperations, types.FormatPauseOperations(paused.PausedOperations()))
+
+		if err := k.afterPauseChange(ctx, schedule.Denom, paused); err != nil {
+			return err
+		}
+	}
+
+	schedule.Active = true
+	k.SetPauseSchedule(ctx, schedule)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPauseScheduleStarted{
+		Denom:      schedule.Denom,
+		Id:         schedule.Id,
+		Operations: schedule.PausedOperations,
+	})
+}
+
+// endPauseSchedule removes an active schedule and unpauses the operations it paused. An operation
+// that another active schedule of the same denom also covers is handed over to that schedule and
+// stays paused until it ends. While the denom has an admin quorum, schedules can only be created by
+// an approved admin proposal, so the quorum has approved the end of the window up front.
+func (k Keeper) endPauseSchedule(ctx sdk.Context, schedule types.PauseSchedule) error {
+	k.DeletePauseSchedule(ctx, schedule.Denom, schedule.Id)
+
+	var operations []types.PauseOperation
+	for _, op := range schedule.PausedOperations {
+		if !k.handOverPauseOperation(ctx, schedule.Denom, op) {
+			operations = append(operations, op)
+		}
+	}
+
+	if len(operations) > 0 {
+		paused := k.GetPaused(ctx, schedule.Denom)
+		previousOperations := types.FormatPauseOperations(paused.PausedOperations())
+		paused.SetOperations(operations, false)
+		k.SetPaused(ctx, paused)
+		k.recordAudit(ctx, schedule.Denom, types.AuditActionUnpause, moduleActor(), "", previousOperations, types.FormatPauseOperations(paused.PausedOperations()))
+
+		if err := k.afterPauseChange(ctx, schedule.Denom, paused); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPauseScheduleEnded{
+		Denom:      schedule.Denom,
+		Id:         schedule.Id,
+		Operations: operations,
+	})
+}
+
+// handOverPauseOperation makes the first other active schedule of a denom that covers an operation
+// responsible for unpausing it, and returns false if there is none.
+func (k Keeper) handOverPauseOperation(ctx sdk.Context, denom string, op types.PauseOperation) bool {
+	for _, other := range k.getPauseSchedulesOfDenom(ctx, denom) {
+		if !other.Active || !containsPauseOperation(pauseScheduleOperations(other), op) {
+			continue
+		}
+
+		if !containsPauseOperation(other.PausedOperations, op) {
+			other.PausedOperations = append(other.PausedOperations, op)
+			k.SetPauseSchedule(ctx, other)
+		}
+		return true
+	}
+
+	return false
+}
+
+// releasePauseOperations stops the active schedules of a denom from unpausing operations that have
+// been paused explicitly, so that they stay paused once the schedules end.
+func (k Keeper) releasePauseOperations(ctx sdk.Context, denom string, ops []types.PauseOperation) {
+	if len(ops) == 0 {
+		ops = types.AllPauseOperations
+	}
+
+	for _, schedule := range k.getPauseSchedulesOfDenom(ctx, denom) {
+		if !schedule.Active {
+			continue
+		}
+
+		var remaining []types.PauseOperation
+		for _, op := range schedule.PausedOperations {
+			if !containsPauseOperation(ops, op) {
+				remaining = append(remaining, op)
+			}
+		}
+
+		if len(remaining) != len(schedule.PausedOperations) {
+			schedule.PausedOperations = remaining
+			k.SetPauseSchedule(ctx, schedule)
+		}
+	}
+}
+
+func containsPauseOperation(ops []types.PauseOperation, op types.PauseOperation) bool {
+	for _, o := range ops {
+		if o == op {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pauseScheduleOperations returns the operations paused by a schedule, which are all operations if
+// the schedule does not list any.
+func pauseScheduleOperations(schedule types.PauseSchedule) []types.PauseOperation {
+	if len(schedule.Operations) == 0 {
+		return types.AllPauseOperations
+	}
+
+	return schedule.Operations
+}
+
+// formatPauseSchedule returns the operations and window of a pause schedule for the audit log.
+func formatPauseSchedule(schedule types.PauseSchedule) string {
+	formatBound := func(height int64, t *time.Time) string {
+		if t != nil {
+			return t.UTC().Format(time.RFC3339)
+		}
+		return fmt.Sprintf("height %d", height)
+	}
+
+	return fmt.Sprintf(
+		"%s from %s to %s",
+		types.FormatPauseOperations(pauseScheduleOperations(schedule)),
+		formatBound(schedule.StartHeight, schedule.StartTime),
+		formatBound(schedule.EndHeight, schedule.EndTime),
+	)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,14 +11,14 @@ import (
 func (k Keeper) SetPaused(ctx sdk.Context, paused types.Paused) {
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshal(&paused)
-	store.Set(types.KeyPrefix(types.PausedKey), b)
+	store.Set(types.DenomPrefix(types.PausedKey, paused.Denom), b)
 }
 
 // GetPaused returns paused
-func (k Keeper) GetPaused(ctx sdk.Context) (val types.Paused) {
+func (k Keeper) GetPaused(ctx sdk.Context, denom string) (val types.Paused) {
 	store := ctx.KVStore(k.storeKey)
 
-	b := store.Get(types.KeyPrefix(types.PausedKey))
+	b := store.Get(types.DenomPrefix(types.PausedKey, denom))
 	if b == nil {
 		panic("Paused state is not set")
 	}
@@ -25,3 +26,19 @@ func (k Keeper) GetPaused(ctx sdk.Context) (val types.Paused) {
 	k.cdc.MustUnmarshal(b, &val)
 	return val
 }
+
+// GetAllPaused returns the paused state of every denom
+func (k Keeper) GetAllPaused(ctx sdk.Context) (list []types.Paused) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PausedKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Paused
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
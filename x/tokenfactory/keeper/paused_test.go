@@ -13,7 +13,7 @@ import (
 )
 
 func createTestPaused(keeper *keeper.Keeper, ctx sdk.Context) types.Paused {
-	item := types.Paused{}
+	item := types.Paused{Denom: testDenom}
 	keeper.SetPaused(ctx, item)
 	return item
 }
@@ -21,7 +21,7 @@ func createTestPaused(keeper *keeper.Keeper, ctx sdk.Context) types.Paused {
 func TestPausedGet(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	item := createTestPaused(keeper, ctx)
-	rst := keeper.GetPaused(ctx)
+	rst := keeper.GetPaused(ctx, testDenom)
 	require.Equal(t,
 		nullify.Fill(&item),
 		nullify.Fill(&rst),
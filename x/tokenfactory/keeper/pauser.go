@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,14 +11,14 @@ import (
 func (k Keeper) SetPauser(ctx sdk.Context, pauser types.Pauser) {
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshal(&pauser)
-	store.Set(types.KeyPrefix(types.PauserKey), b)
+	store.Set(types.DenomPrefix(types.PauserKey, pauser.Denom), b)
 }
 
 // GetPauser returns pauser
-func (k Keeper) GetPauser(ctx sdk.Context) (val types.Pauser, found bool) {
+func (k Keeper) GetPauser(ctx sdk.Context, denom string) (val types.Pauser, found bool) {
 	store := ctx.KVStore(k.storeKey)
 
-	b := store.Get(types.KeyPrefix(types.PauserKey))
+	b := store.Get(types.DenomPrefix(types.PauserKey, denom))
 	if b == nil {
 		return val, false
 	}
@@ -25,3 +26,19 @@ func (k Keeper) GetPauser(ctx sdk.Context) (val types.Pauser, found bool) {
 	k.cdc.MustUnmarshal(b, &val)
 	return val, true
 }
+
+// GetAllPausers returns the pauser of every denom
+func (k Keeper) GetAllPausers(ctx sdk.Context) (list []types.Pauser) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PauserKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Pauser
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
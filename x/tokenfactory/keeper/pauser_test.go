@@ -13,7 +13,7 @@ import (
 )
 
 func createTestPauser(keeper *keeper.Keeper, ctx sdk.Context) types.Pauser {
-	item := types.Pauser{}
+	item := types.Pauser{Denom: testDenom}
 	keeper.SetPauser(ctx, item)
 	return item
 }
@@ -21,7 +21,7 @@ func createTestPauser(keeper *keeper.Keeper, ctx sdk.Context) types.Pauser {
 func TestPauserGet(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	item := createTestPauser(keeper, ctx)
-	rst, found := keeper.GetPauser(ctx)
+	rst, found := keeper.GetPauser(ctx, testDenom)
 	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&item),
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// SetProcessedReference set a specific processedReference in the store from its index
+func (k Keeper) SetProcessedReference(ctx sdk.Context, reference types.ProcessedReference) {
+	if existing, found := k.GetProcessedReference(ctx, reference.Denom, reference.Address, reference.Operation, reference.ReferenceID); found {
+		k.removeProcessedReferenceByTime(ctx, existing)
+	}
+
+	key := types.ProcessedReferenceKey(reference.Denom, reference.Address, reference.Operation, reference.ReferenceID)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProcessedReferenceKeyPrefix))
+	b := k.cdc.MustMarshal(&reference)
+	store.Set(key, b)
+
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProcessedReferenceByTimeKeyPrefix))
+	timeStore.Set(types.ProcessedReferenceByTimeKey(reference.Time, reference.Denom, reference.Address, reference.Operation, reference.ReferenceID), key)
+}
+
+// GetProcessedReference returns a processedReference from its index
+func (k Keeper) GetProcessedReference(ctx sdk.Context, denom string, address string, operation types.ReferenceOperation, referenceID string) (val types.ProcessedReference, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProcessedReferenceKeyPrefix))
+
+	b := store.Get(types.ProcessedReferenceKey(denom, address, operation, referenceID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveProcessedReference removes a processedReference from the store
+func (k Keeper) RemoveProcessedReference(ctx sdk.Context, denom string, address string, operation types.ReferenceOperation, referenceID string) {
+	existing, found := k.GetProcessedReference(ctx, denom, address, operation, referenceID)
+	if !found {
+		return
+	}
+
+	k.removeProcessedReferenceByTime(ctx, existing)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProcessedReferenceKeyPrefix))
+	store.Delete(types.ProcessedReferenceKey(denom, address, operation, referenceID))
+}
+
+func (k Keeper) removeProcessedReferenceByTime(ctx sdk.Context, reference types.ProcessedReference) {
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProcessedReferenceByTimeKeyPrefix))
+	timeStore.Delete(types.ProcessedReferenceByTimeKey(reference.Time, reference.Denom, reference.Address, reference.Operation, reference.ReferenceID))
+}
+
+// GetAllProcessedReferences returns all processedReference
+func (k Keeper) GetAllProcessedReferences(ctx sdk.Context) (list []types.ProcessedReference) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProcessedReferenceKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ProcessedReference
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// PruneProcessedReferences deletes the processed references that are older than the reference id
+// retention param, after which the reference ids can be used again. It is called at the end of
+// every block and does nothing if the retention is zero.
+func (k Keeper) PruneProcessedReferences(ctx sdk.Context) {
+	retention := k.GetParams(ctx).ReferenceIDRetention
+	if retention <= 0 {
+		return
+	}
+
+	cutoff := ctx.BlockTime().Add(-retention)
+
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProcessedReferenceByTimeKeyPrefix))
+	iterator := timeStore.Iterator(nil, sdk.FormatTimeBytes(cutoff))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Value())
+	}
+	iterator.Close()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProcessedReferenceKeyPrefix))
+	for _, key := range keys {
+		var reference types.ProcessedReference
+		k.cdc.MustUnmarshal(store.Get(key), &reference)
+
+		k.RemoveProcessedReference(ctx, reference.Denom, reference.Address, reference.Operation, reference.ReferenceID)
+	}
+}
+
+// checkReference rejects a mint or burn whose reference id the minter has already used for the same operation.
+func (k Keeper) checkReference(ctx sdk.Context, denom string, address string, operation types.ReferenceOperation, referenceID string) error {
+	if referenceID == "" {
+		return nil
+	}
+
+	if reference, found := k.GetProcessedReference(ctx, denom, address, operation, referenceID); found {
+		return sdkerrors.Wrapf(types.ErrDuplicateReference, "reference id %s was processed at height %d", referenceID, reference.Height)
+	}
+
+	return nil
+}
+
+// recordReference stores the reference id of a processed mint or burn with the current block height and time.
+func (k Keeper) recordReference(ctx sdk.Context, denom string, address string, operation types.ReferenceOperation, referenceID string) {
+	if referenceID == "" {
+		return
+	}
+
+	k.SetProcessedReference(ctx, types.ProcessedReference{
+		Denom:       denom,
+		Address:     address,
+		ReferenceID: referenceID,
+		Height:      ctx.BlockHeight(),
+		Time:        ctx.BlockTime(),
+		Operation:   operation,
+	})
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// createNProcessedReferences stores n references, each processed one block and one hour after the previous one.
+func createNProcessedReferences(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.ProcessedReference {
+	items := make([]types.ProcessedReference, n)
+	for i := range items {
+		items[i] = types.ProcessedReference{
+			Denom:       testDenom,
+			Address:     sample.AccAddress(),
+			ReferenceID: fmt.Sprintf("deposit-%d", i),
+			Height:      int64(i + 1),
+			Time:        time.Unix(0, 0).UTC().Add(time.Duration(i) * time.Hour),
+			Operation:   types.ReferenceMint,
+		}
+		keeper.SetProcessedReference(ctx, items[i])
+	}
+	return items
+}
+
+func TestProcessedReferenceGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNProcessedReferences(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetProcessedReference(ctx, item.Denom, item.Address, item.Operation, item.ReferenceID)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestProcessedReferenceRemove(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNProcessedReferences(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveProcessedReference(ctx, item.Denom, item.Address, item.Operation, item.ReferenceID)
+		_, found := keeper.GetProcessedReference(ctx, item.Denom, item.Address, item.Operation, item.ReferenceID)
+		require.False(t, found)
+	}
+}
+
+func TestProcessedReferenceGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNProcessedReferences(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllProcessedReferences(ctx)),
+	)
+}
+
+func TestPruneProcessedReferences(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNProcessedReferences(keeper, ctx, 5)
+
+	// references processed more than the default retention before the block time are pruned
+	ctx = ctx.WithBlockTime(items[2].Time.Add(types.DefaultReferenceIDRetention))
+	keeper.PruneProcessedReferences(ctx)
+	require.ElementsMatch(t,
+		nullify.Fill(items[2:]),
+		nullify.Fill(keeper.GetAllProcessedReferences(ctx)),
+	)
+
+	// without a retention nothing is pruned
+	keeper.SetParams(ctx, types.NewParams(types.DefaultAuditLogRetention, 0, types.DefaultRedemptionTimeout, types.DefaultMaxAttestationAge, types.DefaultMinRedemptionAmount, types.DefaultRedemptionRetention))
+	ctx = ctx.WithBlockTime(items[4].Time.Add(365 * 24 * time.Hour))
+	keeper.PruneProcessedReferences(ctx)
+	require.Len(t, keeper.GetAllProcessedReferences(ctx), 3)
+}
+
+func TestMintBurnReferenceID(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, keepertest.NewMockSupplyBankKeeper())
+	server := keeper.NewMsgServerImpl(k)
+
+	minter := sample.AccAddress()
+	otherMinter := sample.AccAddress()
+	coin := sdk.NewCoin(testDenom, sdk.NewInt(10))
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	k.SetPaused(ctx, types.Paused{Paused: false, Denom: testDenom})
+	k.SetMinters(ctx, types.Minters{Address: minter, Allowance: sdk.NewCoin(testDenom, sdk.NewInt(1000)), Denom: testDenom})
+	k.SetMinters(ctx, types.Minters{Address: otherMinter, Allowance: sdk.NewCoin(testDenom, sdk.NewInt(1000)), Denom: testDenom})
+
+	ctx = ctx.WithBlockHeight(7).WithBlockTime(time.Unix(0, 0).UTC())
+
+	mint := func(ctx sdk.Context, from string, referenceID string) error {
+		msg := types.NewMsgMint(from, from, coin)
+		msg.ReferenceID = referenceID
+		_, err := server.Mint(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+	burn := func(ctx sdk.Context, from string, referenceID string) error {
+		msg := types.NewMsgBurn(from, coin)
+		msg.ReferenceID = referenceID
+		_, err := server.Burn(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	// mints without a reference id are never deduplicated
+	require.NoError(t, mint(ctx, minter, ""))
+	require.NoError(t, mint(ctx, minter, ""))
+	require.Empty(t, k.GetAllProcessedReferences(ctx))
+
+	require.NoError(t, mint(ctx, minter, "deposit-1"))
+	event, ok := lastEvent(t, ctx).(*types.EventMinted)
+	require.True(t, ok)
+	require.Equal(t, "deposit-1", event.ReferenceID)
+
+	resp, err := k.ProcessedReference(sdk.WrapSDKContext(ctx), &types.QueryGetProcessedReferenceRequest{
+		Denom:       testDenom,
+		Address:     minter,
+		Operation:   types.ReferenceMint,
+		ReferenceId: "deposit-1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(7), resp.ProcessedReference.Height)
+
+	// a retry is rejected and does not mint
+	cacheCtx, _ := ctx.CacheContext()
+	require.ErrorIs(t, mint(cacheCtx.WithBlockHeight(8), minter, "deposit-1"), types.ErrDuplicateReference)
+	minters, _ := k.GetMinters(ctx, testDenom, minter)
+	require.Equal(t, sdk.NewInt(970), minters.Allowance.Amount)
+
+	// reference ids are per minter and per operation, so a burn can reuse the reference id of a mint
+	require.NoError(t, mint(ctx, otherMinter, "deposit-1"))
+	require.NoError(t, burn(ctx, minter, "deposit-1"))
+	_, found := k.GetProcessedReference(ctx, testDenom, minter, types.ReferenceBurn, "deposit-1")
+	require.True(t, found)
+
+	require.NoError(t, burn(ctx, minter, "redemption-1"))
+	burned, ok := lastEvent(t, ctx).(*types.EventBurned)
+	require.True(t, ok)
+	require.Equal(t, "redemption-1", burned.ReferenceID)
+	cacheCtx, _ = ctx.CacheContext()
+	require.ErrorIs(t, burn(cacheCtx, minter, "redemption-1"), types.ErrDuplicateReference)
+
+	// a failed mint does not consume its reference id
+	cacheCtx, _ = ctx.CacheContext()
+	msg := types.NewMsgMint(minter, minter, sdk.NewCoin(testDenom, sdk.NewInt(10000)))
+	msg.ReferenceID = "deposit-2"
+	_, err = server.Mint(sdk.WrapSDKContext(cacheCtx), msg)
+	require.ErrorIs(t, err, types.ErrMint)
+	_, found = k.GetProcessedReference(cacheCtx, testDenom, minter, types.ReferenceMint, "deposit-2")
+	require.False(t, found)
+
+	// once pruned the reference id can be used again
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultReferenceIDRetention + time.Second))
+	k.PruneProcessedReferences(ctx)
+	require.NoError(t, mint(ctx, minter, "deposit-1"))
+}
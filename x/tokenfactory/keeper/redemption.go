@@ -0,0 +1,317 @@
+package keeper
+
+import (
+	"errors"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// GetRedemptionCount returns the number of redemptions ever requested, which is the id of the next one
+func (k Keeper) GetRedemptionCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(types.KeyPrefix(types.RedemptionCountKey))
+	if b == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(b)
+}
+
+// SetRedemptionCount sets the number of redemptions ever requested
+func (k Keeper) SetRedemptionCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.RedemptionCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// AppendRedemption stores a new redemption under the next id and returns that id
+func (k Keeper) AppendRedemption(ctx sdk.Context, redemption types.Redemption) uint64 {
+	count := k.GetRedemptionCount(ctx)
+
+	redemption.Id = count
+	k.SetRedemption(ctx, redemption)
+	k.SetRedemptionCount(ctx, count+1)
+
+	return count
+}
+
+// SetRedemption set a specific redemption in the store from its index, along with its requester
+// and status index entries, its timeout index entry while it is pending and its resolve time index
+// entry once it is resolved
+func (k Keeper) SetRedemption(ctx sdk.Context, redemption types.Redemption) {
+	if existing, found := k.GetRedemption(ctx, redemption.Id); found {
+		k.removeRedemptionIndexes(ctx, existing)
+	}
+
+	key := types.RedemptionKey(redemption.Id)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionKeyPrefix))
+	b := k.cdc.MustMarshal(&redemption)
+	store.Set(key, b)
+
+	requesterStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionRequesterPrefix(redemption.Requester))
+	requesterStore.Set(key, key)
+
+	statusStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionStatusPrefix(redemption.Status))
+	statusStore.Set(key, key)
+
+	if redemption.IsPending() && redemption.Timeout != nil {
+		timeoutStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionTimeoutKeyPrefix))
+		timeoutStore.Set(types.RedemptionTimeoutKey(*redemption.Timeout, redemption.Id), key)
+	}
+
+	if !redemption.IsEscrowed() && redemption.ResolveTime != nil {
+		resolvedStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionResolvedKeyPrefix))
+		resolvedStore.Set(types.RedemptionResolvedKey(*redemption.ResolveTime, redemption.Id), key)
+	}
+}
+
+// DeleteRedemption removes a redemption and its index entries from the store
+func (k Keeper) DeleteRedemption(ctx sdk.Context, id uint64) {
+	redemption, found := k.GetRedemption(ctx, id)
+	if !found {
+		return
+	}
+
+	k.removeRedemptionIndexes(ctx, redemption)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionKeyPrefix))
+	store.Delete(types.RedemptionKey(id))
+}
+
+func (k Keeper) removeRedemptionIndexes(ctx sdk.Context, redemption types.Redemption) {
+	key := types.RedemptionKey(redemption.Id)
+
+	requesterStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionRequesterPrefix(redemption.Requester))
+	requesterStore.Delete(key)
+
+	statusStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionStatusPrefix(redemption.Status))
+	statusStore.Delete(key)
+
+	if redemption.Timeout != nil {
+		timeoutStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionTimeoutKeyPrefix))
+		timeoutStore.Delete(types.RedemptionTimeoutKey(*redemption.Timeout, redemption.Id))
+	}
+
+	if redemption.ResolveTime != nil {
+		resolvedStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionResolvedKeyPrefix))
+		resolvedStore.Delete(types.RedemptionResolvedKey(*redemption.ResolveTime, redemption.Id))
+	}
+}
+
+// GetRedemption returns a redemption from its index
+func (k Keeper) GetRedemption(ctx sdk.Context, id uint64) (val types.Redemption, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionKeyPrefix))
+
+	b := store.Get(types.RedemptionKey(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllRedemptions returns all redemption
+func (k Keeper) GetAllRedemptions(ctx sdk.Context) (list []types.Redemption) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Redemption
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetRedemptionEscrow returns the tokens escrowed in the module account for pending and held redemptions
+func (k Keeper) GetRedemptionEscrow(ctx sdk.Context) sdk.Coins {
+	escrow := sdk.NewCoins()
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionKeyPrefix))
+
+	for _, status := range []types.RedemptionStatus{types.RedemptionStatusPending, types.RedemptionStatusHeld} {
+		statusStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionStatusPrefix(status))
+		iterator := sdk.KVStorePrefixIterator(statusStore, []byte{})
+
+		for ; iterator.Valid(); iterator.Next() {
+			var val types.Redemption
+			k.cdc.MustUnmarshal(store.Get(iterator.Value()), &val)
+			escrow = escrow.Add(val.Amount)
+		}
+		iterator.Close()
+	}
+
+	return escrow
+}
+
+// ExpireRedemptions refunds every pending redemption whose timeout is at or before the current
+// block time. It is called at the end of every block. A redemption that can not be refunded stays
+// pending, and is retried in the next block, without affecting the others.
+func (k Keeper) ExpireRedemptions(ctx sdk.Context) error {
+	timeoutStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionTimeoutKeyPrefix))
+	iterator := timeoutStore.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Value())
+	}
+	iterator.Close()
+
+	var errs []error
+
+	for _, key := range keys {
+		redemption, _ := k.GetRedemption(ctx, sdk.BigEndianToUint64(key))
+
+		if err := runCached(ctx, func(ctx sdk.Context) error {
+			return k.expireRedemption(ctx, redemption)
+		}); err != nil {
+			errs = append(errs, sdkerrors.Wrapf(err, "redemption %d", redemption.Id))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (k Keeper) expireRedemption(ctx sdk.Context, redemption types.Redemption) error {
+	held, err := k.resolveRedemption(ctx, redemption, types.RedemptionStatusExpired)
+	if err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRedemptionExpired{
+		Id:        redemption.Id,
+		Requester: redemption.Requester,
+		Amount:    redemption.Amount,
+		Held:      held,
+	})
+}
+
+// resolveRedemption settles the escrow of a pending redemption, burning the escrowed tokens if it is
+// fulfilled or refunding them to the requester otherwise, and stores it with its new status. If the
+// requester is blacklisted, a refund is not sent and the redemption is held instead, which is reported
+// by the returned bool.
+func (k Keeper) resolveRedemption(ctx sdk.Context, redemption types.Redemption, status types.RedemptionStatus) (bool, error) {
+	amount := sdk.NewCoins(redemption.Amount)
+
+	if status == types.RedemptionStatusFulfilled {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount); err != nil {
+			return false, sdkerrors.Wrap(types.ErrBurn, err.Error())
+		}
+		k.recordBurned(ctx, redemption.Resolver, redemption.Amount)
+
+		if err := k.afterBurn(ctx, redemption.Resolver, redemption.Amount); err != nil {
+			return false, err
+		}
+	} else {
+		_, requesterBz, err := bech32.DecodeAndConvert(redemption.Requester)
+		if err != nil {
+			return false, err
+		}
+
+		if _, found := k.GetBlacklisted(ctx, redemption.Amount.Denom, requesterBz); found {
+			redemption.Status = types.RedemptionStatusHeld
+			k.SetRedemption(ctx, redemption)
+			return true, nil
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.AccAddress(requesterBz), amount); err != nil {
+			return false, sdkerrors.Wrap(types.ErrSendCoinsToAccount, err.Error())
+		}
+	}
+
+	blockTime := ctx.BlockTime()
+	redemption.Status = status
+	redemption.ResolveTime = &blockTime
+	k.SetRedemption(ctx, redemption)
+
+	return false, nil
+}
+
+// releaseHeldRedemptions refunds the held redemptions of a denom requested by an address that has
+// just been unblacklisted. A released redemption is stored as rejected if a minter rejected it, or
+// as expired if it timed out.
+func (k Keeper) releaseHeldRedemptions(ctx sdk.Context, denom string, address string) error {
+	requesterStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionRequesterPrefix(address))
+	iterator := sdk.KVStorePrefixIterator(requesterStore, []byte{})
+
+	var held []types.Redemption
+	for ; iterator.Valid(); iterator.Next() {
+		redemption, _ := k.GetRedemption(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if redemption.Status == types.RedemptionStatusHeld && redemption.Amount.Denom == denom {
+			held = append(held, redemption)
+		}
+	}
+	iterator.Close()
+
+	for _, redemption := range held {
+		status := types.RedemptionStatusExpired
+		if redemption.Resolver != "" {
+			status = types.RedemptionStatusRejected
+		}
+
+		if _, err := k.resolveRedemption(ctx, redemption, status); err != nil {
+			return sdkerrors.Wrapf(err, "redemption %d", redemption.Id)
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventRedemptionReleased{
+			Id:        redemption.Id,
+			Requester: redemption.Requester,
+			Amount:    redemption.Amount,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PruneRedemptions deletes the fulfilled, rejected and expired redemptions that were resolved before
+// the redemption retention param. It is called at the end of every block and does nothing if the
+// retention is zero.
+func (k Keeper) PruneRedemptions(ctx sdk.Context) {
+	retention := k.GetParams(ctx).RedemptionRetention
+	if retention <= 0 {
+		return
+	}
+
+	cutoff := ctx.BlockTime().Add(-retention)
+
+	resolvedStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionResolvedKeyPrefix))
+	iterator := resolvedStore.Iterator(nil, sdk.FormatTimeBytes(cutoff))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Value())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		k.DeleteRedemption(ctx, sdk.BigEndianToUint64(key))
+	}
+}
+
+// getPendingRedemption returns a pending redemption that the sender, a minter of its denom, can resolve.
+func (k Keeper) getPendingRedemption(ctx sdk.Context, from string, id uint64) (types.Redemption, error) {
+	redemption, found := k.GetRedemption(ctx, id)
+	if !found {
+		return redemption, sdkerrors.Wrapf(types.ErrRedemption, "redemption %d does not exist", id)
+	}
+
+	if _, found := k.GetMinters(ctx, redemption.Amount.Denom, from); !found {
+		return redemption, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a minter")
+	}
+
+	if !redemption.IsPending() {
+		return redemption, sdkerrors.Wrapf(types.ErrRedemption, "redemption %d is %s", id, redemption.Status)
+	}
+
+	return redemption, nil
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// createNRedemptions appends n pending redemptions, each timing out one hour after the previous one.
+func createNRedemptions(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Redemption {
+	items := make([]types.Redemption, n)
+	for i := range items {
+		timeout := time.Unix(0, 0).UTC().Add(time.Duration(i+1) * time.Hour)
+		items[i] = types.Redemption{
+			Requester:          sample.AccAddress(),
+			Amount:             sdk.NewInt64Coin(testDenom, int64(i+1)),
+			PayoutInstructions: "iban",
+			Status:             types.RedemptionStatusPending,
+			RequestTime:        time.Unix(0, 0).UTC(),
+			Timeout:            &timeout,
+		}
+		items[i].Id = keeper.AppendRedemption(ctx, items[i])
+	}
+	return items
+}
+
+func TestRedemptionGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNRedemptions(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetRedemption(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+	require.Equal(t, uint64(len(items)), keeper.GetRedemptionCount(ctx))
+}
+
+func TestRedemptionGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNRedemptions(keeper, ctx, 10)
+	require.Equal(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllRedemptions(ctx)),
+	)
+}
+
+func TestRedemptionEscrow(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNRedemptions(keeper, ctx, 4)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 10)), keeper.GetRedemptionEscrow(ctx))
+
+	// only pending redemptions are escrowed
+	items[3].Status = types.RedemptionStatusFulfilled
+	keeper.SetRedemption(ctx, items[3])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 6)), keeper.GetRedemptionEscrow(ctx))
+}
+
+func TestExpireRedemptions(t *testing.T) {
+	bankKeeper := keepertest.NewMockSupplyBankKeeper()
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	items := createNRedemptions(k, ctx, 3)
+
+	escrow := k.GetRedemptionEscrow(ctx)
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, escrow))
+
+	// redemptions without a timeout never expire
+	items = append(items, types.Redemption{
+		Requester:          sample.AccAddress(),
+		Amount:             sdk.NewInt64Coin(testDenom, 1),
+		PayoutInstructions: "iban",
+		Status:             types.RedemptionStatusPending,
+	})
+	items[3].Id = k.AppendRedemption(ctx, items[3])
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(items[3].Amount)))
+
+	// a redemption expires at its timeout
+	ctx = ctx.WithBlockTime(*items[1].Timeout)
+	require.NoError(t, k.ExpireRedemptions(ctx))
+
+	for i, item := range items {
+		got, found := k.GetRedemption(ctx, item.Id)
+		require.True(t, found)
+
+		requester := sdk.MustAccAddressFromBech32(item.Requester)
+		if i < 2 {
+			require.Equal(t, types.RedemptionStatusExpired, got.Status)
+			require.Equal(t, ctx.BlockTime(), *got.ResolveTime)
+			require.Equal(t, item.Amount, bankKeeper.SpendableCoins(ctx, requester)[0])
+		} else {
+			require.True(t, got.IsPending())
+			require.True(t, bankKeeper.SpendableCoins(ctx, requester).IsZero())
+		}
+	}
+
+	event, ok := lastEvent(t, ctx).(*types.EventRedemptionExpired)
+	require.True(t, ok)
+	require.Equal(t, items[1].Id, event.Id)
+
+	_, broken := keeper.ModuleAccountBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestExpireRedemptionsContinuesOnFailure(t *testing.T) {
+	bankKeeper := keepertest.NewMockSupplyBankKeeper()
+	k, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	items := createNRedemptions(k, ctx, 2)
+
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, k.GetRedemptionEscrow(ctx)))
+
+	// the first redemption can not be refunded, which does not prevent the second one from expiring
+	items[0].Requester = "invalid"
+	k.SetRedemption(ctx, items[0])
+
+	ctx = ctx.WithBlockTime(*items[1].Timeout)
+	require.Error(t, k.ExpireRedemptions(ctx))
+
+	got, found := k.GetRedemption(ctx, items[0].Id)
+	require.True(t, found)
+	require.True(t, got.IsPending())
+
+	got, found = k.GetRedemption(ctx, items[1].Id)
+	require.True(t, found)
+	require.Equal(t, types.RedemptionStatusExpired, got.Status)
+	require.Equal(t, items[1].Amount, bankKeeper.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(items[1].Requester))[0])
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// GetReserveAttestationCount returns the number of reserve attestations ever posted, which is the id of the next one
+func (k Keeper) GetReserveAttestationCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(types.KeyPrefix(types.ReserveAttestationCountKey))
+	if b == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(b)
+}
+
+// SetReserveAttestationCount sets the number of reserve attestations ever posted
+func (k Keeper) SetReserveAttestationCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.ReserveAttestationCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// AppendReserveAttestation stores a new reserve attestation under the next id and returns that id
+func (k Keeper) AppendReserveAttestation(ctx sdk.Context, attestation types.ReserveAttestation) uint64 {
+	count := k.GetReserveAttestationCount(ctx)
+
+	attestation.Id = count
+	k.SetReserveAttestation(ctx, attestation)
+	k.SetReserveAttestationCount(ctx, count+1)
+
+	return count
+}
+
+// SetReserveAttestation set a specific reserveAttestation in the store from its index
+func (k Keeper) SetReserveAttestation(ctx sdk.Context, attestation types.ReserveAttestation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReserveAttestationKeyPrefix))
+	b := k.cdc.MustMarshal(&attestation)
+	store.Set(types.ReserveAttestationKey(attestation.Reserves.Denom, attestation.Id), b)
+}
+
+// GetReserveAttestation returns a reserveAttestation from its index
+func (k Keeper) GetReserveAttestation(ctx sdk.Context, denom string, id uint64) (val types.ReserveAttestation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReserveAttestationKeyPrefix))
+
+	b := store.Get(types.ReserveAttestationKey(denom, id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetLatestReserveAttestation returns the reserve attestation of a denom that was posted last
+func (k Keeper) GetLatestReserveAttestation(ctx sdk.Context, denom string) (val types.ReserveAttestation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomPrefix(types.ReserveAttestationKeyPrefix, denom))
+	iterator := sdk.KVStoreReversePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val, true
+}
+
+// GetAllReserveAttestations returns all reserveAttestation
+func (k Keeper) GetAllReserveAttestations(ctx sdk.Context) (list []types.ReserveAttestation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReserveAttestationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ReserveAttestation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IsReserveAttestationStale returns true if an attestation is older than the maximum attestation age.
+func (k Keeper) IsReserveAttestationStale(ctx sdk.Context, attestation types.ReserveAttestation) bool {
+	maxAge := k.GetParams(ctx).MaxAttestationAge
+	return maxAge > 0 && ctx.BlockTime().Sub(attestation.Timestamp) > maxAge
+}
+
+// checkReserves refuses a mint of a denom with an attestor unless its latest reserve attestation
+// is recent enough and covers the supply after the mint.
+func (k Keeper) checkReserves(ctx sdk.Context, amount sdk.Coin) error {
+	if _, found := k.GetAttestor(ctx, amount.Denom); !found {
+		return nil
+	}
+
+	attestation, found := k.GetLatestReserveAttestation(ctx, amount.Denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInsufficientReserves, "no reserves of %s have been attested", amount.Denom)
+	}
+
+	if k.IsReserveAttestationStale(ctx, attestation) {
+		return sdkerrors.Wrapf(
+			types.ErrInsufficientReserves,
+			"latest reserve attestation from %s is older than %s",
+			attestation.Timestamp.Format(time.RFC3339), k.GetParams(ctx).MaxAttestationAge,
+		)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom)
+	if attestation.Reserves.IsLT(supply.Add(amount)) {
+		return sdkerrors.Wrapf(
+			types.ErrInsufficientReserves,
+			"minting %s would bring the supply of %s above the attested reserves of %s",
+			amount, supply, attestation.Reserves,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetSupplyCap set the supplyCap of a denom in the store
+func (k Keeper) SetSupplyCap(ctx sdk.Context, supplyCap types.SupplyCap) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&supplyCap)
+	store.Set(types.DenomPrefix(types.SupplyCapKey, supplyCap.Denom), b)
+}
+
+// GetSupplyCap returns the supplyCap of a denom
+func (k Keeper) GetSupplyCap(ctx sdk.Context, denom string) (val types.SupplyCap, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(types.DenomPrefix(types.SupplyCapKey, denom))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// DeleteSupplyCap removes the supplyCap of a denom from the store
+func (k Keeper) DeleteSupplyCap(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DenomPrefix(types.SupplyCapKey, denom))
+}
+
+// GetAllSupplyCaps returns the supplyCap of every denom
+func (k Keeper) GetAllSupplyCaps(ctx sdk.Context) (list []types.SupplyCap) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SupplyCapKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SupplyCap
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SupplyCapHeadroom returns the amount of a denom that can still be minted before its max supply is reached.
+func SupplyCapHeadroom(supplyCap types.SupplyCap, supply sdk.Coin) sdk.Coin {
+	if !supply.IsLT(supplyCap.MaxSupply) {
+		return sdk.NewCoin(supplyCap.Denom, sdk.ZeroInt())
+	}
+
+	return supplyCap.MaxSupply.Sub(supply)
+}
@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/nullify"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupplyCap(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+
+	_, found := keeper.GetSupplyCap(ctx, testDenom)
+	require.False(t, found)
+
+	items := []types.SupplyCap{
+		{Denom: testDenom, MaxSupply: sdk.NewCoin(testDenom, sdk.NewInt(100))},
+		{Denom: "other", MaxSupply: sdk.NewCoin("other", sdk.NewInt(200))},
+	}
+	for _, item := range items {
+		keeper.SetSupplyCap(ctx, item)
+	}
+
+	rst, found := keeper.GetSupplyCap(ctx, testDenom)
+	require.True(t, found)
+	require.Equal(t, items[0], rst)
+
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllSupplyCaps(ctx)),
+	)
+
+	keeper.DeleteSupplyCap(ctx, testDenom)
+	_, found = keeper.GetSupplyCap(ctx, testDenom)
+	require.False(t, found)
+}
@@ -0,0 +1,139 @@
+package v2
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// MintingDenomKey is the store key the single v1 minting denom was stored under.
+var MintingDenomKey = append(types.KeyPrefix(types.MintingDenomKey), types.KeyPrefix(types.MintingDenomKey)...)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The migration moves the single
+// v1 minting denom and all of its roles, paused state, minters, minter controllers and blacklisted
+// addresses under store keys scoped by that denom.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	bz := store.Get(MintingDenomKey)
+	if bz == nil {
+		// nothing has been minted on this chain yet, there is no state to migrate
+		return nil
+	}
+
+	var mintingDenom types.MintingDenom
+	if err := cdc.Unmarshal(bz, &mintingDenom); err != nil {
+		return err
+	}
+	denom := mintingDenom.Denom
+	if denom == "" {
+		return fmt.Errorf("v1 minting denom is empty")
+	}
+
+	store.Delete(MintingDenomKey)
+	store.Set(types.DenomPrefix(types.MintingDenomKey, denom), bz)
+
+	for _, key := range []string{types.OwnerKey, types.PendingOwnerKey, types.MasterMinterKey, types.PauserKey, types.BlacklisterKey} {
+		if err := migrateRole(store, cdc, key, denom); err != nil {
+			return err
+		}
+	}
+
+	if bz := store.Get(types.KeyPrefix(types.PausedKey)); bz != nil {
+		var paused types.Paused
+		if err := cdc.Unmarshal(bz, &paused); err != nil {
+			return err
+		}
+		paused.Denom = denom
+
+		store.Delete(types.KeyPrefix(types.PausedKey))
+		store.Set(types.DenomPrefix(types.PausedKey, denom), cdc.MustMarshal(&paused))
+	}
+
+	blacklistedStore := prefix.NewStore(store, types.KeyPrefix(types.BlacklistedKeyPrefix))
+	for _, entry := range collect(blacklistedStore) {
+		var blacklisted types.Blacklisted
+		if err := cdc.Unmarshal(entry.value, &blacklisted); err != nil {
+			return err
+		}
+		blacklisted.Denom = denom
+
+		blacklistedStore.Delete(entry.key)
+		blacklistedStore.Set(types.BlacklistedKey(denom, blacklisted.AddressBz), cdc.MustMarshal(&blacklisted))
+	}
+
+	mintersStore := prefix.NewStore(store, types.KeyPrefix(types.MintersKeyPrefix))
+	for _, entry := range collect(mintersStore) {
+		var minters types.Minters
+		if err := cdc.Unmarshal(entry.value, &minters); err != nil {
+			return err
+		}
+		minters.Denom = denom
+
+		mintersStore.Delete(entry.key)
+		mintersStore.Set(types.MintersKey(denom, minters.Address), cdc.MustMarshal(&minters))
+	}
+
+	minterControllerStore := prefix.NewStore(store, types.KeyPrefix(types.MinterControllerKeyPrefix))
+	for _, entry := range collect(minterControllerStore) {
+		var minterController types.MinterController
+		if err := cdc.Unmarshal(entry.value, &minterController); err != nil {
+			return err
+		}
+		minterController.Denom = denom
+
+		minterControllerStore.Delete(entry.key)
+		minterControllerStore.Set(MinterControllerKey(denom, minterController.Controller), cdc.MustMarshal(&minterController))
+	}
+
+	return nil
+}
+
+// migrateRole moves a v1 role singleton stored under key to its denom scoped key.
+func migrateRole(store sdk.KVStore, cdc codec.BinaryCodec, key string, denom string) error {
+	bz := store.Get(types.KeyPrefix(key))
+	if bz == nil {
+		return nil
+	}
+
+	// all role types share the same wire format, so the owner type is used to decode each of them
+	var role types.Owner
+	if err := cdc.Unmarshal(bz, &role); err != nil {
+		return err
+	}
+	role.Denom = denom
+
+	store.Delete(types.KeyPrefix(key))
+	store.Set(types.DenomPrefix(key, denom), cdc.MustMarshal(&role))
+
+	return nil
+}
+
+// MinterControllerKey returns the v2 store key of the MinterController of a controller.
+func MinterControllerKey(denom string, controllerAddress string) []byte {
+	key := append(types.DenomKey(denom), []byte(controllerAddress)...)
+	return append(key, []byte("/")...)
+}
+
+type entry struct {
+	key   []byte
+	value []byte
+}
+
+// collect reads every entry of a store so that it can be rewritten without mutating it during iteration.
+func collect(store sdk.KVStore) []entry {
+	var entries []entry
+
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		entries = append(entries, entry{key: iterator.Key(), value: iterator.Value()})
+	}
+
+	return entries
+}
@@ -0,0 +1,74 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	v2 "github.com/noble-assets/noble/v5/x/tokenfactory/migrations/v2"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(storeKey)
+
+	denom := "ufrienzies"
+	owner := sample.AccAddress()
+	minter := sample.AccAddress()
+	controller := sample.AccAddress()
+	blacklisted := sample.AddressBz()
+
+	// v1 state
+	store.Set(v2.MintingDenomKey, cdc.MustMarshal(&types.MintingDenom{Denom: denom}))
+	store.Set(types.KeyPrefix(types.OwnerKey), cdc.MustMarshal(&types.Owner{Address: owner}))
+	store.Set(types.KeyPrefix(types.PausedKey), cdc.MustMarshal(&types.Paused{Paused: true}))
+	store.Set(append(types.KeyPrefix(types.BlacklistedKeyPrefix), append(blacklisted, '/')...), cdc.MustMarshal(&types.Blacklisted{AddressBz: blacklisted}))
+	store.Set(append(types.KeyPrefix(types.MintersKeyPrefix), []byte(minter+"/")...), cdc.MustMarshal(&types.Minters{Address: minter, Allowance: sdk.NewCoin(denom, sdk.NewInt(10))}))
+	store.Set(append(types.KeyPrefix(types.MinterControllerKeyPrefix), []byte(controller+"/")...), cdc.MustMarshal(&types.MinterController{Minter: minter, Controller: controller}))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+
+	require.False(t, store.Has(v2.MintingDenomKey))
+	require.False(t, store.Has(types.KeyPrefix(types.OwnerKey)))
+	require.False(t, store.Has(types.KeyPrefix(types.PausedKey)))
+
+	var mintingDenom types.MintingDenom
+	cdc.MustUnmarshal(store.Get(types.DenomPrefix(types.MintingDenomKey, denom)), &mintingDenom)
+	require.Equal(t, denom, mintingDenom.Denom)
+
+	var o types.Owner
+	cdc.MustUnmarshal(store.Get(types.DenomPrefix(types.OwnerKey, denom)), &o)
+	require.Equal(t, types.Owner{Address: owner, Denom: denom}, o)
+
+	var paused types.Paused
+	cdc.MustUnmarshal(store.Get(types.DenomPrefix(types.PausedKey, denom)), &paused)
+	require.Equal(t, types.Paused{Paused: true, Denom: denom}, paused)
+
+	var bl types.Blacklisted
+	cdc.MustUnmarshal(store.Get(append(types.KeyPrefix(types.BlacklistedKeyPrefix), types.BlacklistedKey(denom, blacklisted)...)), &bl)
+	require.Equal(t, denom, bl.Denom)
+
+	var m types.Minters
+	cdc.MustUnmarshal(store.Get(append(types.KeyPrefix(types.MintersKeyPrefix), types.MintersKey(denom, minter)...)), &m)
+	require.Equal(t, denom, m.Denom)
+
+	var mc types.MinterController
+	cdc.MustUnmarshal(store.Get(append(types.KeyPrefix(types.MinterControllerKeyPrefix), v2.MinterControllerKey(denom, controller)...)), &mc)
+	require.Equal(t, types.MinterController{Minter: minter, Controller: controller, Denom: denom}, mc)
+}
+
+func TestMigrateStoreEmpty(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+}
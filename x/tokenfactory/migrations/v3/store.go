@@ -0,0 +1,86 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The migration rewrites every v2
+// minter controller, which controlled exactly one minter, as a controller and minter pair and adds
+// it to the index of controllers by minter.
+//
+// v2 allowed several controllers to control the same minter, while v3 allows a single controller per
+// minter. The first of those controllers in store order, which is ordered by controller address, is
+// kept and the others are removed, emitting an EventMinterControllerRemoved for each of them.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	minterControllerStore := prefix.NewStore(store, types.KeyPrefix(types.MinterControllerKeyPrefix))
+	indexStore := prefix.NewStore(store, types.KeyPrefix(types.MinterControllerByMinterKeyPrefix))
+
+	for _, entry := range collect(minterControllerStore) {
+		var minterController types.MinterController
+		if err := cdc.Unmarshal(entry.value, &minterController); err != nil {
+			return err
+		}
+
+		minterControllerStore.Delete(entry.key)
+
+		indexPrefix := types.MinterControllerByMinterPrefix(minterController.Denom, minterController.Minter)
+		if hasPrefix(indexStore, indexPrefix) {
+			err := ctx.EventManager().EmitTypedEvent(&types.EventMinterControllerRemoved{
+				Denom:      minterController.Denom,
+				Controller: minterController.Controller,
+				Minter:     minterController.Minter,
+			})
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		minterControllerStore.Set(types.MinterControllerKey(
+			minterController.Denom,
+			minterController.Controller,
+			minterController.Minter,
+		), entry.value)
+		indexStore.Set(types.MinterControllerByMinterKey(
+			minterController.Denom,
+			minterController.Minter,
+			minterController.Controller,
+		), entry.value)
+	}
+
+	return nil
+}
+
+// hasPrefix returns whether store holds any key starting with keyPrefix.
+func hasPrefix(store sdk.KVStore, keyPrefix []byte) bool {
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+type entry struct {
+	key   []byte
+	value []byte
+}
+
+// collect reads every entry of a store so that it can be rewritten without mutating it during iteration.
+func collect(store sdk.KVStore) []entry {
+	var entries []entry
+
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		entries = append(entries, entry{key: iterator.Key(), value: iterator.Value()})
+	}
+
+	return entries
+}
@@ -0,0 +1,90 @@
+package v3_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	v2 "github.com/noble-assets/noble/v5/x/tokenfactory/migrations/v2"
+	v3 "github.com/noble-assets/noble/v5/x/tokenfactory/migrations/v3"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(storeKey)
+	minterControllerStore := prefix.NewStore(store, types.KeyPrefix(types.MinterControllerKeyPrefix))
+	indexStore := prefix.NewStore(store, types.KeyPrefix(types.MinterControllerByMinterKeyPrefix))
+
+	pairs := []types.MinterController{
+		{Denom: "ufrienzies", Controller: sample.AccAddress(), Minter: sample.AccAddress()},
+		{Denom: "ufrienzies", Controller: sample.AccAddress(), Minter: sample.AccAddress()},
+		{Denom: "uusdc", Controller: sample.AccAddress(), Minter: sample.AccAddress()},
+	}
+
+	// v2 state
+	for _, pair := range pairs {
+		minterControllerStore.Set(v2.MinterControllerKey(pair.Denom, pair.Controller), cdc.MustMarshal(&pair))
+	}
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+	for _, pair := range pairs {
+		require.False(t, minterControllerStore.Has(v2.MinterControllerKey(pair.Denom, pair.Controller)))
+
+		var minterController types.MinterController
+		cdc.MustUnmarshal(minterControllerStore.Get(types.MinterControllerKey(pair.Denom, pair.Controller, pair.Minter)), &minterController)
+		require.Equal(t, pair, minterController)
+
+		var indexed types.MinterController
+		cdc.MustUnmarshal(indexStore.Get(types.MinterControllerByMinterKey(pair.Denom, pair.Minter, pair.Controller)), &indexed)
+		require.Equal(t, pair, indexed)
+	}
+}
+
+func TestMigrateStoreSharedMinter(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(storeKey)
+	minterControllerStore := prefix.NewStore(store, types.KeyPrefix(types.MinterControllerKeyPrefix))
+	indexStore := prefix.NewStore(store, types.KeyPrefix(types.MinterControllerByMinterKeyPrefix))
+
+	minter := sample.AccAddress()
+	controllers := []string{sample.AccAddress(), sample.AccAddress()}
+	sort.Strings(controllers)
+
+	// v2 state, with two controllers of the same minter
+	for _, controller := range controllers {
+		pair := types.MinterController{Denom: "uusdc", Controller: controller, Minter: minter}
+		minterControllerStore.Set(v2.MinterControllerKey(pair.Denom, pair.Controller), cdc.MustMarshal(&pair))
+	}
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+	// the controller that sorts first is kept
+	kept := types.MinterController{Denom: "uusdc", Controller: controllers[0], Minter: minter}
+	var indexed types.MinterController
+	cdc.MustUnmarshal(indexStore.Get(types.MinterControllerByMinterKey("uusdc", minter, controllers[0])), &indexed)
+	require.Equal(t, kept, indexed)
+	require.True(t, minterControllerStore.Has(types.MinterControllerKey("uusdc", controllers[0], minter)))
+
+	require.False(t, minterControllerStore.Has(v2.MinterControllerKey("uusdc", controllers[1])))
+	require.False(t, minterControllerStore.Has(types.MinterControllerKey("uusdc", controllers[1], minter)))
+	require.False(t, indexStore.Has(types.MinterControllerByMinterKey("uusdc", minter, controllers[1])))
+
+	events := ctx.EventManager().ABCIEvents()
+	require.Len(t, events, 1)
+	event, err := sdk.ParseTypedEvent(events[0])
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterControllerRemoved{Denom: "uusdc", Controller: controllers[1], Minter: minter}, event)
+}
@@ -0,0 +1,44 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4. The migration replaces the single
+// paused flag of every denom with per-operation flags, pausing every operation of a paused denom.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.PausedKey))
+
+	var list []types.Paused
+
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var paused types.Paused
+		if err := cdc.Unmarshal(iterator.Value(), &paused); err != nil {
+			iterator.Close()
+			return err
+		}
+		list = append(list, paused)
+	}
+	iterator.Close()
+
+	// the entries are rewritten after iterating so that the store is not mutated during iteration
+	for _, paused := range list {
+		if !paused.Paused {
+			continue
+		}
+
+		paused.SetOperations(types.AllPauseOperations, true)
+		bz, err := cdc.Marshal(&paused)
+		if err != nil {
+			return err
+		}
+		store.Set(types.DenomKey(paused.Denom), bz)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v4 "github.com/noble-assets/noble/v5/x/tokenfactory/migrations/v4"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(storeKey)
+
+	// v3 state
+	store.Set(types.DenomPrefix(types.PausedKey, "ufrienzies"), cdc.MustMarshal(&types.Paused{Paused: true, Denom: "ufrienzies"}))
+	store.Set(types.DenomPrefix(types.PausedKey, "uusdc"), cdc.MustMarshal(&types.Paused{Paused: false, Denom: "uusdc"}))
+
+	require.NoError(t, v4.MigrateStore(ctx, storeKey, cdc))
+
+	var paused types.Paused
+	cdc.MustUnmarshal(store.Get(types.DenomPrefix(types.PausedKey, "ufrienzies")), &paused)
+	require.Equal(t, types.Paused{
+		Paused:      true,
+		Denom:       "ufrienzies",
+		Mint:        true,
+		Burn:        true,
+		Transfer:    true,
+		IBCOutbound: true,
+	}, paused)
+
+	paused = types.Paused{}
+	cdc.MustUnmarshal(store.Get(types.DenomPrefix(types.PausedKey, "uusdc")), &paused)
+	require.Equal(t, types.Paused{Denom: "uusdc"}, paused)
+}
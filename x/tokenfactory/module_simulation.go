@@ -81,6 +81,110 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgRemoveMinterController int = 100
 
+	opWeightMsgIncreaseMinterAllowance = "op_weight_msg_increase_minter_allowance"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgIncreaseMinterAllowance int = 100
+
+	opWeightMsgDecreaseMinterAllowance = "op_weight_msg_decrease_minter_allowance"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDecreaseMinterAllowance int = 100
+
+	opWeightMsgSetMintRateLimit = "op_weight_msg_set_mint_rate_limit"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetMintRateLimit int = 100
+
+	opWeightMsgRemoveMintRateLimit = "op_weight_msg_remove_mint_rate_limit"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRemoveMintRateLimit int = 100
+
+	opWeightMsgSetMaxSupply = "op_weight_msg_set_max_supply"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetMaxSupply int = 100
+
+	opWeightMsgWipeBlacklistedBalance = "op_weight_msg_wipe_blacklisted_balance"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgWipeBlacklistedBalance int = 100
+
+	opWeightMsgSchedulePause = "op_weight_msg_schedule_pause"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSchedulePause int = 100
+
+	opWeightMsgCancelPauseSchedule = "op_weight_msg_cancel_pause_schedule"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCancelPauseSchedule int = 100
+
+	opWeightMsgBlacklistBatch = "op_weight_msg_blacklist_batch"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgBlacklistBatch int = 100
+
+	opWeightMsgUnblacklistBatch = "op_weight_msg_unblacklist_batch"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUnblacklistBatch int = 100
+
+	opWeightMsgSetAdminQuorum = "op_weight_msg_set_admin_quorum"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetAdminQuorum int = 100
+
+	opWeightMsgSubmitAdminProposal = "op_weight_msg_submit_admin_proposal"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSubmitAdminProposal int = 100
+
+	opWeightMsgApprove = "op_weight_msg_approve"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgApprove int = 100
+
+	opWeightMsgReject = "op_weight_msg_reject"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgReject int = 100
+
+	opWeightMsgRequestRedemption = "op_weight_msg_request_redemption"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRequestRedemption int = 100
+
+	opWeightMsgFulfillRedemption = "op_weight_msg_fulfill_redemption"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgFulfillRedemption int = 100
+
+	opWeightMsgRejectRedemption = "op_weight_msg_reject_redemption"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRejectRedemption int = 100
+
+	opWeightMsgUpdateAttestor = "op_weight_msg_update_attestor"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUpdateAttestor int = 100
+
+	opWeightMsgAttestReserves = "op_weight_msg_attest_reserves"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAttestReserves int = 100
+
+	opWeightMsgUpdateDenomMetadata = "op_weight_msg_update_denom_metadata"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUpdateDenomMetadata int = 100
+
+	opWeightMsgUpdateAllowlister = "op_weight_msg_update_allowlister"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUpdateAllowlister int = 100
+
+	opWeightMsgSetAllowlistMode = "op_weight_msg_set_allowlist_mode"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetAllowlistMode int = 100
+
+	opWeightMsgAllow = "op_weight_msg_allow"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAllow int = 100
+
+	opWeightMsgDisallow = "op_weight_msg_disallow"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDisallow int = 100
+
+	opWeightMsgFreezeAmount = "op_weight_msg_freeze_amount"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgFreezeAmount int = 100
+
+	opWeightMsgUnfreezeAmount = "op_weight_msg_unfreeze_amount"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUnfreezeAmount int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -89,7 +193,8 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	// x/tokenfactory
 
 	genesis := types.GenesisState{
-		MintingDenom: &types.MintingDenom{Denom: "ufrienzies"},
+		MintingDenomList: []types.MintingDenom{{Denom: "ufrienzies"}},
+		PausedList:       []types.Paused{{Paused: false, Denom: "ufrienzies"}},
 	}
 
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&genesis)
@@ -300,6 +405,292 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		tokenfactorysimulation.SimulateMsgRemoveMinterController(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgIncreaseMinterAllowance int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgIncreaseMinterAllowance, &weightMsgIncreaseMinterAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgIncreaseMinterAllowance = defaultWeightMsgIncreaseMinterAllowance
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgIncreaseMinterAllowance,
+		tokenfactorysimulation.SimulateMsgIncreaseMinterAllowance(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgDecreaseMinterAllowance int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDecreaseMinterAllowance, &weightMsgDecreaseMinterAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgDecreaseMinterAllowance = defaultWeightMsgDecreaseMinterAllowance
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDecreaseMinterAllowance,
+		tokenfactorysimulation.SimulateMsgDecreaseMinterAllowance(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSetMintRateLimit int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSetMintRateLimit, &weightMsgSetMintRateLimit, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetMintRateLimit = defaultWeightMsgSetMintRateLimit
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetMintRateLimit,
+		tokenfactorysimulation.SimulateMsgSetMintRateLimit(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRemoveMintRateLimit int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgRemoveMintRateLimit, &weightMsgRemoveMintRateLimit, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemoveMintRateLimit = defaultWeightMsgRemoveMintRateLimit
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRemoveMintRateLimit,
+		tokenfactorysimulation.SimulateMsgRemoveMintRateLimit(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSetMaxSupply int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSetMaxSupply, &weightMsgSetMaxSupply, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetMaxSupply = defaultWeightMsgSetMaxSupply
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetMaxSupply,
+		tokenfactorysimulation.SimulateMsgSetMaxSupply(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgWipeBlacklistedBalance int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgWipeBlacklistedBalance, &weightMsgWipeBlacklistedBalance, nil,
+		func(_ *rand.Rand) {
+			weightMsgWipeBlacklistedBalance = defaultWeightMsgWipeBlacklistedBalance
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgWipeBlacklistedBalance,
+		tokenfactorysimulation.SimulateMsgWipeBlacklistedBalance(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSchedulePause int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSchedulePause, &weightMsgSchedulePause, nil,
+		func(_ *rand.Rand) {
+			weightMsgSchedulePause = defaultWeightMsgSchedulePause
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSchedulePause,
+		tokenfactorysimulation.SimulateMsgSchedulePause(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgCancelPauseSchedule int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgCancelPauseSchedule, &weightMsgCancelPauseSchedule, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelPauseSchedule = defaultWeightMsgCancelPauseSchedule
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelPauseSchedule,
+		tokenfactorysimulation.SimulateMsgCancelPauseSchedule(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgBlacklistBatch int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgBlacklistBatch, &weightMsgBlacklistBatch, nil,
+		func(_ *rand.Rand) {
+			weightMsgBlacklistBatch = defaultWeightMsgBlacklistBatch
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgBlacklistBatch,
+		tokenfactorysimulation.SimulateMsgBlacklistBatch(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgUnblacklistBatch int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUnblacklistBatch, &weightMsgUnblacklistBatch, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnblacklistBatch = defaultWeightMsgUnblacklistBatch
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUnblacklistBatch,
+		tokenfactorysimulation.SimulateMsgUnblacklistBatch(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSetAdminQuorum int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSetAdminQuorum, &weightMsgSetAdminQuorum, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAdminQuorum = defaultWeightMsgSetAdminQuorum
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetAdminQuorum,
+		tokenfactorysimulation.SimulateMsgSetAdminQuorum(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSubmitAdminProposal int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSubmitAdminProposal, &weightMsgSubmitAdminProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitAdminProposal = defaultWeightMsgSubmitAdminProposal
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSubmitAdminProposal,
+		tokenfactorysimulation.SimulateMsgSubmitAdminProposal(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgApprove int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgApprove, &weightMsgApprove, nil,
+		func(_ *rand.Rand) {
+			weightMsgApprove = defaultWeightMsgApprove
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgApprove,
+		tokenfactorysimulation.SimulateMsgApprove(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgReject int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgReject, &weightMsgReject, nil,
+		func(_ *rand.Rand) {
+			weightMsgReject = defaultWeightMsgReject
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgReject,
+		tokenfactorysimulation.SimulateMsgReject(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRequestRedemption int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgRequestRedemption, &weightMsgRequestRedemption, nil,
+		func(_ *rand.Rand) {
+			weightMsgRequestRedemption = defaultWeightMsgRequestRedemption
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRequestRedemption,
+		tokenfactorysimulation.SimulateMsgRequestRedemption(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgFulfillRedemption int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgFulfillRedemption, &weightMsgFulfillRedemption, nil,
+		func(_ *rand.Rand) {
+			weightMsgFulfillRedemption = defaultWeightMsgFulfillRedemption
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgFulfillRedemption,
+		tokenfactorysimulation.SimulateMsgFulfillRedemption(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRejectRedemption int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgRejectRedemption, &weightMsgRejectRedemption, nil,
+		func(_ *rand.Rand) {
+			weightMsgRejectRedemption = defaultWeightMsgRejectRedemption
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRejectRedemption,
+		tokenfactorysimulation.SimulateMsgRejectRedemption(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgUpdateAttestor int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUpdateAttestor, &weightMsgUpdateAttestor, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateAttestor = defaultWeightMsgUpdateAttestor
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateAttestor,
+		tokenfactorysimulation.SimulateMsgUpdateAttestor(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAttestReserves int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAttestReserves, &weightMsgAttestReserves, nil,
+		func(_ *rand.Rand) {
+			weightMsgAttestReserves = defaultWeightMsgAttestReserves
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAttestReserves,
+		tokenfactorysimulation.SimulateMsgAttestReserves(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgUpdateDenomMetadata int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUpdateDenomMetadata, &weightMsgUpdateDenomMetadata, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateDenomMetadata = defaultWeightMsgUpdateDenomMetadata
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateDenomMetadata,
+		tokenfactorysimulation.SimulateMsgUpdateDenomMetadata(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgUpdateAllowlister int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUpdateAllowlister, &weightMsgUpdateAllowlister, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateAllowlister = defaultWeightMsgUpdateAllowlister
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateAllowlister,
+		tokenfactorysimulation.SimulateMsgUpdateAllowlister(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSetAllowlistMode int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSetAllowlistMode, &weightMsgSetAllowlistMode, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAllowlistMode = defaultWeightMsgSetAllowlistMode
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetAllowlistMode,
+		tokenfactorysimulation.SimulateMsgSetAllowlistMode(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAllow int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAllow, &weightMsgAllow, nil,
+		func(_ *rand.Rand) {
+			weightMsgAllow = defaultWeightMsgAllow
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAllow,
+		tokenfactorysimulation.SimulateMsgAllow(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgDisallow int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDisallow, &weightMsgDisallow, nil,
+		func(_ *rand.Rand) {
+			weightMsgDisallow = defaultWeightMsgDisallow
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDisallow,
+		tokenfactorysimulation.SimulateMsgDisallow(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgFreezeAmount int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgFreezeAmount, &weightMsgFreezeAmount, nil,
+		func(_ *rand.Rand) {
+			weightMsgFreezeAmount = defaultWeightMsgFreezeAmount
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgFreezeAmount,
+		tokenfactorysimulation.SimulateMsgFreezeAmount(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgUnfreezeAmount int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUnfreezeAmount, &weightMsgUnfreezeAmount, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnfreezeAmount = defaultWeightMsgUnfreezeAmount
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUnfreezeAmount,
+		tokenfactorysimulation.SimulateMsgUnfreezeAmount(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
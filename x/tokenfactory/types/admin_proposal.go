@@ -0,0 +1,159 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AdminMsg is an administrative message of a minting denom.
+type AdminMsg interface {
+	sdk.Msg
+	GetDenom() string
+}
+
+// IsAdminProposalMsg returns true if the message requires the approval of the admin quorum of its
+// denom, when one is set, and can therefore be submitted as an admin proposal.
+func IsAdminProposalMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *MsgUpdateOwner,
+		*MsgUpdateMasterMinter,
+		*MsgUpdatePauser,
+		*MsgUpdateBlacklister,
+		*MsgUpdateAttestor,
+		*MsgUpdateAllowlister,
+		*MsgSetAllowlistMode,
+		*MsgConfigureMinterController,
+		*MsgRemoveMinterController,
+		*MsgUnpause,
+		*MsgSchedulePause,
+		*MsgCancelPauseSchedule,
+		*MsgSetAdminQuorum:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateAdminQuorum checks that the approvers are unique and valid addresses, and that the
+// threshold can be reached. No approvers, a zero threshold and a zero voting period remove the quorum.
+func ValidateAdminQuorum(approvers []string, threshold uint32, votingPeriod time.Duration) error {
+	if len(approvers) == 0 && threshold == 0 && votingPeriod == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for _, approver := range approvers {
+		if _, err := sdk.AccAddressFromBech32(approver); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid approver address (%s)", err)
+		}
+		if seen[approver] {
+			return sdkerrors.Wrapf(ErrAdminProposal, "duplicate approver %s", approver)
+		}
+		seen[approver] = true
+	}
+
+	if threshold == 0 || int(threshold) > len(approvers) {
+		return sdkerrors.Wrapf(ErrAdminProposal, "threshold must be between 1 and the number of approvers (%d)", len(approvers))
+	}
+	if votingPeriod <= 0 {
+		return sdkerrors.Wrapf(ErrAdminProposal, "voting period must be positive")
+	}
+
+	return nil
+}
+
+// Validate checks that the quorum has approvers and a reachable threshold.
+func (q AdminQuorum) Validate() error {
+	if len(q.Approvers) == 0 {
+		return sdkerrors.Wrapf(ErrAdminProposal, "quorum of %s has no approvers", q.Denom)
+	}
+
+	return ValidateAdminQuorum(q.Approvers, q.Threshold, q.VotingPeriod)
+}
+
+// IsApprover returns true if the address is one of the approvers of the quorum.
+func (q AdminQuorum) IsApprover(address string) bool {
+	for _, approver := range q.Approvers {
+		if approver == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasVoted returns true if the address has already approved or rejected the proposal.
+func (p AdminProposal) HasVoted(address string) bool {
+	for _, voter := range append(append([]string{}, p.Approvals...), p.Rejections...) {
+		if voter == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetAdminMsg returns the administrative message of the proposal.
+func (p AdminProposal) GetAdminMsg() (AdminMsg, error) {
+	return unpackAdminMsg(p.Msg)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p AdminProposal) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(p.Msg, &msg)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueryGetAdminProposalResponse) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return m.AdminProposal.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueryAllAdminProposalResponse) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, proposal := range m.AdminProposal {
+		if err := proposal.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unpackAdminMsg returns the cached message of an Any, which must be an administrative message
+// that can be submitted as an admin proposal.
+func unpackAdminMsg(any *cdctypes.Any) (AdminMsg, error) {
+	if any == nil {
+		return nil, sdkerrors.Wrap(ErrAdminProposal, "message is not set")
+	}
+
+	msg, ok := any.GetCachedValue().(AdminMsg)
+	if !ok || !IsAdminProposalMsg(msg) {
+		return nil, sdkerrors.Wrapf(ErrAdminProposal, "%s can not be submitted as an admin proposal", any.TypeUrl)
+	}
+
+	return msg, nil
+}
+
+// validateAdminProposal checks the fields of a proposal in the genesis state.
+func validateAdminProposal(p AdminProposal) error {
+	if _, err := sdk.AccAddressFromBech32(p.Proposer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "admin proposal %d has invalid proposer address (%s)", p.Id, err)
+	}
+	msg, err := p.GetAdminMsg()
+	if err != nil {
+		return sdkerrors.Wrapf(err, "admin proposal %d", p.Id)
+	}
+	if msg.GetDenom() != p.Denom {
+		return fmt.Errorf("admin proposal %d has a message of denom %s", p.Id, msg.GetDenom())
+	}
+	if !p.Expiry.After(p.SubmitTime) {
+		return fmt.Errorf("admin proposal %d must expire after it is submitted", p.Id)
+	}
+
+	return nil
+}
@@ -0,0 +1,485 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/allowlisted.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Allowlisted is an address that may receive a minting denom while its allowlist mode is enabled.
+type Allowlisted struct {
+	AddressBz []byte `protobuf:"bytes,1,opt,name=addressBz,proto3" json:"addressBz,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// allowlister is the address that allowlisted the address.
+	Allowlister string `protobuf:"bytes,3,opt,name=allowlister,proto3" json:"allowlister,omitempty"`
+	// allowlisted_at is the block time the address was allowlisted at.
+	AllowlistedAt time.Time `protobuf:"bytes,4,opt,name=allowlisted_at,json=allowlistedAt,proto3,stdtime" json:"allowlisted_at"`
+}
+
+func (m *Allowlisted) Reset()         { *m = Allowlisted{} }
+func (m *Allowlisted) String() string { return proto.CompactTextString(m) }
+func (*Allowlisted) ProtoMessage()    {}
+func (*Allowlisted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d358f947646cc4a, []int{0}
+}
+func (m *Allowlisted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Allowlisted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Allowlisted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Allowlisted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Allowlisted.Merge(m, src)
+}
+func (m *Allowlisted) XXX_Size() int {
+	return m.Size()
+}
+func (m *Allowlisted) XXX_DiscardUnknown() {
+	xxx_messageInfo_Allowlisted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Allowlisted proto.InternalMessageInfo
+
+func (m *Allowlisted) GetAddressBz() []byte {
+	if m != nil {
+		return m.AddressBz
+	}
+	return nil
+}
+
+func (m *Allowlisted) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Allowlisted) GetAllowlister() string {
+	if m != nil {
+		return m.Allowlister
+	}
+	return ""
+}
+
+func (m *Allowlisted) GetAllowlistedAt() time.Time {
+	if m != nil {
+		return m.AllowlistedAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*Allowlisted)(nil), "noble.tokenfactory.Allowlisted")
+}
+
+func init() { proto.RegisterFile("tokenfactory/allowlisted.proto", fileDescriptor_3d358f947646cc4a) }
+
+var fileDescriptor_3d358f947646cc4a = []byte{
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xb1, 0x4e, 0xac, 0x40,
+	0x14, 0x86, 0x99, 0x7b, 0xd5, 0xb8, 0x83, 0x5a, 0x4c, 0xb6, 0x20, 0xc4, 0x0c, 0xc4, 0x8a, 0xc6,
+	0x99, 0x44, 0xb3, 0x0f, 0xb0, 0xb4, 0x16, 0x26, 0xc4, 0xca, 0xc6, 0x0c, 0xcb, 0x2c, 0x12, 0x81,
+	0x43, 0x98, 0xb3, 0xea, 0xfa, 0x14, 0xfb, 0x28, 0x3e, 0xc6, 0x96, 0x5b, 0x5a, 0xa9, 0x81, 0x17,
+	0x31, 0x42, 0x36, 0x60, 0x37, 0xdf, 0x39, 0xff, 0xe4, 0x7c, 0xf9, 0x29, 0x47, 0x78, 0xd2, 0xe5,
+	0x52, 0x2d, 0x10, 0xea, 0xb5, 0x54, 0x79, 0x0e, 0x2f, 0x79, 0x66, 0x50, 0x27, 0xa2, 0xaa, 0x01,
+	0x81, 0xb1, 0x12, 0xe2, 0x5c, 0x8b, 0x71, 0xca, 0x9d, 0xa6, 0x90, 0x42, 0xb7, 0x96, 0xbf, 0xaf,
+	0x3e, 0xe9, 0x7a, 0x29, 0x40, 0x9a, 0x6b, 0xd9, 0x51, 0xbc, 0x5a, 0x4a, 0xcc, 0x0a, 0x6d, 0x50,
+	0x15, 0x55, 0x1f, 0xb8, 0x78, 0x27, 0xd4, 0x9e, 0x0f, 0x07, 0xd8, 0x39, 0x9d, 0xa8, 0x24, 0xa9,
+	0xb5, 0x31, 0xe1, 0x9b, 0x43, 0x7c, 0x12, 0x9c, 0x44, 0xc3, 0x80, 0x4d, 0xe9, 0x61, 0xa2, 0x4b,
+	0x28, 0x9c, 0x7f, 0x3e, 0x09, 0x26, 0x51, 0x0f, 0xcc, 0xa7, 0xf6, 0xe0, 0x58, 0x3b, 0xff, 0xbb,
+	0xdd, 0x78, 0xc4, 0x6e, 0xe8, 0xd9, 0x80, 0xc9, 0x83, 0x42, 0xe7, 0xc0, 0x27, 0x81, 0x7d, 0xe5,
+	0x8a, 0xde, 0x4f, 0xec, 0xfd, 0xc4, 0xdd, 0xde, 0x2f, 0x3c, 0xde, 0x7e, 0x7a, 0xd6, 0xe6, 0xcb,
+	0x23, 0xd1, 0xe9, 0xe8, 0xef, 0x1c, 0xc3, 0xdb, 0x6d, 0xc3, 0xc9, 0xae, 0xe1, 0xe4, 0xbb, 0xe1,
+	0x64, 0xd3, 0x72, 0x6b, 0xd7, 0x72, 0xeb, 0xa3, 0xe5, 0xd6, 0xfd, 0x2c, 0xcd, 0xf0, 0x71, 0x15,
+	0x8b, 0x05, 0x14, 0xb2, 0xab, 0xe8, 0x52, 0x19, 0xa3, 0xd1, 0xf4, 0x20, 0x9f, 0x67, 0xf2, 0x55,
+	0xfe, 0xa9, 0x16, 0xd7, 0x95, 0x36, 0xf1, 0x51, 0x77, 0xfd, 0xfa, 0x67, 0x00, 0xc3, 0xc6, 0x55,
+	0x65, 0x77, 0x01, 0x00, 0x00,
+}
+
+func (m *Allowlisted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Allowlisted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Allowlisted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AllowlistedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AllowlistedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAllowlisted(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Allowlister) > 0 {
+		i -= len(m.Allowlister)
+		copy(dAtA[i:], m.Allowlister)
+		i = encodeVarintAllowlisted(dAtA, i, uint64(len(m.Allowlister)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAllowlisted(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AddressBz) > 0 {
+		i -= len(m.AddressBz)
+		copy(dAtA[i:], m.AddressBz)
+		i = encodeVarintAllowlisted(dAtA, i, uint64(len(m.AddressBz)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAllowlisted(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAllowlisted(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Allowlisted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AddressBz)
+	if l > 0 {
+		n += 1 + l + sovAllowlisted(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAllowlisted(uint64(l))
+	}
+	l = len(m.Allowlister)
+	if l > 0 {
+		n += 1 + l + sovAllowlisted(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AllowlistedAt)
+	n += 1 + l + sovAllowlisted(uint64(l))
+	return n
+}
+
+func sovAllowlisted(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAllowlisted(x uint64) (n int) {
+	return sovAllowlisted(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Allowlisted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAllowlisted
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Allowlisted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Allowlisted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressBz", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowlisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAllowlisted
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowlisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressBz = append(m.AddressBz[:0], dAtA[iNdEx:postIndex]...)
+			if m.AddressBz == nil {
+				m.AddressBz = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowlisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowlisted
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowlisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlister", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowlisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowlisted
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowlisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlister = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowlisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAllowlisted
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowlisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AllowlistedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAllowlisted(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAllowlisted
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAllowlisted(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAllowlisted
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAllowlisted
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAllowlisted
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAllowlisted
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAllowlisted
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAllowlisted
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAllowlisted        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAllowlisted          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAllowlisted = fmt.Errorf("proto: unexpected end of group")
+)
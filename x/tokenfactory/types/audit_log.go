@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseAuditAction parses an action from its short name, e.g. update-owner or blacklist, or its
+// full enum name.
+func ParseAuditAction(s string) (AuditAction, error) {
+	name := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(s), "-", "_"))
+	if !strings.HasPrefix(name, "AUDIT_ACTION_") {
+		name = "AUDIT_ACTION_" + name
+	}
+
+	action, ok := AuditAction_value[name]
+	if !ok || AuditAction(action) == AuditActionUnspecified {
+		return AuditActionUnspecified, fmt.Errorf("unknown audit action %q", s)
+	}
+
+	return AuditAction(action), nil
+}
@@ -0,0 +1,866 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/audit_log.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AuditAction is an administrative action recorded in the audit log.
+type AuditAction int32
+
+const (
+	AuditActionUnspecified               AuditAction = 0
+	AuditActionUpdateOwner               AuditAction = 1
+	AuditActionAcceptOwner               AuditAction = 2
+	AuditActionUpdateMasterMinter        AuditAction = 3
+	AuditActionUpdatePauser              AuditAction = 4
+	AuditActionUpdateBlacklister         AuditAction = 5
+	AuditActionConfigureMinterController AuditAction = 6
+	AuditActionRemoveMinterController    AuditAction = 7
+	AuditActionConfigureMinter           AuditAction = 8
+	AuditActionRemoveMinter              AuditAction = 9
+	AuditActionIncreaseMinterAllowance   AuditAction = 10
+	AuditActionDecreaseMinterAllowance   AuditAction = 11
+	AuditActionBlacklist                 AuditAction = 12
+	AuditActionUnblacklist               AuditAction = 13
+	AuditActionPause                     AuditAction = 14
+	AuditActionUnpause                   AuditAction = 15
+	AuditActionSetAdminQuorum            AuditAction = 16
+	AuditActionUpdateAttestor            AuditAction = 17
+	AuditActionUpdateAllowlister         AuditAction = 18
+	AuditActionSetAllowlistMode          AuditAction = 19
+	AuditActionAllow                     AuditAction = 20
+	AuditActionDisallow                  AuditAction = 21
+	AuditActionFreezeAmount              AuditAction = 22
+	AuditActionUnfreezeAmount            AuditAction = 23
+	AuditActionSetMaxSupply              AuditAction = 24
+	AuditActionSetMintRateLimit          AuditAction = 25
+	AuditActionRemoveMintRateLimit       AuditAction = 26
+	AuditActionWipeBlacklistedBalance    AuditAction = 27
+	AuditActionCreateDenom               AuditAction = 28
+	AuditActionSchedulePause             AuditAction = 29
+	AuditActionCancelPauseSchedule       AuditAction = 30
+	AuditActionUpdateDenomMetadata       AuditAction = 31
+)
+
+var AuditAction_name = map[int32]string{
+	0:  "AUDIT_ACTION_UNSPECIFIED",
+	1:  "AUDIT_ACTION_UPDATE_OWNER",
+	2:  "AUDIT_ACTION_ACCEPT_OWNER",
+	3:  "AUDIT_ACTION_UPDATE_MASTER_MINTER",
+	4:  "AUDIT_ACTION_UPDATE_PAUSER",
+	5:  "AUDIT_ACTION_UPDATE_BLACKLISTER",
+	6:  "AUDIT_ACTION_CONFIGURE_MINTER_CONTROLLER",
+	7:  "AUDIT_ACTION_REMOVE_MINTER_CONTROLLER",
+	8:  "AUDIT_ACTION_CONFIGURE_MINTER",
+	9:  "AUDIT_ACTION_REMOVE_MINTER",
+	10: "AUDIT_ACTION_INCREASE_MINTER_ALLOWANCE",
+	11: "AUDIT_ACTION_DECREASE_MINTER_ALLOWANCE",
+	12: "AUDIT_ACTION_BLACKLIST",
+	13: "AUDIT_ACTION_UNBLACKLIST",
+	14: "AUDIT_ACTION_PAUSE",
+	15: "AUDIT_ACTION_UNPAUSE",
+	16: "AUDIT_ACTION_SET_ADMIN_QUORUM",
+	17: "AUDIT_ACTION_UPDATE_ATTESTOR",
+	18: "AUDIT_ACTION_UPDATE_ALLOWLISTER",
+	19: "AUDIT_ACTION_SET_ALLOWLIST_MODE",
+	20: "AUDIT_ACTION_ALLOW",
+	21: "AUDIT_ACTION_DISALLOW",
+	22: "AUDIT_ACTION_FREEZE_AMOUNT",
+	23: "AUDIT_ACTION_UNFREEZE_AMOUNT",
+	24: "AUDIT_ACTION_SET_MAX_SUPPLY",
+	25: "AUDIT_ACTION_SET_MINT_RATE_LIMIT",
+	26: "AUDIT_ACTION_REMOVE_MINT_RATE_LIMIT",
+	27: "AUDIT_ACTION_WIPE_BLACKLISTED_BALANCE",
+	28: "AUDIT_ACTION_CREATE_DENOM",
+	29: "AUDIT_ACTION_SCHEDULE_PAUSE",
+	30: "AUDIT_ACTION_CANCEL_PAUSE_SCHEDULE",
+	31: "AUDIT_ACTION_UPDATE_DENOM_METADATA",
+}
+
+var AuditAction_value = map[string]int32{
+	"AUDIT_ACTION_UNSPECIFIED":                 0,
+	"AUDIT_ACTION_UPDATE_OWNER":                1,
+	"AUDIT_ACTION_ACCEPT_OWNER":                2,
+	"AUDIT_ACTION_UPDATE_MASTER_MINTER":        3,
+	"AUDIT_ACTION_UPDATE_PAUSER":               4,
+	"AUDIT_ACTION_UPDATE_BLACKLISTER":          5,
+	"AUDIT_ACTION_CONFIGURE_MINTER_CONTROLLER": 6,
+	"AUDIT_ACTION_REMOVE_MINTER_CONTROLLER":    7,
+	"AUDIT_ACTION_CONFIGURE_MINTER":            8,
+	"AUDIT_ACTION_REMOVE_MINTER":               9,
+	"AUDIT_ACTION_INCREASE_MINTER_ALLOWANCE":   10,
+	"AUDIT_ACTION_DECREASE_MINTER_ALLOWANCE":   11,
+	"AUDIT_ACTION_BLACKLIST":                   12,
+	"AUDIT_ACTION_UNBLACKLIST":                 13,
+	"AUDIT_ACTION_PAUSE":                       14,
+	"AUDIT_ACTION_UNPAUSE":                     15,
+	"AUDIT_ACTION_SET_ADMIN_QUORUM":            16,
+	"AUDIT_ACTION_UPDATE_ATTESTOR":             17,
+	"AUDIT_ACTION_UPDATE_ALLOWLISTER":          18,
+	"AUDIT_ACTION_SET_ALLOWLIST_MODE":          19,
+	"AUDIT_ACTION_ALLOW":                       20,
+	"AUDIT_ACTION_DISALLOW":                    21,
+	"AUDIT_ACTION_FREEZE_AMOUNT":               22,
+	"AUDIT_ACTION_UNFREEZE_AMOUNT":             23,
+	"AUDIT_ACTION_SET_MAX_SUPPLY":              24,
+	"AUDIT_ACTION_SET_MINT_RATE_LIMIT":         25,
+	"AUDIT_ACTION_REMOVE_MINT_RATE_LIMIT":      26,
+	"AUDIT_ACTION_WIPE_BLACKLISTED_BALANCE":    27,
+	"AUDIT_ACTION_CREATE_DENOM":                28,
+	"AUDIT_ACTION_SCHEDULE_PAUSE":              29,
+	"AUDIT_ACTION_CANCEL_PAUSE_SCHEDULE":       30,
+	"AUDIT_ACTION_UPDATE_DENOM_METADATA":       31,
+}
+
+func (x AuditAction) String() string {
+	return proto.EnumName(AuditAction_name, int32(x))
+}
+
+func (AuditAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3fd82d337347276c, []int{0}
+}
+
+// AuditLogEntry records an administrative action taken on a minting denom. Actions taken by the
+// module itself at the end of a block, such as scheduled pauses or expired blacklist entries, are
+// recorded with the module account as the actor.
+type AuditLogEntry struct {
+	Id     uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Denom  string      `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Action AuditAction `protobuf:"varint,3,opt,name=action,proto3,enum=noble.tokenfactory.AuditAction" json:"action,omitempty"`
+	Actor  string      `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// target is the address the action applies to, if any.
+	Target string `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	// old_value and new_value hold the role address, minter of a controller, minter allowance,
+	// paused operations, blacklist reason or frozen amount before and after the action, depending on
+	// the action.
+	OldValue string    `protobuf:"bytes,6,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue string    `protobuf:"bytes,7,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	Height   int64     `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	Time     time.Time `protobuf:"bytes,9,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *AuditLogEntry) Reset()         { *m = AuditLogEntry{} }
+func (m *AuditLogEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditLogEntry) ProtoMessage()    {}
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fd82d337347276c, []int{0}
+}
+func (m *AuditLogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditLogEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditLogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogEntry.Merge(m, src)
+}
+func (m *AuditLogEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditLogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogEntry proto.InternalMessageInfo
+
+func (m *AuditLogEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditLogEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetAction() AuditAction {
+	if m != nil {
+		return m.Action
+	}
+	return AuditActionUnspecified
+}
+
+func (m *AuditLogEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AuditLogEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("noble.tokenfactory.AuditAction", AuditAction_name, AuditAction_value)
+	proto.RegisterType((*AuditLogEntry)(nil), "noble.tokenfactory.AuditLogEntry")
+}
+
+func init() { proto.RegisterFile("tokenfactory/audit_log.proto", fileDescriptor_3fd82d337347276c) }
+
+var fileDescriptor_3fd82d337347276c = []byte{
+	// 1162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x96, 0x41, 0x73, 0xdb, 0x44,
+	0x1f, 0xc6, 0xe3, 0x34, 0x4d, 0x93, 0xed, 0xdb, 0xbe, 0x8b, 0x9a, 0xa6, 0xee, 0x26, 0xb1, 0xd5,
+	0x40, 0x3b, 0x1e, 0x06, 0x6c, 0xa6, 0xd0, 0xa1, 0x0c, 0x30, 0xb0, 0x91, 0x36, 0x54, 0x54, 0xb2,
+	0x5c, 0x59, 0x6e, 0xa0, 0x17, 0x8d, 0x62, 0x6d, 0x1c, 0x4d, 0x65, 0xad, 0x47, 0x5e, 0x37, 0x0d,
+	0x9f, 0x80, 0xd1, 0xa9, 0x5f, 0x40, 0x27, 0x2e, 0x7c, 0x94, 0x1e, 0x7b, 0x84, 0x0b, 0x30, 0xed,
+	0x17, 0x61, 0xb4, 0x72, 0x52, 0xd9, 0xeb, 0x70, 0xf3, 0x7a, 0xf7, 0xf9, 0xed, 0xff, 0xff, 0x68,
+	0x9f, 0x95, 0xc0, 0x36, 0x67, 0xcf, 0x69, 0x7c, 0xe4, 0xf7, 0x39, 0x4b, 0x4e, 0x5b, 0xfe, 0x24,
+	0x08, 0xb9, 0x17, 0xb1, 0x41, 0x73, 0x94, 0x30, 0xce, 0x14, 0x25, 0x66, 0x87, 0x11, 0x6d, 0x96,
+	0xd7, 0xa0, 0x8d, 0x01, 0x1b, 0x30, 0x31, 0xdd, 0xca, 0x7f, 0x15, 0x2b, 0x51, 0x7d, 0xc0, 0xd8,
+	0x20, 0xa2, 0x2d, 0x31, 0x3a, 0x9c, 0x1c, 0xb5, 0x78, 0x38, 0xa4, 0x63, 0xee, 0x0f, 0x47, 0xc5,
+	0x82, 0xdd, 0xdf, 0x97, 0xc1, 0x35, 0x9c, 0xe3, 0x4d, 0x36, 0x20, 0x31, 0x4f, 0x4e, 0x95, 0xeb,
+	0x60, 0x39, 0x0c, 0xaa, 0x15, 0xb5, 0xd2, 0x58, 0x71, 0x96, 0xc3, 0x40, 0xd9, 0x00, 0x97, 0x03,
+	0x1a, 0xb3, 0x61, 0x75, 0x59, 0xad, 0x34, 0xd6, 0x9d, 0x62, 0xa0, 0x7c, 0x09, 0x56, 0xfd, 0x3e,
+	0x0f, 0x59, 0x5c, 0xbd, 0xa4, 0x56, 0x1a, 0xd7, 0xef, 0xd7, 0x9b, 0x72, 0x4d, 0x4d, 0x01, 0xc6,
+	0x62, 0x99, 0x33, 0x5d, 0x9e, 0xe3, 0xc4, 0x6c, 0x75, 0xa5, 0xc0, 0x89, 0x81, 0xb2, 0x09, 0x56,
+	0xb9, 0x9f, 0x0c, 0x28, 0xaf, 0x5e, 0x16, 0x7f, 0x4f, 0x47, 0xca, 0x16, 0x58, 0x67, 0x51, 0xe0,
+	0xbd, 0xf0, 0xa3, 0x09, 0xad, 0xae, 0x8a, 0xa9, 0x35, 0x16, 0x05, 0x4f, 0xf3, 0x71, 0x3e, 0x19,
+	0xd3, 0x93, 0xe9, 0xe4, 0x95, 0x62, 0x32, 0xa6, 0x27, 0xc5, 0xe4, 0x26, 0x58, 0x3d, 0xa6, 0xe1,
+	0xe0, 0x98, 0x57, 0xd7, 0xd4, 0x4a, 0xe3, 0x92, 0x33, 0x1d, 0x29, 0x0f, 0xc1, 0x4a, 0xee, 0x41,
+	0x75, 0x5d, 0xad, 0x34, 0xae, 0xde, 0x47, 0xcd, 0xc2, 0xa0, 0xe6, 0x99, 0x41, 0x4d, 0xf7, 0xcc,
+	0xa0, 0xbd, 0xb5, 0xd7, 0x7f, 0xd5, 0x97, 0x5e, 0xfd, 0x5d, 0xaf, 0x38, 0x42, 0xf1, 0xf1, 0x9f,
+	0x10, 0x5c, 0x2d, 0x75, 0xa4, 0x3c, 0x04, 0x55, 0xdc, 0xd3, 0x0d, 0xd7, 0xc3, 0x9a, 0x6b, 0xd8,
+	0x6d, 0xaf, 0xd7, 0xee, 0x76, 0x88, 0x66, 0xec, 0x1b, 0x44, 0x87, 0x4b, 0x08, 0xa5, 0x99, 0xba,
+	0x59, 0x5a, 0xde, 0x8b, 0xc7, 0x23, 0xda, 0x0f, 0x8f, 0x42, 0x1a, 0x28, 0x5f, 0x81, 0xdb, 0xb3,
+	0xca, 0x8e, 0x8e, 0x5d, 0xe2, 0xd9, 0x07, 0x6d, 0xe2, 0xc0, 0x8a, 0x2c, 0x1d, 0x05, 0x3e, 0xa7,
+	0xf6, 0x49, 0x4c, 0x13, 0x49, 0x8a, 0x35, 0x8d, 0x74, 0xdc, 0xa9, 0x74, 0x59, 0x92, 0xe2, 0x7e,
+	0x9f, 0x8e, 0x78, 0x21, 0x7d, 0x04, 0xee, 0x2c, 0xda, 0xd5, 0xc2, 0x5d, 0x97, 0x38, 0x9e, 0x65,
+	0xb4, 0x5d, 0xe2, 0xc0, 0x4b, 0xe8, 0x4e, 0x9a, 0xa9, 0x3b, 0xd2, 0xee, 0x96, 0x3f, 0xe6, 0x34,
+	0xb1, 0xc2, 0x98, 0xd3, 0x44, 0xf9, 0x1a, 0xa0, 0x45, 0xa4, 0x0e, 0xee, 0x75, 0x89, 0x03, 0x57,
+	0xd0, 0x56, 0x9a, 0xa9, 0xb7, 0x24, 0x44, 0xc7, 0x9f, 0x8c, 0x69, 0xa2, 0x10, 0x50, 0x5f, 0x24,
+	0xde, 0x33, 0xb1, 0xf6, 0xd8, 0x34, 0xf2, 0x5a, 0xe0, 0x65, 0xa4, 0xa6, 0x99, 0xba, 0x2d, 0x11,
+	0xf6, 0x22, 0xbf, 0xff, 0x3c, 0x0a, 0xf3, 0x4a, 0x94, 0xa7, 0xa0, 0x31, 0x83, 0xd1, 0xec, 0xf6,
+	0xbe, 0xf1, 0x43, 0xcf, 0x21, 0xd3, 0x4e, 0xf2, 0x3f, 0x5c, 0xc7, 0x36, 0x4d, 0xe2, 0xc0, 0x55,
+	0xd4, 0x48, 0x33, 0xf5, 0xa3, 0x12, 0x4f, 0x63, 0xf1, 0x51, 0x38, 0x98, 0x24, 0xb4, 0xe8, 0x48,
+	0x63, 0x31, 0x4f, 0x58, 0x14, 0xd1, 0x44, 0xe9, 0x80, 0xbb, 0x33, 0x5c, 0x87, 0x58, 0xf6, 0xd3,
+	0x45, 0xd0, 0x2b, 0xe8, 0x6e, 0x9a, 0xa9, 0x77, 0xca, 0x67, 0x9c, 0x0e, 0xd9, 0x0b, 0x99, 0x88,
+	0xc1, 0xce, 0x7f, 0x56, 0x0a, 0xd7, 0x50, 0x2d, 0xcd, 0x54, 0x74, 0x71, 0x79, 0x92, 0xe1, 0x33,
+	0x45, 0xc1, 0x75, 0xc9, 0xf0, 0x72, 0x25, 0x8a, 0x03, 0xee, 0xcd, 0x88, 0x8d, 0xb6, 0xe6, 0x10,
+	0xdc, 0x3d, 0xef, 0x09, 0x9b, 0xa6, 0x7d, 0x80, 0xdb, 0x1a, 0x81, 0x00, 0xdd, 0x4b, 0x33, 0x75,
+	0xb7, 0x04, 0x32, 0xe2, 0x7e, 0x42, 0xfd, 0xf1, 0x14, 0x85, 0xa3, 0x88, 0x9d, 0xf8, 0x71, 0x9f,
+	0x4a, 0x4c, 0x9d, 0x5c, 0xc4, 0xbc, 0x2a, 0x31, 0x75, 0xba, 0x98, 0xf9, 0x05, 0xd8, 0x9c, 0x61,
+	0x9e, 0x9f, 0x08, 0xf8, 0x3f, 0x54, 0x4d, 0x33, 0x75, 0xa3, 0xc4, 0x38, 0x3f, 0x09, 0x0b, 0x52,
+	0xf8, 0x5e, 0x77, 0x6d, 0x41, 0x0a, 0x0f, 0xcf, 0x95, 0x9f, 0x00, 0x65, 0x46, 0x29, 0x8e, 0x2f,
+	0xbc, 0x8e, 0x36, 0xd2, 0x4c, 0x85, 0x25, 0x8d, 0x38, 0xb7, 0xca, 0x67, 0x60, 0x63, 0x6e, 0x9f,
+	0x62, 0xfd, 0xff, 0xd1, 0x66, 0x9a, 0xa9, 0xca, 0xcc, 0x1e, 0x23, 0xa1, 0xf8, 0x7e, 0xee, 0xb9,
+	0x77, 0x89, 0xeb, 0x61, 0xdd, 0x32, 0xda, 0xde, 0x93, 0x9e, 0xed, 0xf4, 0x2c, 0x08, 0xd1, 0x4e,
+	0x9a, 0xa9, 0xb7, 0x4b, 0xd2, 0x2e, 0xe5, 0x38, 0x18, 0x86, 0xf1, 0x93, 0x09, 0x4b, 0x26, 0x43,
+	0xe5, 0x3b, 0xb0, 0xbd, 0x28, 0x2a, 0xd8, 0x75, 0x49, 0xd7, 0xb5, 0x1d, 0xf8, 0x81, 0x04, 0x28,
+	0x72, 0x82, 0x39, 0xa7, 0x63, 0xce, 0x2e, 0xcc, 0x9a, 0x78, 0x3a, 0xd3, 0xac, 0x29, 0x17, 0x64,
+	0x4d, 0x3c, 0x97, 0x69, 0xd6, 0x74, 0x50, 0x97, 0x3b, 0x39, 0x63, 0x78, 0x96, 0xad, 0x13, 0x78,
+	0x03, 0xd5, 0xd3, 0x4c, 0xdd, 0x9a, 0xeb, 0xe5, 0x8c, 0x61, 0xb1, 0x80, 0x4a, 0x7e, 0x0b, 0x02,
+	0xdc, 0x90, 0xfc, 0x16, 0x2a, 0xe5, 0x3e, 0xb8, 0x39, 0x7b, 0xc2, 0x8c, 0x6e, 0x21, 0xb8, 0x89,
+	0x6e, 0xa5, 0x99, 0x7a, 0xa3, 0x7c, 0xa0, 0xc2, 0xb1, 0x2f, 0x34, 0xf3, 0x31, 0xd9, 0x77, 0x08,
+	0x79, 0x46, 0x3c, 0x6c, 0xd9, 0xbd, 0xb6, 0x0b, 0x37, 0xa5, 0x98, 0xec, 0x27, 0x94, 0xfe, 0x42,
+	0xf1, 0x90, 0x4d, 0x62, 0x2e, 0x9b, 0xdd, 0x9e, 0x95, 0xdf, 0x92, 0xcd, 0x8e, 0x8f, 0xca, 0x80,
+	0x6f, 0xc0, 0x96, 0xe4, 0x92, 0x85, 0x7f, 0xf2, 0xba, 0xbd, 0x4e, 0xc7, 0xfc, 0x19, 0x56, 0xa5,
+	0xed, 0xbb, 0x94, 0x5b, 0xfe, 0xcb, 0xee, 0x64, 0x34, 0x8a, 0x4e, 0x15, 0x02, 0x54, 0x59, 0x6d,
+	0xb4, 0x5d, 0xcf, 0xc9, 0x9f, 0x98, 0x69, 0x58, 0x86, 0x0b, 0x6f, 0x2f, 0x32, 0x39, 0x8f, 0x91,
+	0xe3, 0x73, 0x6a, 0x86, 0xc3, 0x90, 0x2b, 0x8f, 0xc1, 0x87, 0x17, 0xdd, 0x14, 0x65, 0x12, 0x42,
+	0xbb, 0x69, 0xa6, 0xd6, 0x16, 0x5e, 0x19, 0xef, 0x61, 0xf3, 0x77, 0xe1, 0x81, 0xd1, 0x29, 0x5f,
+	0xd4, 0xba, 0xb7, 0x87, 0x4d, 0x11, 0xf2, 0x2d, 0xe9, 0x2e, 0x3c, 0x08, 0x47, 0xa5, 0xeb, 0x3a,
+	0xd8, 0xf3, 0x23, 0x91, 0xf1, 0xf9, 0xd7, 0x57, 0x7e, 0x6b, 0xb8, 0xc4, 0xd3, 0x49, 0xdb, 0xb6,
+	0xe0, 0xb6, 0x14, 0x57, 0x2d, 0xa1, 0x3e, 0xa7, 0xba, 0xf8, 0xe2, 0xf8, 0x76, 0xde, 0x5e, 0xed,
+	0x11, 0xd1, 0x7b, 0xe6, 0xf4, 0xb5, 0x03, 0x77, 0xd0, 0x76, 0x9a, 0xa9, 0xd5, 0xb2, 0x37, 0xfd,
+	0x63, 0x1a, 0x4c, 0xa2, 0xe2, 0xbd, 0xa3, 0xfc, 0x08, 0x76, 0x67, 0x77, 0xce, 0x0b, 0x37, 0x0b,
+	0xf1, 0x39, 0x0b, 0xd6, 0x24, 0x5f, 0xb4, 0xbc, 0xee, 0x48, 0x30, 0xce, 0x80, 0x12, 0x6b, 0x1a,
+	0x2b, 0xd1, 0x85, 0x67, 0x11, 0x17, 0xeb, 0xd8, 0xc5, 0xb0, 0x2e, 0xb1, 0x8a, 0x64, 0x89, 0x76,
+	0x2c, 0xca, 0xfd, 0xc0, 0xe7, 0x3e, 0x5a, 0xf9, 0xf5, 0xb7, 0xda, 0xd2, 0x9e, 0xfd, 0xfa, 0x6d,
+	0xad, 0xf2, 0xe6, 0x6d, 0xad, 0xf2, 0xcf, 0xdb, 0x5a, 0xe5, 0xd5, 0xbb, 0xda, 0xd2, 0x9b, 0x77,
+	0xb5, 0xa5, 0x3f, 0xde, 0xd5, 0x96, 0x9e, 0x3d, 0x18, 0x84, 0xfc, 0x78, 0x72, 0xd8, 0xec, 0xb3,
+	0x61, 0x4b, 0x7c, 0x62, 0x7d, 0xea, 0x8f, 0xc7, 0x94, 0x8f, 0x8b, 0x41, 0xeb, 0xc5, 0x83, 0xd6,
+	0xcb, 0xd6, 0xcc, 0xc7, 0x22, 0x3f, 0x1d, 0xd1, 0xf1, 0xe1, 0xaa, 0xf8, 0xa0, 0xf9, 0xfc, 0xdf,
+	0x01, 0x00, 0xe7, 0xf5, 0x0f, 0x9d, 0x49, 0x0a, 0x00, 0x00,
+}
+
+func (m *AuditLogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditLogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditLogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuditLog(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if m.Height != 0 {
+		i = encodeVarintAuditLog(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintAuditLog(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintAuditLog(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintAuditLog(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintAuditLog(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Action != 0 {
+		i = encodeVarintAuditLog(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAuditLog(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuditLog(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuditLog(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuditLog(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuditLogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAuditLog(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAuditLog(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovAuditLog(uint64(m.Action))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovAuditLog(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovAuditLog(uint64(l))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovAuditLog(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovAuditLog(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAuditLog(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAuditLog(uint64(l))
+	return n
+}
+
+func sovAuditLog(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuditLog(x uint64) (n int) {
+	return sovAuditLog(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AuditLogEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditLog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditLogEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditLogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= AuditAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditLog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuditLog(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuditLog
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuditLog
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuditLog
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuditLog
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuditLog        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuditLog          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuditLog = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &MintAuthorization{}
+
+// NewMintAuthorization creates a new MintAuthorization object.
+func NewMintAuthorization(spendLimit sdk.Coin, allowedRecipients []string, expiry *time.Time) *MintAuthorization {
+	return &MintAuthorization{
+		SpendLimit:        spendLimit,
+		AllowedRecipients: allowedRecipients,
+		Expiry:            expiry,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a MintAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgMint{})
+}
+
+// Accept implements Authorization.Accept. It only checks the limits of the authorization, the
+// minter allowance of the granter is checked when the mint is executed.
+func (a MintAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mint, ok := msg.(*MsgMint)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if a.Expiry != nil && !ctx.BlockTime().Before(*a.Expiry) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("mint authorization expired at %s", a.Expiry.Format(time.RFC3339))
+	}
+
+	if !a.IsAllowedRecipient(mint.Address) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("%s is not an allowed recipient", mint.Address)
+	}
+
+	if mint.Amount.Denom != a.SpendLimit.Denom {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidCoins.Wrapf("mint authorization only allows minting %s", a.SpendLimit.Denom)
+	}
+	if a.SpendLimit.IsLT(mint.Amount) {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+	}
+
+	limitLeft := a.SpendLimit.Sub(mint.Amount)
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: NewMintAuthorization(limitLeft, a.AllowedRecipients, a.Expiry)}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MintAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.IsValid() || !a.SpendLimit.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit must be positive")
+	}
+
+	seen := make(map[string]bool)
+	for _, recipient := range a.AllowedRecipients {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowed recipient (%s)", err)
+		}
+		if seen[recipient] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowed recipient %s", recipient)
+		}
+		seen[recipient] = true
+	}
+
+	return nil
+}
+
+// IsAllowedRecipient returns true if tokens can be minted to the address.
+func (a MintAuthorization) IsAllowedRecipient(address string) bool {
+	if len(a.AllowedRecipients) == 0 {
+		return true
+	}
+
+	for _, recipient := range a.AllowedRecipients {
+		if recipient == address {
+			return true
+		}
+	}
+
+	return false
+}
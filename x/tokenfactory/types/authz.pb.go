@@ -0,0 +1,453 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintAuthorization allows a grantee to mint on behalf of a minter through x/authz, up to a
+// cumulative spend limit, to the allowed recipients and until the expiry. Every mint is still
+// checked against, and decreases, the minter allowance of the granter.
+type MintAuthorization struct {
+	// spend_limit is the amount that can still be minted, which is decreased by every accepted mint.
+	SpendLimit types.Coin `protobuf:"bytes,1,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit"`
+	// allowed_recipients are the addresses that can be minted to, any address if empty.
+	AllowedRecipients []string `protobuf:"bytes,2,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+	// expiry is the time after which the authorization can no longer be used, if set.
+	Expiry *time.Time `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+}
+
+func (m *MintAuthorization) Reset()         { *m = MintAuthorization{} }
+func (m *MintAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MintAuthorization) ProtoMessage()    {}
+func (*MintAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe03fe64a0212d68, []int{0}
+}
+func (m *MintAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintAuthorization.Merge(m, src)
+}
+func (m *MintAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintAuthorization proto.InternalMessageInfo
+
+func (m *MintAuthorization) GetSpendLimit() types.Coin {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return types.Coin{}
+}
+
+func (m *MintAuthorization) GetAllowedRecipients() []string {
+	if m != nil {
+		return m.AllowedRecipients
+	}
+	return nil
+}
+
+func (m *MintAuthorization) GetExpiry() *time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MintAuthorization)(nil), "noble.tokenfactory.MintAuthorization")
+}
+
+func init() { proto.RegisterFile("tokenfactory/authz.proto", fileDescriptor_fe03fe64a0212d68) }
+
+var fileDescriptor_fe03fe64a0212d68 = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0xdb, 0x0b, 0x21, 0xb9, 0x25, 0x2e, 0x68, 0x5c, 0x14, 0x16, 0x03, 0x71, 0xc5, 0x86,
+	0x99, 0xa0, 0x21, 0x31, 0xae, 0x14, 0xb7, 0x1a, 0x93, 0xc6, 0x95, 0x1b, 0x32, 0x2d, 0x43, 0x99,
+	0xd8, 0xce, 0x69, 0x3a, 0xa7, 0x08, 0x3c, 0x05, 0x0f, 0xe3, 0x43, 0xb0, 0x44, 0x57, 0xae, 0xd4,
+	0xc0, 0x8b, 0x18, 0x3a, 0xc5, 0xc8, 0xae, 0xff, 0xf9, 0xce, 0x9f, 0xf3, 0xa5, 0xe3, 0x78, 0x08,
+	0xcf, 0x42, 0x4d, 0x78, 0x88, 0x90, 0x2d, 0x18, 0xcf, 0x71, 0xba, 0xa4, 0x69, 0x06, 0x08, 0xae,
+	0xab, 0x20, 0x88, 0x05, 0xfd, 0xcb, 0x5b, 0x24, 0x04, 0x9d, 0x80, 0x66, 0x01, 0xd7, 0x82, 0xcd,
+	0xfa, 0x81, 0x40, 0xde, 0x67, 0x21, 0x48, 0x65, 0x3a, 0xad, 0xa6, 0xe1, 0xa3, 0x22, 0x31, 0x13,
+	0x4a, 0x74, 0x1a, 0x41, 0x04, 0x66, 0xbe, 0xff, 0x2a, 0xa7, 0xed, 0x08, 0x20, 0x8a, 0x05, 0x2b,
+	0x52, 0x90, 0x4f, 0x18, 0xca, 0x44, 0x68, 0xe4, 0x49, 0x6a, 0x16, 0xce, 0xde, 0x6c, 0xa7, 0x71,
+	0x2f, 0x15, 0xde, 0xe4, 0x38, 0x85, 0x4c, 0x2e, 0x39, 0x4a, 0x50, 0xee, 0xb5, 0x53, 0xd7, 0xa9,
+	0x50, 0xe3, 0x51, 0x2c, 0x13, 0x89, 0x9e, 0xdd, 0xb1, 0xbb, 0xf5, 0xf3, 0x26, 0x2d, 0x0f, 0xee,
+	0xed, 0x68, 0x69, 0x47, 0x6f, 0x41, 0xaa, 0x61, 0x75, 0xfd, 0xd9, 0xb6, 0x7c, 0xa7, 0xe8, 0xdc,
+	0xed, 0x2b, 0x6e, 0xcf, 0x71, 0x79, 0x1c, 0xc3, 0x8b, 0x18, 0x8f, 0x32, 0x11, 0xca, 0x54, 0x0a,
+	0x85, 0xda, 0xfb, 0xd7, 0xa9, 0x74, 0xff, 0xfb, 0x8d, 0x92, 0xf8, 0xbf, 0xc0, 0xbd, 0x74, 0x6a,
+	0x62, 0x9e, 0xca, 0x6c, 0xe1, 0x55, 0x8a, 0x5b, 0x2d, 0x6a, 0xc4, 0xe9, 0x41, 0x9c, 0x3e, 0x1e,
+	0xc4, 0x87, 0xd5, 0xd5, 0x57, 0xdb, 0xf6, 0xcb, 0xfd, 0xab, 0xc6, 0xfb, 0x6b, 0xef, 0xe4, 0xc8,
+	0x7e, 0xf8, 0xb0, 0xde, 0x12, 0x7b, 0xb3, 0x25, 0xf6, 0xf7, 0x96, 0xd8, 0xab, 0x1d, 0xb1, 0x36,
+	0x3b, 0x62, 0x7d, 0xec, 0x88, 0xf5, 0x34, 0x88, 0x24, 0x4e, 0xf3, 0x80, 0x86, 0x90, 0xb0, 0xe2,
+	0xf7, 0xf7, 0xb8, 0xd6, 0x02, 0xb5, 0x09, 0x6c, 0x36, 0x60, 0x73, 0x76, 0xf4, 0x60, 0xb8, 0x48,
+	0x85, 0x0e, 0x6a, 0x85, 0xc5, 0xc5, 0xcf, 0x00, 0x9b, 0x96, 0x78, 0x55, 0xcd, 0x01, 0x00, 0x00,
+}
+
+func (m *MintAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiry):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.SpendLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Expiry != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestMintAuthorization_ValidateBasic(t *testing.T) {
+	recipient := sample.AccAddress()
+	tests := []struct {
+		name          string
+		authorization *MintAuthorization
+		err           error
+	}{
+		{
+			name:          "zero spend limit",
+			authorization: NewMintAuthorization(sdk.NewCoin("utoken", sdk.ZeroInt()), nil, nil),
+			err:           sdkerrors.ErrInvalidCoins,
+		}, {
+			name:          "invalid spend limit",
+			authorization: NewMintAuthorization(sdk.Coin{Denom: "1", Amount: sdk.NewInt(1)}, nil, nil),
+			err:           sdkerrors.ErrInvalidCoins,
+		}, {
+			name:          "invalid recipient",
+			authorization: NewMintAuthorization(sdk.NewCoin("utoken", sdk.NewInt(1)), []string{"invalid_address"}, nil),
+			err:           sdkerrors.ErrInvalidAddress,
+		}, {
+			name:          "duplicate recipient",
+			authorization: NewMintAuthorization(sdk.NewCoin("utoken", sdk.NewInt(1)), []string{recipient, recipient}, nil),
+			err:           sdkerrors.ErrInvalidRequest,
+		}, {
+			name:          "valid authorization",
+			authorization: NewMintAuthorization(sdk.NewCoin("utoken", sdk.NewInt(1)), []string{recipient}, nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.authorization.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMintAuthorization_Accept(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiry := now.Add(time.Hour)
+	ctx := sdk.NewContext(nil, tmproto.Header{Time: now}, false, nil)
+
+	minter, recipient := sample.AccAddress(), sample.AccAddress()
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin("utoken", sdk.NewInt(amount))
+	}
+
+	tests := []struct {
+		name          string
+		authorization *MintAuthorization
+		ctx           sdk.Context
+		msg           sdk.Msg
+		resp          authz.AcceptResponse
+		err           error
+	}{
+		{
+			name:          "not a mint",
+			authorization: NewMintAuthorization(coin(10), nil, nil),
+			msg:           NewMsgBurn(minter, coin(1)),
+			err:           sdkerrors.ErrInvalidType,
+		}, {
+			name:          "expired",
+			authorization: NewMintAuthorization(coin(10), nil, &expiry),
+			ctx:           ctx.WithBlockTime(expiry),
+			msg:           NewMsgMint(minter, recipient, coin(1)),
+			err:           sdkerrors.ErrUnauthorized,
+		}, {
+			name:          "recipient not allowed",
+			authorization: NewMintAuthorization(coin(10), []string{sample.AccAddress()}, nil),
+			msg:           NewMsgMint(minter, recipient, coin(1)),
+			err:           sdkerrors.ErrUnauthorized,
+		}, {
+			name:          "other denom",
+			authorization: NewMintAuthorization(coin(10), nil, nil),
+			msg:           NewMsgMint(minter, recipient, sdk.NewCoin("uother", sdk.NewInt(1))),
+			err:           sdkerrors.ErrInvalidCoins,
+		}, {
+			name:          "above spend limit",
+			authorization: NewMintAuthorization(coin(10), nil, nil),
+			msg:           NewMsgMint(minter, recipient, coin(11)),
+			err:           sdkerrors.ErrInsufficientFunds,
+		}, {
+			name:          "below spend limit",
+			authorization: NewMintAuthorization(coin(10), []string{recipient}, &expiry),
+			msg:           NewMsgMint(minter, recipient, coin(4)),
+			resp:          authz.AcceptResponse{Accept: true, Updated: NewMintAuthorization(coin(6), []string{recipient}, &expiry)},
+		}, {
+			name:          "exhausts spend limit",
+			authorization: NewMintAuthorization(coin(10), nil, &expiry),
+			msg:           NewMsgMint(minter, recipient, coin(10)),
+			resp:          authz.AcceptResponse{Accept: true, Delete: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.ctx.Context() == nil {
+				tt.ctx = ctx
+			}
+			resp, err := tt.authorization.Accept(tt.ctx, tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.resp, resp)
+		})
+	}
+}
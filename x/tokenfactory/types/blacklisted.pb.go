@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,6 +29,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Blacklisted struct {
 	AddressBz []byte `protobuf:"bytes,1,opt,name=addressBz,proto3" json:"addressBz,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// reason the address was blacklisted, e.g. sanctions, court_order or fraud.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// case_id identifies the compliance case that required the address to be blacklisted.
+	CaseID string `protobuf:"bytes,4,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	// blacklister is the address that blacklisted the address.
+	Blacklister string `protobuf:"bytes,5,opt,name=blacklister,proto3" json:"blacklister,omitempty"`
+	// blacklisted_at is the block time the address was blacklisted at, unset for addresses blacklisted
+	// before this field was introduced.
+	BlacklistedAt *time.Time `protobuf:"bytes,6,opt,name=blacklisted_at,json=blacklistedAt,proto3,stdtime" json:"blacklisted_at,omitempty"`
+	// expiry is the block time after which the address is automatically unblacklisted, unset if the
+	// address stays blacklisted until it is unblacklisted.
+	Expiry *time.Time `protobuf:"bytes,7,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
 }
 
 func (m *Blacklisted) Reset()         { *m = Blacklisted{} }
@@ -66,6 +84,48 @@ func (m *Blacklisted) GetAddressBz() []byte {
 	return nil
 }
 
+func (m *Blacklisted) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Blacklisted) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Blacklisted) GetCaseID() string {
+	if m != nil {
+		return m.CaseID
+	}
+	return ""
+}
+
+func (m *Blacklisted) GetBlacklister() string {
+	if m != nil {
+		return m.Blacklister
+	}
+	return ""
+}
+
+func (m *Blacklisted) GetBlacklistedAt() *time.Time {
+	if m != nil {
+		return m.BlacklistedAt
+	}
+	return nil
+}
+
+func (m *Blacklisted) GetExpiry() *time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Blacklisted)(nil), "noble.tokenfactory.Blacklisted")
 }
@@ -73,18 +133,28 @@ func init() {
 func init() { proto.RegisterFile("tokenfactory/blacklisted.proto", fileDescriptor_43ff59c42df01ab4) }
 
 var fileDescriptor_43ff59c42df01ab4 = []byte{
-	// 165 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x4f, 0xca, 0x49, 0x4c, 0xce, 0xce, 0xc9,
-	0x2c, 0x2e, 0x49, 0x4d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0x43, 0x56, 0xa5, 0xa4, 0xcd, 0xc5, 0xed, 0x84, 0x50, 0x28, 0x24, 0xc3, 0xc5, 0x99,
-	0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0xec, 0x54, 0x25, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x13, 0x84,
-	0x10, 0x70, 0xf2, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd3, 0xf4,
-	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xb0, 0x2d, 0xba, 0x89, 0xc5, 0xc5,
-	0xa9, 0x25, 0xc5, 0x10, 0x8e, 0x7e, 0x99, 0xa9, 0x7e, 0x85, 0x3e, 0x8a, 0xeb, 0x4a, 0x2a, 0x0b,
-	0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x0e, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x18, 0x40, 0xfc,
-	0x3c, 0xba, 0x00, 0x00, 0x00,
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x6a, 0xea, 0x40,
+	0x14, 0x86, 0x1d, 0xaf, 0x46, 0x1c, 0xef, 0xbd, 0x8b, 0x41, 0x2e, 0x83, 0x5c, 0xc6, 0xd0, 0x6e,
+	0xdc, 0x34, 0x03, 0x2d, 0x42, 0xb7, 0x4d, 0x0b, 0xc5, 0x55, 0x21, 0x74, 0xd5, 0x8d, 0x4c, 0x32,
+	0xc7, 0x34, 0x98, 0x64, 0x42, 0x66, 0x2c, 0xda, 0xa7, 0xf0, 0x1d, 0xfa, 0x32, 0x5d, 0xba, 0xec,
+	0xaa, 0x2d, 0xf1, 0x45, 0x8a, 0x89, 0x12, 0xbb, 0xeb, 0x6e, 0xbe, 0xff, 0xff, 0xe7, 0x1c, 0x7e,
+	0x0e, 0x66, 0x46, 0xcd, 0x21, 0x9d, 0x89, 0xc0, 0xa8, 0x7c, 0xc5, 0xfd, 0x58, 0x04, 0xf3, 0x38,
+	0xd2, 0x06, 0xa4, 0x93, 0xe5, 0xca, 0x28, 0x42, 0x52, 0xe5, 0xc7, 0xe0, 0x1c, 0xa7, 0x06, 0xfd,
+	0x50, 0x85, 0xaa, 0xb4, 0xf9, 0xee, 0x55, 0x25, 0x07, 0xc3, 0x50, 0xa9, 0x30, 0x06, 0x5e, 0x92,
+	0xbf, 0x98, 0x71, 0x13, 0x25, 0xa0, 0x8d, 0x48, 0xb2, 0x2a, 0x70, 0xf2, 0xd2, 0xc4, 0x3d, 0xb7,
+	0x5e, 0x40, 0xfe, 0xe3, 0xae, 0x90, 0x32, 0x07, 0xad, 0xdd, 0x67, 0x8a, 0x6c, 0x34, 0xfa, 0xed,
+	0xd5, 0x02, 0xe9, 0xe3, 0xb6, 0x84, 0x54, 0x25, 0xb4, 0x69, 0xa3, 0x51, 0xd7, 0xab, 0x80, 0xfc,
+	0xc3, 0x56, 0x0e, 0x42, 0xab, 0x94, 0xfe, 0x2a, 0xe5, 0x3d, 0x91, 0x53, 0xdc, 0x09, 0x84, 0x86,
+	0x69, 0x24, 0x69, 0x6b, 0x67, 0xb8, 0xb8, 0x78, 0x1f, 0x5a, 0xd7, 0x42, 0xc3, 0xe4, 0xc6, 0xb3,
+	0x76, 0xd6, 0x44, 0x12, 0x1b, 0xf7, 0xea, 0x82, 0x39, 0x6d, 0x97, 0x13, 0x8e, 0x25, 0x72, 0x8b,
+	0xff, 0xd6, 0x28, 0xa7, 0xc2, 0x50, 0xcb, 0x46, 0xa3, 0xde, 0xf9, 0xc0, 0xa9, 0xca, 0x39, 0x87,
+	0x72, 0xce, 0xfd, 0xa1, 0x9c, 0xdb, 0x5a, 0x7f, 0x0c, 0x91, 0xf7, 0xe7, 0xe8, 0xdf, 0x95, 0x21,
+	0x97, 0xd8, 0x82, 0x65, 0x16, 0xe5, 0x2b, 0xda, 0xf9, 0xe1, 0x80, 0x7d, 0xde, 0xbd, 0x7b, 0x2d,
+	0x18, 0xda, 0x14, 0x0c, 0x7d, 0x16, 0x0c, 0xad, 0xb7, 0xac, 0xb1, 0xd9, 0xb2, 0xc6, 0xdb, 0x96,
+	0x35, 0x1e, 0xc6, 0x61, 0x64, 0x1e, 0x17, 0xbe, 0x13, 0xa8, 0x84, 0x97, 0x57, 0x39, 0x13, 0x5a,
+	0x83, 0xd1, 0x15, 0xf0, 0xa7, 0x31, 0x5f, 0xf2, 0x6f, 0xd7, 0x34, 0xab, 0x0c, 0xb4, 0x6f, 0x95,
+	0x2b, 0x2f, 0xbe, 0x06, 0x00, 0x5a, 0x86, 0x15, 0xd1, 0xea, 0x01, 0x00, 0x00,
 }
 
 func (m *Blacklisted) Marshal() (dAtA []byte, err error) {
@@ -107,6 +177,54 @@ func (m *Blacklisted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiry != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiry):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintBlacklisted(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BlacklistedAt != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.BlacklistedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.BlacklistedAt):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintBlacklisted(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Blacklister) > 0 {
+		i -= len(m.Blacklister)
+		copy(dAtA[i:], m.Blacklister)
+		i = encodeVarintBlacklisted(dAtA, i, uint64(len(m.Blacklister)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CaseID) > 0 {
+		i -= len(m.CaseID)
+		copy(dAtA[i:], m.CaseID)
+		i = encodeVarintBlacklisted(dAtA, i, uint64(len(m.CaseID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBlacklisted(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBlacklisted(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.AddressBz) > 0 {
 		i -= len(m.AddressBz)
 		copy(dAtA[i:], m.AddressBz)
@@ -138,6 +256,30 @@ func (m *Blacklisted) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBlacklisted(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
+	l = len(m.CaseID)
+	if l > 0 {
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
+	l = len(m.Blacklister)
+	if l > 0 {
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
+	if m.BlacklistedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.BlacklistedAt)
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
+	if m.Expiry != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
 	return n
 }
 
@@ -210,6 +352,206 @@ func (m *Blacklisted) Unmarshal(dAtA []byte) error {
 				m.AddressBz = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaseID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CaseID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blacklister = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlacklistedAt == nil {
+				m.BlacklistedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.BlacklistedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlacklisted(dAtA[iNdEx:])
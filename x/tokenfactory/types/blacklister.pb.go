@@ -24,6 +24,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Blacklister struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *Blacklister) Reset()         { *m = Blacklister{} }
@@ -66,6 +67,13 @@ func (m *Blacklister) GetAddress() string {
 	return ""
 }
 
+func (m *Blacklister) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Blacklister)(nil), "noble.tokenfactory.Blacklister")
 }
@@ -73,18 +81,18 @@ func init() {
 func init() { proto.RegisterFile("tokenfactory/blacklister.proto", fileDescriptor_c4e04641cbe52423) }
 
 var fileDescriptor_c4e04641cbe52423 = []byte{
-	// 163 bytes of a gzipped FileDescriptorProto
+	// 174 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0xc9, 0xcf, 0x4e,
 	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x4f, 0xca, 0x49, 0x4c, 0xce, 0xce, 0xc9,
 	0x2c, 0x2e, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0x43, 0x56, 0xa5, 0xa4, 0xce, 0xc5, 0xed, 0x84, 0x50, 0x28, 0x24, 0xc1, 0xc5, 0x9e,
-	0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0x04, 0xe3, 0x3a,
-	0xf9, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e,
-	0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x69, 0x7a, 0x66, 0x49,
-	0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd8, 0x06, 0xdd, 0xc4, 0xe2, 0xe2, 0xd4, 0x92,
-	0x62, 0x08, 0x47, 0xbf, 0xcc, 0x54, 0xbf, 0x42, 0x1f, 0xc5, 0x65, 0x25, 0x95, 0x05, 0xa9, 0xc5,
-	0x49, 0x6c, 0x60, 0x47, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5c, 0xd6, 0xfa, 0xda, 0xb6,
-	0x00, 0x00, 0x00,
+	0x49, 0xd5, 0x43, 0x56, 0xa5, 0x64, 0xcb, 0xc5, 0xed, 0x84, 0x50, 0x28, 0x24, 0xc1, 0xc5, 0x9e,
+	0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0x04, 0xe3, 0x0a,
+	0x89, 0x70, 0xb1, 0xa6, 0xa4, 0xe6, 0xe5, 0xe7, 0x4a, 0x30, 0x81, 0xc5, 0x21, 0x1c, 0x27, 0xff,
+	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
+	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28,
+	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0xdb, 0xab, 0x9b, 0x58, 0x5c, 0x9c, 0x5a, 0x52, 0x0c,
+	0xe1, 0xe8, 0x97, 0x99, 0xea, 0x57, 0xe8, 0xa3, 0xb8, 0xb7, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
+	0x0d, 0xec, 0x54, 0x63, 0xc0, 0x00, 0x82, 0x68, 0xab, 0xa8, 0xcc, 0x00, 0x00, 0x00,
 }
 
 func (m *Blacklister) Marshal() (dAtA []byte, err error) {
@@ -107,6 +115,13 @@ func (m *Blacklister) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBlacklister(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -138,6 +153,10 @@ func (m *Blacklister) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBlacklister(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBlacklister(uint64(l))
+	}
 	return n
 }
 
@@ -208,6 +227,38 @@ func (m *Blacklister) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklister
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlacklister
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklister
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlacklister(dAtA[iNdEx:])
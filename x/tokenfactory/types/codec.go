@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 func init() {
@@ -27,6 +28,34 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUnpause{}, "tokenfactory/Unpause", nil)
 	cdc.RegisterConcrete(&MsgConfigureMinterController{}, "tokenfactory/ConfigureMinterController", nil)
 	cdc.RegisterConcrete(&MsgRemoveMinterController{}, "tokenfactory/RemoveMinterController", nil)
+	cdc.RegisterConcrete(&MsgCreateDenom{}, "tokenfactory/CreateDenom", nil)
+	cdc.RegisterConcrete(&MsgIncreaseMinterAllowance{}, "tokenfactory/IncreaseMinterAllowance", nil)
+	cdc.RegisterConcrete(&MsgDecreaseMinterAllowance{}, "tokenfactory/DecreaseMinterAllowance", nil)
+	cdc.RegisterConcrete(&MsgSetMintRateLimit{}, "tokenfactory/SetMintRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveMintRateLimit{}, "tokenfactory/RemoveMintRateLimit", nil)
+	cdc.RegisterConcrete(&MsgSetMaxSupply{}, "tokenfactory/SetMaxSupply", nil)
+	cdc.RegisterConcrete(&MsgWipeBlacklistedBalance{}, "tokenfactory/WipeBlacklistedBalance", nil)
+	cdc.RegisterConcrete(&MsgSchedulePause{}, "tokenfactory/SchedulePause", nil)
+	cdc.RegisterConcrete(&MsgCancelPauseSchedule{}, "tokenfactory/CancelPauseSchedule", nil)
+	cdc.RegisterConcrete(&MsgBlacklistBatch{}, "tokenfactory/BlacklistBatch", nil)
+	cdc.RegisterConcrete(&MsgUnblacklistBatch{}, "tokenfactory/UnblacklistBatch", nil)
+	cdc.RegisterConcrete(&MsgSetAdminQuorum{}, "tokenfactory/SetAdminQuorum", nil)
+	cdc.RegisterConcrete(&MsgSubmitAdminProposal{}, "tokenfactory/SubmitAdminProposal", nil)
+	cdc.RegisterConcrete(&MsgApprove{}, "tokenfactory/Approve", nil)
+	cdc.RegisterConcrete(&MsgReject{}, "tokenfactory/Reject", nil)
+	cdc.RegisterConcrete(&MintAuthorization{}, "tokenfactory/MintAuthorization", nil)
+	cdc.RegisterConcrete(&MsgRequestRedemption{}, "tokenfactory/RequestRedemption", nil)
+	cdc.RegisterConcrete(&MsgFulfillRedemption{}, "tokenfactory/FulfillRedemption", nil)
+	cdc.RegisterConcrete(&MsgRejectRedemption{}, "tokenfactory/RejectRedemption", nil)
+	cdc.RegisterConcrete(&MsgUpdateAttestor{}, "tokenfactory/UpdateAttestor", nil)
+	cdc.RegisterConcrete(&MsgAttestReserves{}, "tokenfactory/AttestReserves", nil)
+	cdc.RegisterConcrete(&MsgUpdateDenomMetadata{}, "tokenfactory/UpdateDenomMetadata", nil)
+	cdc.RegisterConcrete(&MsgUpdateAllowlister{}, "tokenfactory/UpdateAllowlister", nil)
+	cdc.RegisterConcrete(&MsgSetAllowlistMode{}, "tokenfactory/SetAllowlistMode", nil)
+	cdc.RegisterConcrete(&MsgAllow{}, "tokenfactory/Allow", nil)
+	cdc.RegisterConcrete(&MsgDisallow{}, "tokenfactory/Disallow", nil)
+	cdc.RegisterConcrete(&MsgFreezeAmount{}, "tokenfactory/FreezeAmount", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeAmount{}, "tokenfactory/UnfreezeAmount", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -46,6 +75,37 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUnpause{},
 		&MsgConfigureMinterController{},
 		&MsgRemoveMinterController{},
+		&MsgCreateDenom{},
+		&MsgIncreaseMinterAllowance{},
+		&MsgDecreaseMinterAllowance{},
+		&MsgSetMintRateLimit{},
+		&MsgRemoveMintRateLimit{},
+		&MsgSetMaxSupply{},
+		&MsgWipeBlacklistedBalance{},
+		&MsgSchedulePause{},
+		&MsgCancelPauseSchedule{},
+		&MsgBlacklistBatch{},
+		&MsgUnblacklistBatch{},
+		&MsgSetAdminQuorum{},
+		&MsgSubmitAdminProposal{},
+		&MsgApprove{},
+		&MsgReject{},
+		&MsgRequestRedemption{},
+		&MsgFulfillRedemption{},
+		&MsgRejectRedemption{},
+		&MsgUpdateAttestor{},
+		&MsgAttestReserves{},
+		&MsgUpdateDenomMetadata{},
+		&MsgUpdateAllowlister{},
+		&MsgSetAllowlistMode{},
+		&MsgAllow{},
+		&MsgDisallow{},
+		&MsgFreezeAmount{},
+		&MsgUnfreezeAmount{},
+	)
+
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&MintAuthorization{},
 	)
 
 	// this line is used by starport scaffolding # 3
@@ -56,4 +116,5 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 var (
 	amino     = codec.NewLegacyAmino()
 	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+	aminoCdc  = codec.NewAminoCodec(amino)
 )
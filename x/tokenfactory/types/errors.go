@@ -8,14 +8,31 @@ import (
 
 // x/tokenfactory module sentinel errors
 var (
-	ErrUnauthorized       = sdkerrors.Register(ModuleName, 2, "unauthorized")
-	ErrUserNotFound       = sdkerrors.Register(ModuleName, 3, "user not found")
-	ErrMint               = sdkerrors.Register(ModuleName, 4, "tokens can not be minted")
-	ErrSendCoinsToAccount = sdkerrors.Register(ModuleName, 5, "can't send tokens to account")
-	ErrBurn               = sdkerrors.Register(ModuleName, 6, "tokens can not be burned")
-	ErrPaused             = sdkerrors.Register(ModuleName, 7, "the chain is paused")
-	ErrMintingDenomSet    = sdkerrors.Register(ModuleName, 9, "the minting denom has already been set")
-	ErrUserBlacklisted    = sdkerrors.Register(ModuleName, 10, "user is already blacklisted")
-	ErrAlreadyPrivileged  = sdkerrors.Register(ModuleName, 11, "address is already assigned to privileged role")
-	ErrDenomNotRegistered = sdkerrors.Register(ModuleName, 12, "denom not registered in bank module")
+	ErrUnauthorized          = sdkerrors.Register(ModuleName, 2, "unauthorized")
+	ErrUserNotFound          = sdkerrors.Register(ModuleName, 3, "user not found")
+	ErrMint                  = sdkerrors.Register(ModuleName, 4, "tokens can not be minted")
+	ErrSendCoinsToAccount    = sdkerrors.Register(ModuleName, 5, "can't send tokens to account")
+	ErrBurn                  = sdkerrors.Register(ModuleName, 6, "tokens can not be burned")
+	ErrPaused                = sdkerrors.Register(ModuleName, 7, "the chain is paused")
+	ErrMintingDenomSet       = sdkerrors.Register(ModuleName, 9, "the minting denom has already been set")
+	ErrUserBlacklisted       = sdkerrors.Register(ModuleName, 10, "user is already blacklisted")
+	ErrAlreadyPrivileged     = sdkerrors.Register(ModuleName, 11, "address is already assigned to privileged role")
+	ErrDenomNotRegistered    = sdkerrors.Register(ModuleName, 12, "denom not registered in bank module")
+	ErrDenomNotFound         = sdkerrors.Register(ModuleName, 13, "denom is not a tokenfactory minting denom")
+	ErrDenomExists           = sdkerrors.Register(ModuleName, 14, "denom already exists")
+	ErrAllowance             = sdkerrors.Register(ModuleName, 15, "invalid minter allowance")
+	ErrMintRateLimit         = sdkerrors.Register(ModuleName, 16, "mint rate limit exceeded")
+	ErrSupplyCap             = sdkerrors.Register(ModuleName, 17, "max supply exceeded")
+	ErrPauseSchedule         = sdkerrors.Register(ModuleName, 18, "invalid pause schedule")
+	ErrExpiry                = sdkerrors.Register(ModuleName, 19, "expiry must be after the current block time")
+	ErrAdminProposal         = sdkerrors.Register(ModuleName, 20, "invalid admin proposal")
+	ErrAdminProposalRequired = sdkerrors.Register(ModuleName, 21, "action requires an approved admin proposal")
+	ErrDuplicateReference    = sdkerrors.Register(ModuleName, 22, "reference id has already been processed")
+	ErrRedemption            = sdkerrors.Register(ModuleName, 23, "invalid redemption")
+	ErrReserveAttestation    = sdkerrors.Register(ModuleName, 24, "invalid reserve attestation")
+	ErrInsufficientReserves  = sdkerrors.Register(ModuleName, 25, "mint is not backed by attested reserves")
+	ErrNotAllowlisted        = sdkerrors.Register(ModuleName, 26, "address is not allowlisted")
+	ErrUserAllowlisted       = sdkerrors.Register(ModuleName, 27, "user is already allowlisted")
+	ErrFrozenAmount          = sdkerrors.Register(ModuleName, 28, "amount is frozen")
+	ErrMinterControllerSet   = sdkerrors.Register(ModuleName, 29, "the minter already has a minter controller")
 )
@@ -20,4 +20,11 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+}
+
+// AuthorityKeeper defines the expected interface needed to retrieve the chain authority.
+type AuthorityKeeper interface {
+	GetAuthority(ctx sdk.Context) string
 }
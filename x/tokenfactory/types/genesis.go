@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -10,15 +11,32 @@ import (
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		BlacklistedList:      []Blacklisted{},
-		Paused:               nil,
-		MasterMinter:         nil,
-		MintersList:          []Minters{},
-		Pauser:               nil,
-		Blacklister:          nil,
-		Owner:                nil,
-		MinterControllerList: []MinterController{},
-		MintingDenom:         nil,
+		BlacklistedList:         []Blacklisted{},
+		PausedList:              []Paused{},
+		MasterMinterList:        []MasterMinter{},
+		MintersList:             []Minters{},
+		PauserList:              []Pauser{},
+		BlacklisterList:         []Blacklister{},
+		AttestorList:            []Attestor{},
+		AllowlisterList:         []Allowlister{},
+		AllowlistedList:         []Allowlisted{},
+		AllowlistModeList:       []AllowlistMode{},
+		FrozenAmountList:        []FrozenAmount{},
+		OwnerList:               []Owner{},
+		MinterControllerList:    []MinterController{},
+		MintingDenomList:        []MintingDenom{},
+		MintRateLimitList:       []MintRateLimit{},
+		MintRateLimitWindowList: []MintRateLimitWindow{},
+		SupplyCapList:           []SupplyCap{},
+		PauseScheduleList:       []PauseSchedule{},
+		AuditLogList:            []AuditLogEntry{},
+		AdminQuorumList:         []AdminQuorum{},
+		AdminProposalList:       []AdminProposal{},
+		ProcessedReferenceList:  []ProcessedReference{},
+		RedemptionList:          []Redemption{},
+		MintStatsList:           []MintStats{},
+		DailyMintStatsList:      []DailyMintStats{},
+		ReserveAttestationList:  []ReserveAttestation{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -27,20 +45,118 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// Check for duplicated or invalid minting denoms
+	mintingDenoms := make(map[string]struct{})
+	for _, elem := range gs.MintingDenomList {
+		if elem.Denom == "" {
+			return fmt.Errorf("minting denom cannot be an empty string")
+		}
+		if err := sdk.ValidateDenom(elem.Denom); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid minting denom (%s)", err)
+		}
+		if _, ok := mintingDenoms[elem.Denom]; ok {
+			return fmt.Errorf("duplicated minting denom %s", elem.Denom)
+		}
+		mintingDenoms[elem.Denom] = struct{}{}
+	}
+
+	validateDenom := func(denom string) error {
+		if _, ok := mintingDenoms[denom]; !ok {
+			return sdkerrors.Wrapf(ErrDenomNotFound, "%s", denom)
+		}
+		return nil
+	}
+
 	// Check for duplicated index in blacklisted
 	blacklistedIndexMap := make(map[string]struct{})
 	for _, elem := range gs.BlacklistedList {
-		index := string(BlacklistedKey(elem.AddressBz))
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+
+		index := string(BlacklistedKey(elem.Denom, elem.AddressBz))
 		if _, ok := blacklistedIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for blacklisted")
 		}
 		blacklistedIndexMap[index] = struct{}{}
+
+		if elem.Blacklister != "" {
+			if _, err := sdk.AccAddressFromBech32(elem.Blacklister); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "blacklisted has invalid blacklister address (%s)", err)
+			}
+		}
+
+		if elem.BlacklistedAt != nil && elem.Expiry != nil && !elem.Expiry.After(*elem.BlacklistedAt) {
+			return fmt.Errorf("blacklisted expiry must be after the time it was blacklisted at")
+		}
+	}
+
+	// Check for duplicated index in allowlisted
+	allowlistedIndexMap := make(map[string]struct{})
+	for _, elem := range gs.AllowlistedList {
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+
+		index := string(AllowlistedKey(elem.Denom, elem.AddressBz))
+		if _, ok := allowlistedIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for allowlisted")
+		}
+		allowlistedIndexMap[index] = struct{}{}
+
+		if len(elem.AddressBz) == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "allowlisted address can not be empty")
+		}
+
+		if elem.Allowlister != "" {
+			if _, err := sdk.AccAddressFromBech32(elem.Allowlister); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "allowlisted has invalid allowlister address (%s)", err)
+			}
+		}
+	}
+
+	allowlistModeIndexMap := make(map[string]struct{})
+	for _, elem := range gs.AllowlistModeList {
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+
+		if _, ok := allowlistModeIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated allowlist mode for %s", elem.Denom)
+		}
+		allowlistModeIndexMap[elem.Denom] = struct{}{}
+	}
+
+	// Check for duplicated index in frozen amounts and validate their amounts
+	frozenAmountIndexMap := make(map[string]struct{})
+	for _, elem := range gs.FrozenAmountList {
+		if err := validateDenom(elem.Amount.Denom); err != nil {
+			return err
+		}
+
+		index := string(FrozenAmountKey(elem.Amount.Denom, elem.AddressBz))
+		if _, ok := frozenAmountIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for frozen amount")
+		}
+		frozenAmountIndexMap[index] = struct{}{}
+
+		if len(elem.AddressBz) == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "frozen amount address can not be empty")
+		}
+
+		if !elem.Amount.IsValid() || elem.Amount.IsZero() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid frozen amount %s", elem.Amount)
+		}
 	}
 
 	// Check for duplicated index in minters and validate minter addr and allowance
 	mintersIndexMap := make(map[string]struct{})
 	for _, elem := range gs.MintersList {
-		index := string(MintersKey(elem.Address))
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+
+		index := string(MintersKey(elem.Denom, elem.Address))
 		if _, ok := mintersIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for minters")
 		}
@@ -53,17 +169,32 @@ func (gs GenesisState) Validate() error {
 		if elem.Allowance.IsNil() || elem.Allowance.IsNegative() {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "minter allowance cannot be nil or negative")
 		}
+
+		if elem.Allowance.Denom != elem.Denom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "minter allowance denom must be %s", elem.Denom)
+		}
 	}
 
-	// Check for duplicated index in minterController and validate both controller and minter addresses
+	// Check for duplicated index in minterController, that every minter has a single controller, and validate both addresses
 	minterControllerIndexMap := make(map[string]struct{})
+	controlledMinterIndexMap := make(map[string]struct{})
 	for _, elem := range gs.MinterControllerList {
-		index := string(MinterControllerKey(elem.Controller))
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+
+		index := string(MinterControllerKey(elem.Denom, elem.Controller, elem.Minter))
 		if _, ok := minterControllerIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for minterController")
 		}
 		minterControllerIndexMap[index] = struct{}{}
 
+		minterIndex := string(MinterControllerByMinterPrefix(elem.Denom, elem.Minter))
+		if _, ok := controlledMinterIndexMap[minterIndex]; ok {
+			return fmt.Errorf("minter %s has more than one minterController", elem.Minter)
+		}
+		controlledMinterIndexMap[minterIndex] = struct{}{}
+
 		if _, err := sdk.AccAddressFromBech32(elem.Minter); err != nil {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "minter controller has invalid minter address (%s)", err)
 		}
@@ -73,46 +204,399 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
-	var addresses []sdk.AccAddress
+	pausedIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PausedList {
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
 
-	if gs.Owner != nil {
-		owner, err := sdk.AccAddressFromBech32(gs.Owner.Address)
-		if err != nil {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+		if _, ok := pausedIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated paused state for %s", elem.Denom)
+		}
+
+		if elem.Paused != (elem.Mint || elem.Burn || elem.Transfer || elem.IBCOutbound) {
+			return fmt.Errorf("paused state for %s does not match its paused operations", elem.Denom)
 		}
-		addresses = append(addresses, owner)
+		pausedIndexMap[elem.Denom] = struct{}{}
 	}
 
-	if gs.MasterMinter != nil {
-		masterMinter, err := sdk.AccAddressFromBech32(gs.MasterMinter.Address)
+	// privileged addresses, grouped by denom
+	addresses := make(map[string][]sdk.AccAddress)
+
+	addRole := func(role string, denom string, address string) error {
+		if err := validateDenom(denom); err != nil {
+			return err
+		}
+
+		acc, err := sdk.AccAddressFromBech32(address)
 		if err != nil {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid master minter address (%s)", err)
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid %s address (%s)", role, err)
 		}
-		addresses = append(addresses, masterMinter)
+
+		addresses[denom] = append(addresses[denom], acc)
+		return nil
 	}
 
-	if gs.Pauser != nil {
-		pauser, err := sdk.AccAddressFromBech32(gs.Pauser.Address)
-		if err != nil {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pauser address (%s)", err)
+	roles := make(map[string]struct{})
+	checkUnique := func(role string, denom string) error {
+		index := role + string(DenomKey(denom))
+		if _, ok := roles[index]; ok {
+			return fmt.Errorf("duplicated %s for %s", role, denom)
 		}
-		addresses = append(addresses, pauser)
+		roles[index] = struct{}{}
+		return nil
 	}
 
-	if gs.Blacklister != nil {
-		blacklister, err := sdk.AccAddressFromBech32(gs.Blacklister.Address)
-		if err != nil {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid black lister address (%s)", err)
+	for _, elem := range gs.OwnerList {
+		if err := checkUnique("owner", elem.Denom); err != nil {
+			return err
+		}
+		if err := addRole("owner", elem.Denom, elem.Address); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range gs.MasterMinterList {
+		if err := checkUnique("master minter", elem.Denom); err != nil {
+			return err
+		}
+		if err := addRole("master minter", elem.Denom, elem.Address); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range gs.PauserList {
+		if err := checkUnique("pauser", elem.Denom); err != nil {
+			return err
+		}
+		if err := addRole("pauser", elem.Denom, elem.Address); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range gs.BlacklisterList {
+		if err := checkUnique("black lister", elem.Denom); err != nil {
+			return err
+		}
+		if err := addRole("black lister", elem.Denom, elem.Address); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range gs.AttestorList {
+		if err := checkUnique("attestor", elem.Denom); err != nil {
+			return err
+		}
+		if err := addRole("attestor", elem.Denom, elem.Address); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range gs.AllowlisterList {
+		if err := checkUnique("allowlister", elem.Denom); err != nil {
+			return err
+		}
+		if err := addRole("allowlister", elem.Denom, elem.Address); err != nil {
+			return err
+		}
+	}
+
+	for _, denomAddresses := range addresses {
+		if err := validatePrivileges(denomAddresses); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated or invalid mint rate limits and their windows
+	mintRateLimitIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MintRateLimitList {
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+
+		index := string(MintRateLimitKey(elem.Denom, elem.Minter))
+		if _, ok := mintRateLimitIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for mintRateLimit")
+		}
+		mintRateLimitIndexMap[index] = struct{}{}
+
+		if elem.Minter != "" {
+			if _, err := sdk.AccAddressFromBech32(elem.Minter); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "mint rate limit has invalid minter address (%s)", err)
+			}
+		}
+
+		if elem.Window <= 0 {
+			return fmt.Errorf("mint rate limit window must be positive")
+		}
+
+		if err := elem.Limit.Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid mint rate limit (%s)", err)
+		}
+
+		if elem.Limit.Denom != elem.Denom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "mint rate limit denom must be %s", elem.Denom)
+		}
+	}
+
+	mintRateLimitWindowIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MintRateLimitWindowList {
+		index := string(MintRateLimitKey(elem.Denom, elem.Minter))
+		if _, ok := mintRateLimitIndexMap[index]; !ok {
+			return fmt.Errorf("mint rate limit window has no mint rate limit")
+		}
+
+		if _, ok := mintRateLimitWindowIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for mintRateLimitWindow")
+		}
+		mintRateLimitWindowIndexMap[index] = struct{}{}
+
+		for i, bucket := range elem.Buckets {
+			if err := bucket.Minted.Validate(); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid mint rate limit window minted amount (%s)", err)
+			}
+
+			if bucket.Minted.Denom != elem.Denom {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "mint rate limit window denom must be %s", elem.Denom)
+			}
+
+			if i > 0 && !bucket.Start.After(elem.Buckets[i-1].Start) {
+				return fmt.Errorf("mint rate limit window buckets must be ordered by start time")
+			}
 		}
-		addresses = append(addresses, blacklister)
 	}
 
-	if err := validatePrivileges(addresses); err != nil {
-		return err
+	supplyCapIndexMap := make(map[string]struct{})
+	for _, elem := range gs.SupplyCapList {
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+
+		if _, ok := supplyCapIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated supply cap for %s", elem.Denom)
+		}
+		supplyCapIndexMap[elem.Denom] = struct{}{}
+
+		if err := elem.MaxSupply.Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid max supply (%s)", err)
+		}
+
+		if elem.MaxSupply.Denom != elem.Denom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "max supply denom must be %s", elem.Denom)
+		}
+	}
+
+	pauseScheduleIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.PauseScheduleList {
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+
+		if _, ok := pauseScheduleIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated pause schedule id %d", elem.Id)
+		}
+		pauseScheduleIndexMap[elem.Id] = struct{}{}
+
+		if elem.Id >= gs.PauseScheduleCount {
+			return fmt.Errorf("pause schedule id %d should be lower than the pause schedule count %d", elem.Id, gs.PauseScheduleCount)
+		}
+
+		if err := elem.ValidateWindow(); err != nil {
+			return sdkerrors.Wrapf(ErrPauseSchedule, "pause schedule %d: %s", elem.Id, err)
+		}
+
+		if !elem.Active && len(elem.PausedOperations) > 0 {
+			return sdkerrors.Wrapf(ErrPauseSchedule, "pause schedule %d has paused operations but is not active", elem.Id)
+		}
+	}
+
+	auditLogIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.AuditLogList {
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+
+		if _, ok := auditLogIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated audit log id %d", elem.Id)
+		}
+		auditLogIndexMap[elem.Id] = struct{}{}
+
+		if elem.Id >= gs.AuditLogCount {
+			return fmt.Errorf("audit log id %d should be lower than the audit log count %d", elem.Id, gs.AuditLogCount)
+		}
+
+		if _, ok := AuditAction_name[int32(elem.Action)]; !ok || elem.Action == AuditActionUnspecified {
+			return fmt.Errorf("audit log entry %d has unknown action %d", elem.Id, elem.Action)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(elem.Actor); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "audit log entry %d has invalid actor address (%s)", elem.Id, err)
+		}
 	}
 
-	if gs.MintingDenom != nil && gs.MintingDenom.Denom == "" {
-		return fmt.Errorf("minting denom cannot be an empty string")
+	adminQuorumIndexMap := make(map[string]struct{})
+	for _, elem := range gs.AdminQuorumList {
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+
+		if _, ok := adminQuorumIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated admin quorum for denom %s", elem.Denom)
+		}
+		adminQuorumIndexMap[elem.Denom] = struct{}{}
+
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+	}
+
+	adminProposalIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.AdminProposalList {
+		if _, ok := adminQuorumIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("admin proposal %d has no admin quorum for denom %s", elem.Id, elem.Denom)
+		}
+
+		if _, ok := adminProposalIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated admin proposal id %d", elem.Id)
+		}
+		adminProposalIndexMap[elem.Id] = struct{}{}
+
+		if elem.Id >= gs.AdminProposalCount {
+			return fmt.Errorf("admin proposal id %d should be lower than the admin proposal count %d", elem.Id, gs.AdminProposalCount)
+		}
+
+		if err := validateAdminProposal(elem); err != nil {
+			return err
+		}
+	}
+
+	processedReferenceIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ProcessedReferenceList {
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "processed reference has invalid address (%s)", err)
+		}
+
+		if elem.ReferenceID == "" {
+			return fmt.Errorf("processed reference of %s has an empty reference id", elem.Address)
+		}
+
+		if err := ValidateReferenceID(elem.ReferenceID); err != nil {
+			return err
+		}
+
+		if elem.Operation != ReferenceMint && elem.Operation != ReferenceBurn {
+			return fmt.Errorf("processed reference %s of %s has an unknown operation %d", elem.ReferenceID, elem.Address, elem.Operation)
+		}
+
+		index := string(ProcessedReferenceKey(elem.Denom, elem.Address, elem.Operation, elem.ReferenceID))
+		if _, ok := processedReferenceIndexMap[index]; ok {
+			return fmt.Errorf("duplicated processed reference %s of %s", elem.ReferenceID, elem.Address)
+		}
+		processedReferenceIndexMap[index] = struct{}{}
+	}
+
+	redemptionIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.RedemptionList {
+		if err := validateDenom(elem.Amount.Denom); err != nil {
+			return err
+		}
+
+		if _, ok := redemptionIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated redemption id %d", elem.Id)
+		}
+		redemptionIndexMap[elem.Id] = struct{}{}
+
+		if elem.Id >= gs.RedemptionCount {
+			return fmt.Errorf("redemption id %d should be lower than the redemption count %d", elem.Id, gs.RedemptionCount)
+		}
+
+		if err := validateRedemption(elem); err != nil {
+			return err
+		}
+	}
+
+	reserveAttestationIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ReserveAttestationList {
+		if err := validateDenom(elem.Reserves.Denom); err != nil {
+			return err
+		}
+
+		index := string(ReserveAttestationKey(elem.Reserves.Denom, elem.Id))
+		if _, ok := reserveAttestationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated reserve attestation id %d", elem.Id)
+		}
+		reserveAttestationIndexMap[index] = struct{}{}
+
+		if elem.Id >= gs.ReserveAttestationCount {
+			return fmt.Errorf("reserve attestation id %d should be lower than the reserve attestation count %d", elem.Id, gs.ReserveAttestationCount)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(elem.Attestor); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid reserve attestation attestor address (%s)", err)
+		}
+
+		if err := elem.Reserves.Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid attested reserves (%s)", err)
+		}
+
+		if elem.Timestamp.IsZero() {
+			return fmt.Errorf("reserve attestation %d has no timestamp", elem.Id)
+		}
+	}
+
+	validateMintStatsAmounts := func(denom string, minted sdk.Coin, burned sdk.Coin) error {
+		for _, amount := range []sdk.Coin{minted, burned} {
+			if err := amount.Validate(); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid mint stats amount (%s)", err)
+			}
+
+			if amount.Denom != denom {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "mint stats denom must be %s", denom)
+			}
+		}
+		return nil
+	}
+
+	mintStatsIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MintStatsList {
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+
+		index := string(MintStatsKey(elem.Denom, elem.Minter))
+		if _, ok := mintStatsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated mint stats of %q", elem.Minter)
+		}
+		mintStatsIndexMap[index] = struct{}{}
+
+		if err := validateMintStatsAmounts(elem.Denom, elem.Minted, elem.Burned); err != nil {
+			return err
+		}
+	}
+
+	dailyMintStatsIndexMap := make(map[string]struct{})
+	for _, elem := range gs.DailyMintStatsList {
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+
+		if err := ValidateMintStatsDate(elem.Date); err != nil {
+			return err
+		}
+
+		index := string(DailyMintStatsKey(elem.Denom, elem.Minter, elem.Date))
+		if _, ok := dailyMintStatsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated daily mint stats of %q on %s", elem.Minter, elem.Date)
+		}
+		dailyMintStatsIndexMap[index] = struct{}{}
+
+		if err := validateMintStatsAmounts(elem.Denom, elem.Minted, elem.Burned); err != nil {
+			return err
+		}
 	}
 
 	// this line is used by starport scaffolding # genesis/types/validate
@@ -120,6 +604,17 @@ func (gs GenesisState) Validate() error {
 	return gs.Params.Validate()
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, proposal := range gs.AdminProposalList {
+		if err := proposal.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // validatePrivileges ensures that the same address is not being assigned to more than one privileged role.
 func validatePrivileges(addresses []sdk.AccAddress) error {
 	for i, current := range addresses {
@@ -25,16 +25,38 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the tokenfactory module's genesis state.
 type GenesisState struct {
-	Params               Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	BlacklistedList      []Blacklisted      `protobuf:"bytes,2,rep,name=blacklistedList,proto3" json:"blacklistedList"`
-	Paused               *Paused            `protobuf:"bytes,3,opt,name=paused,proto3" json:"paused,omitempty"`
-	MasterMinter         *MasterMinter      `protobuf:"bytes,4,opt,name=masterMinter,proto3" json:"masterMinter,omitempty"`
-	MintersList          []Minters          `protobuf:"bytes,5,rep,name=mintersList,proto3" json:"mintersList"`
-	Pauser               *Pauser            `protobuf:"bytes,6,opt,name=pauser,proto3" json:"pauser,omitempty"`
-	Blacklister          *Blacklister       `protobuf:"bytes,7,opt,name=blacklister,proto3" json:"blacklister,omitempty"`
-	Owner                *Owner             `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
-	MinterControllerList []MinterController `protobuf:"bytes,9,rep,name=minterControllerList,proto3" json:"minterControllerList"`
-	MintingDenom         *MintingDenom      `protobuf:"bytes,10,opt,name=mintingDenom,proto3" json:"mintingDenom,omitempty"`
+	Params                  Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	BlacklistedList         []Blacklisted         `protobuf:"bytes,2,rep,name=blacklistedList,proto3" json:"blacklistedList"`
+	PausedList              []Paused              `protobuf:"bytes,3,rep,name=pausedList,proto3" json:"pausedList"`
+	MasterMinterList        []MasterMinter        `protobuf:"bytes,4,rep,name=masterMinterList,proto3" json:"masterMinterList"`
+	MintersList             []Minters             `protobuf:"bytes,5,rep,name=mintersList,proto3" json:"mintersList"`
+	PauserList              []Pauser              `protobuf:"bytes,6,rep,name=pauserList,proto3" json:"pauserList"`
+	BlacklisterList         []Blacklister         `protobuf:"bytes,7,rep,name=blacklisterList,proto3" json:"blacklisterList"`
+	OwnerList               []Owner               `protobuf:"bytes,8,rep,name=ownerList,proto3" json:"ownerList"`
+	MinterControllerList    []MinterController    `protobuf:"bytes,9,rep,name=minterControllerList,proto3" json:"minterControllerList"`
+	MintingDenomList        []MintingDenom        `protobuf:"bytes,10,rep,name=mintingDenomList,proto3" json:"mintingDenomList"`
+	MintRateLimitList       []MintRateLimit       `protobuf:"bytes,11,rep,name=mintRateLimitList,proto3" json:"mintRateLimitList"`
+	MintRateLimitWindowList []MintRateLimitWindow `protobuf:"bytes,12,rep,name=mintRateLimitWindowList,proto3" json:"mintRateLimitWindowList"`
+	SupplyCapList           []SupplyCap           `protobuf:"bytes,13,rep,name=supplyCapList,proto3" json:"supplyCapList"`
+	PauseScheduleList       []PauseSchedule       `protobuf:"bytes,14,rep,name=pauseScheduleList,proto3" json:"pauseScheduleList"`
+	PauseScheduleCount      uint64                `protobuf:"varint,15,opt,name=pauseScheduleCount,proto3" json:"pauseScheduleCount,omitempty"`
+	AuditLogList            []AuditLogEntry       `protobuf:"bytes,16,rep,name=auditLogList,proto3" json:"auditLogList"`
+	AuditLogCount           uint64                `protobuf:"varint,17,opt,name=auditLogCount,proto3" json:"auditLogCount,omitempty"`
+	AdminQuorumList         []AdminQuorum         `protobuf:"bytes,18,rep,name=adminQuorumList,proto3" json:"adminQuorumList"`
+	AdminProposalList       []AdminProposal       `protobuf:"bytes,19,rep,name=adminProposalList,proto3" json:"adminProposalList"`
+	AdminProposalCount      uint64                `protobuf:"varint,20,opt,name=adminProposalCount,proto3" json:"adminProposalCount,omitempty"`
+	ProcessedReferenceList  []ProcessedReference  `protobuf:"bytes,21,rep,name=processedReferenceList,proto3" json:"processedReferenceList"`
+	RedemptionList          []Redemption          `protobuf:"bytes,22,rep,name=redemptionList,proto3" json:"redemptionList"`
+	RedemptionCount         uint64                `protobuf:"varint,23,opt,name=redemptionCount,proto3" json:"redemptionCount,omitempty"`
+	MintStatsList           []MintStats           `protobuf:"bytes,24,rep,name=mintStatsList,proto3" json:"mintStatsList"`
+	DailyMintStatsList      []DailyMintStats      `protobuf:"bytes,25,rep,name=dailyMintStatsList,proto3" json:"dailyMintStatsList"`
+	AttestorList            []Attestor            `protobuf:"bytes,26,rep,name=attestorList,proto3" json:"attestorList"`
+	ReserveAttestationList  []ReserveAttestation  `protobuf:"bytes,27,rep,name=reserveAttestationList,proto3" json:"reserveAttestationList"`
+	ReserveAttestationCount uint64                `protobuf:"varint,28,opt,name=reserveAttestationCount,proto3" json:"reserveAttestationCount,omitempty"`
+	AllowlisterList         []Allowlister         `protobuf:"bytes,29,rep,name=allowlisterList,proto3" json:"allowlisterList"`
+	AllowlistedList         []Allowlisted         `protobuf:"bytes,30,rep,name=allowlistedList,proto3" json:"allowlistedList"`
+	AllowlistModeList       []AllowlistMode       `protobuf:"bytes,31,rep,name=allowlistModeList,proto3" json:"allowlistModeList"`
+	FrozenAmountList        []FrozenAmount        `protobuf:"bytes,32,rep,name=frozenAmountList,proto3" json:"frozenAmountList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,16 +106,16 @@ func (m *GenesisState) GetBlacklistedList() []Blacklisted {
 	return nil
 }
 
-func (m *GenesisState) GetPaused() *Paused {
+func (m *GenesisState) GetPausedList() []Paused {
 	if m != nil {
-		return m.Paused
+		return m.PausedList
 	}
 	return nil
 }
 
-func (m *GenesisState) GetMasterMinter() *MasterMinter {
+func (m *GenesisState) GetMasterMinterList() []MasterMinter {
 	if m != nil {
-		return m.MasterMinter
+		return m.MasterMinterList
 	}
 	return nil
 }
@@ -105,23 +127,23 @@ func (m *GenesisState) GetMintersList() []Minters {
 	return nil
 }
 
-func (m *GenesisState) GetPauser() *Pauser {
+func (m *GenesisState) GetPauserList() []Pauser {
 	if m != nil {
-		return m.Pauser
+		return m.PauserList
 	}
 	return nil
 }
 
-func (m *GenesisState) GetBlacklister() *Blacklister {
+func (m *GenesisState) GetBlacklisterList() []Blacklister {
 	if m != nil {
-		return m.Blacklister
+		return m.BlacklisterList
 	}
 	return nil
 }
 
-func (m *GenesisState) GetOwner() *Owner {
+func (m *GenesisState) GetOwnerList() []Owner {
 	if m != nil {
-		return m.Owner
+		return m.OwnerList
 	}
 	return nil
 }
@@ -133,9 +155,163 @@ func (m *GenesisState) GetMinterControllerList() []MinterController {
 	return nil
 }
 
-func (m *GenesisState) GetMintingDenom() *MintingDenom {
+func (m *GenesisState) GetMintingDenomList() []MintingDenom {
 	if m != nil {
-		return m.MintingDenom
+		return m.MintingDenomList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintRateLimitList() []MintRateLimit {
+	if m != nil {
+		return m.MintRateLimitList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintRateLimitWindowList() []MintRateLimitWindow {
+	if m != nil {
+		return m.MintRateLimitWindowList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSupplyCapList() []SupplyCap {
+	if m != nil {
+		return m.SupplyCapList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPauseScheduleList() []PauseSchedule {
+	if m != nil {
+		return m.PauseScheduleList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPauseScheduleCount() uint64 {
+	if m != nil {
+		return m.PauseScheduleCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAuditLogList() []AuditLogEntry {
+	if m != nil {
+		return m.AuditLogList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditLogCount() uint64 {
+	if m != nil {
+		return m.AuditLogCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAdminQuorumList() []AdminQuorum {
+	if m != nil {
+		return m.AdminQuorumList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdminProposalList() []AdminProposal {
+	if m != nil {
+		return m.AdminProposalList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdminProposalCount() uint64 {
+	if m != nil {
+		return m.AdminProposalCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetProcessedReferenceList() []ProcessedReference {
+	if m != nil {
+		return m.ProcessedReferenceList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedemptionList() []Redemption {
+	if m != nil {
+		return m.RedemptionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedemptionCount() uint64 {
+	if m != nil {
+		return m.RedemptionCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetMintStatsList() []MintStats {
+	if m != nil {
+		return m.MintStatsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDailyMintStatsList() []DailyMintStats {
+	if m != nil {
+		return m.DailyMintStatsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestorList() []Attestor {
+	if m != nil {
+		return m.AttestorList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReserveAttestationList() []ReserveAttestation {
+	if m != nil {
+		return m.ReserveAttestationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReserveAttestationCount() uint64 {
+	if m != nil {
+		return m.ReserveAttestationCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAllowlisterList() []Allowlister {
+	if m != nil {
+		return m.AllowlisterList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowlistedList() []Allowlisted {
+	if m != nil {
+		return m.AllowlistedList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowlistModeList() []AllowlistMode {
+	if m != nil {
+		return m.AllowlistModeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenAmountList() []FrozenAmount {
+	if m != nil {
+		return m.FrozenAmountList
 	}
 	return nil
 }
@@ -147,36 +323,70 @@ func init() {
 func init() { proto.RegisterFile("tokenfactory/genesis.proto", fileDescriptor_415d5acd9b7bd461) }
 
 var fileDescriptor_415d5acd9b7bd461 = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x41, 0x8e, 0xd3, 0x30,
-	0x18, 0x85, 0x13, 0x66, 0x5a, 0xc0, 0x19, 0x09, 0xc9, 0x9a, 0x85, 0x27, 0x48, 0x99, 0x08, 0xcd,
-	0x62, 0x36, 0x24, 0x52, 0xd1, 0x48, 0x6c, 0x69, 0x2b, 0xb1, 0xa1, 0x2a, 0x0a, 0x3b, 0x16, 0x54,
-	0x49, 0x6a, 0x42, 0xd4, 0xc4, 0xae, 0x6c, 0x17, 0xe8, 0x2d, 0x38, 0x05, 0x67, 0xe9, 0xb2, 0x4b,
-	0x56, 0x08, 0xb5, 0x17, 0x41, 0xb1, 0xad, 0x34, 0x06, 0xb7, 0xdd, 0xcd, 0xe8, 0x7d, 0xef, 0xf5,
-	0x7f, 0xbf, 0xff, 0x00, 0x5f, 0xd0, 0x05, 0x26, 0x9f, 0xd3, 0x5c, 0x50, 0xb6, 0x8e, 0x0b, 0x4c,
-	0x30, 0x2f, 0x79, 0xb4, 0x64, 0x54, 0x50, 0x08, 0x09, 0xcd, 0x2a, 0x1c, 0x75, 0x09, 0xff, 0xba,
-	0xa0, 0x05, 0x95, 0x72, 0xdc, 0xfc, 0xa5, 0x48, 0x3f, 0x30, 0x52, 0xb2, 0x2a, 0xcd, 0x17, 0x55,
-	0xc9, 0x05, 0x9e, 0x9f, 0xd1, 0x99, 0xd6, 0x43, 0x43, 0xaf, 0xd3, 0x46, 0x9a, 0xd5, 0x25, 0x39,
-	0x10, 0x77, 0x26, 0x21, 0xa5, 0x59, 0x4e, 0x89, 0x60, 0xb4, 0xaa, 0x5a, 0xca, 0xb7, 0x50, 0xdc,
-	0xfe, 0x1b, 0x25, 0x11, 0x25, 0x29, 0x66, 0x73, 0x4c, 0x68, 0xad, 0x09, 0x64, 0x10, 0xf4, 0x1b,
-	0x69, 0x73, 0x6f, 0x0c, 0x65, 0x99, 0xb2, 0xb4, 0xe6, 0x47, 0xa4, 0x15, 0x6f, 0x5b, 0x5b, 0x24,
-	0x1d, 0xf8, 0xe2, 0x67, 0x0f, 0x5c, 0xbd, 0x55, 0xcb, 0xfe, 0x20, 0x52, 0x81, 0xe1, 0x6b, 0xd0,
-	0x57, 0xb1, 0xc8, 0x0d, 0xdd, 0x7b, 0x6f, 0xe0, 0x47, 0xff, 0x2f, 0x3f, 0x7a, 0x2f, 0x89, 0xe1,
-	0xe5, 0xe6, 0xf7, 0xad, 0x93, 0x68, 0x1e, 0x4e, 0xc1, 0xb3, 0xce, 0xc2, 0xdf, 0x95, 0x5c, 0xa0,
-	0x47, 0xe1, 0xc5, 0xbd, 0x37, 0xb8, 0xb5, 0x45, 0x0c, 0x0f, 0xa8, 0xce, 0xf9, 0xd7, 0x0d, 0x07,
-	0xcd, 0x28, 0x4d, 0x0d, 0x74, 0x71, 0x6a, 0x94, 0x86, 0x48, 0x34, 0x09, 0xc7, 0xe0, 0x4a, 0xbd,
-	0xda, 0x44, 0xee, 0x1c, 0x5d, 0x4a, 0x67, 0x68, 0x73, 0x4e, 0x3a, 0x5c, 0x62, 0xb8, 0xe0, 0x08,
-	0x78, 0xfa, 0xcd, 0x64, 0x8d, 0x9e, 0xac, 0xf1, 0xdc, 0x1a, 0xa2, 0x30, 0x5d, 0xa1, 0xeb, 0x6a,
-	0xc7, 0x67, 0xa8, 0x7f, 0x66, 0x7c, 0xa6, 0xc7, 0x67, 0xf0, 0x0d, 0xf0, 0x3a, 0x47, 0x89, 0x1e,
-	0x4b, 0xe3, 0x99, 0xfd, 0xb1, 0xa4, 0xeb, 0x81, 0x31, 0xe8, 0xc9, 0x8b, 0x41, 0x4f, 0xa4, 0xf9,
-	0xc6, 0x66, 0x9e, 0x36, 0x40, 0xa2, 0x38, 0xf8, 0x09, 0x5c, 0xab, 0xb1, 0x47, 0xed, 0x15, 0xcb,
-	0xd6, 0x4f, 0x65, 0xeb, 0xbb, 0xe3, 0xad, 0x0f, 0xbc, 0xae, 0x6f, 0xcd, 0x91, 0x4f, 0xa2, 0x8e,
-	0x7c, 0xdc, 0xdc, 0x38, 0x02, 0x27, 0x9e, 0xa4, 0xc3, 0x25, 0x86, 0x6b, 0x38, 0xdd, 0xec, 0x02,
-	0x77, 0xbb, 0x0b, 0xdc, 0x3f, 0xbb, 0xc0, 0xfd, 0xb1, 0x0f, 0x9c, 0xed, 0x3e, 0x70, 0x7e, 0xed,
-	0x03, 0xe7, 0xe3, 0x43, 0x51, 0x8a, 0x2f, 0xab, 0x2c, 0xca, 0x69, 0x1d, 0xcb, 0xcc, 0x97, 0x29,
-	0xe7, 0x58, 0x70, 0xf5, 0x4f, 0xfc, 0xf5, 0x21, 0xfe, 0x1e, 0x1b, 0x1f, 0x80, 0x58, 0x2f, 0x31,
-	0xcf, 0xfa, 0xf2, 0x03, 0x78, 0xf5, 0x37, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x1f, 0x15, 0x34, 0x79,
-	0x04, 0x00, 0x00,
+	// 1006 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xdd, 0x4e, 0x1c, 0x37,
+	0x14, 0xc7, 0xd9, 0x42, 0x69, 0x63, 0x20, 0x24, 0x0e, 0x0d, 0xcb, 0x02, 0xc3, 0x06, 0xa1, 0x94,
+	0x9b, 0xee, 0x4a, 0xa9, 0x22, 0xe5, 0xa6, 0x52, 0x81, 0x34, 0x55, 0x55, 0x56, 0xd0, 0x45, 0x55,
+	0xab, 0x5e, 0x74, 0x64, 0x76, 0xcc, 0x64, 0x94, 0x19, 0x7b, 0x64, 0x7b, 0x42, 0xb7, 0x2f, 0xd1,
+	0x3e, 0x56, 0x2e, 0x73, 0xd9, 0xab, 0xaa, 0x82, 0x17, 0xa9, 0xe6, 0xd8, 0xf3, 0xe1, 0x1d, 0xcf,
+	0xc2, 0xdd, 0xae, 0xcf, 0xff, 0xfc, 0x6c, 0x9f, 0x8f, 0x39, 0x46, 0x3d, 0xc5, 0xdf, 0x51, 0x76,
+	0x45, 0x26, 0x8a, 0x8b, 0xe9, 0x30, 0xa4, 0x8c, 0xca, 0x48, 0x0e, 0x52, 0xc1, 0x15, 0xc7, 0x98,
+	0xf1, 0xcb, 0x98, 0x0e, 0xea, 0x8a, 0xde, 0x46, 0xc8, 0x43, 0x0e, 0xe6, 0x61, 0xfe, 0x4b, 0x2b,
+	0x7b, 0xcf, 0x2c, 0x0a, 0x09, 0x92, 0x88, 0xf9, 0xa9, 0xe0, 0x29, 0x97, 0x24, 0x76, 0x4b, 0xe2,
+	0x98, 0x5f, 0xc7, 0x91, 0x54, 0x7e, 0xc2, 0x03, 0x6a, 0x24, 0x9e, 0x5b, 0x42, 0x83, 0x3b, 0xec,
+	0xc2, 0xd8, 0xb7, 0x6d, 0xbb, 0x52, 0x54, 0x2a, 0x5e, 0x18, 0x77, 0x6c, 0x63, 0x16, 0x44, 0xca,
+	0x8f, 0x79, 0xe8, 0x44, 0x5f, 0xc6, 0x64, 0xf2, 0x6e, 0xce, 0xd6, 0x95, 0xbd, 0xa0, 0xf7, 0x2d,
+	0xfb, 0x95, 0xe0, 0x7f, 0x52, 0xe6, 0x93, 0x84, 0x67, 0x4c, 0x39, 0x15, 0x09, 0xc9, 0x9d, 0xfd,
+	0x24, 0x62, 0x15, 0x63, 0xdf, 0x56, 0x44, 0x4c, 0xf9, 0x82, 0x28, 0xea, 0xc7, 0x51, 0x12, 0x15,
+	0x94, 0xdd, 0xa6, 0x46, 0x2a, 0xa2, 0x4c, 0xc6, 0x7a, 0x07, 0x0d, 0x33, 0x15, 0xfe, 0x84, 0x33,
+	0x25, 0x78, 0x1c, 0x97, 0x1b, 0xf5, 0x1c, 0x2a, 0xe9, 0x3e, 0x66, 0xc4, 0x54, 0xc4, 0x42, 0x3f,
+	0xa0, 0x8c, 0x27, 0x46, 0xd1, 0xb5, 0x14, 0xfc, 0x9a, 0x95, 0xdc, 0x2d, 0xcb, 0x92, 0x12, 0x41,
+	0x12, 0xe9, 0xcc, 0x7e, 0x4a, 0x32, 0x49, 0x7d, 0x39, 0x79, 0x4b, 0x83, 0x2c, 0xa6, 0x2d, 0xde,
+	0x99, 0xa4, 0x41, 0xbb, 0xa9, 0xd8, 0xf3, 0xb9, 0x6d, 0x12, 0x7c, 0x42, 0xa5, 0xa4, 0x81, 0x2f,
+	0xe8, 0x15, 0x15, 0x94, 0x4d, 0xa8, 0x33, 0x70, 0x82, 0x06, 0x34, 0x49, 0x55, 0xc4, 0x99, 0x13,
+	0x23, 0xa8, 0xa4, 0xe2, 0x3d, 0xf5, 0x75, 0x09, 0x91, 0x9a, 0xce, 0xc6, 0xc8, 0x2c, 0x4d, 0xe3,
+	0xa9, 0x3f, 0x21, 0xa9, 0x36, 0xef, 0xff, 0xf5, 0x04, 0xad, 0x7e, 0xaf, 0x7b, 0xe8, 0x42, 0x11,
+	0x45, 0xf1, 0x2b, 0xb4, 0xac, 0xe3, 0xd0, 0xed, 0xf4, 0x3b, 0x87, 0x2b, 0x2f, 0x7a, 0x83, 0x66,
+	0x4f, 0x0d, 0xce, 0x41, 0x71, 0xbc, 0xf4, 0xe1, 0xdf, 0xbd, 0x85, 0xb1, 0xd1, 0xe3, 0x33, 0xb4,
+	0x5e, 0x2b, 0xc3, 0xd3, 0x48, 0xaa, 0xee, 0x27, 0xfd, 0xc5, 0xc3, 0x95, 0x17, 0x7b, 0x2e, 0xc4,
+	0x71, 0x25, 0x35, 0x9c, 0x59, 0x6f, 0xfc, 0x2d, 0x42, 0x3a, 0xa8, 0xc0, 0x5a, 0xec, 0x2f, 0xb6,
+	0x1f, 0x27, 0x93, 0x25, 0xa6, 0xe6, 0x83, 0xc7, 0xe8, 0x91, 0xae, 0xdb, 0x11, 0x94, 0x0c, 0x70,
+	0x96, 0x80, 0xd3, 0x77, 0x71, 0x46, 0x35, 0xad, 0xa1, 0x35, 0xfc, 0xf1, 0x09, 0x5a, 0x31, 0x05,
+	0x08, 0xb8, 0x4f, 0x01, 0xb7, 0xed, 0xc4, 0x69, 0x99, 0x21, 0xd5, 0xbd, 0xca, 0xab, 0xe9, 0x23,
+	0x2d, 0xdf, 0x71, 0x35, 0x61, 0x5d, 0x4d, 0x1f, 0xc3, 0x8a, 0xb6, 0xc6, 0x7c, 0x76, 0x9f, 0x68,
+	0x8b, 0x66, 0xb4, 0x35, 0xf0, 0x1b, 0xf4, 0x00, 0x5a, 0x03, 0x50, 0x9f, 0x03, 0x6a, 0xcb, 0x85,
+	0x3a, 0xbb, 0x66, 0x25, 0xa4, 0xf2, 0xc0, 0xbf, 0xa3, 0x0d, 0x7d, 0xc1, 0x93, 0xb2, 0x79, 0x81,
+	0xf4, 0x00, 0x48, 0x07, 0xed, 0xf1, 0xa9, 0xf4, 0x06, 0xea, 0xe4, 0x40, 0x2a, 0x75, 0x6f, 0xbf,
+	0xce, 0x5b, 0x1b, 0xd8, 0x68, 0x4e, 0x2a, 0x6b, 0xda, 0x32, 0x95, 0x33, 0xfe, 0xf8, 0x67, 0xf4,
+	0x38, 0x5f, 0x1b, 0x13, 0x45, 0x4f, 0xf3, 0x4f, 0x16, 0x40, 0x57, 0x00, 0xfa, 0xac, 0x0d, 0x5a,
+	0x8a, 0x0d, 0xb5, 0x49, 0xc0, 0x21, 0xda, 0xb4, 0x16, 0x7f, 0x89, 0x58, 0xc0, 0xaf, 0x01, 0xbe,
+	0x0a, 0xf0, 0x2f, 0xef, 0x84, 0x6b, 0x17, 0xb3, 0x45, 0x1b, 0x0d, 0xff, 0x80, 0xd6, 0x74, 0x43,
+	0x9f, 0x90, 0x14, 0xf0, 0x6b, 0x80, 0xdf, 0x75, 0xe1, 0x2f, 0x0a, 0xa1, 0x81, 0xda, 0x9e, 0x79,
+	0x28, 0xa0, 0xb8, 0x2e, 0xcc, 0x27, 0x0e, 0x70, 0x0f, 0xdb, 0x43, 0x71, 0x5e, 0x17, 0x17, 0xa1,
+	0x68, 0x10, 0xf0, 0x00, 0x61, 0x6b, 0xf1, 0x24, 0x9f, 0x2f, 0xdd, 0xf5, 0x7e, 0xe7, 0x70, 0x69,
+	0xec, 0xb0, 0xe0, 0x1f, 0xd1, 0x2a, 0x0c, 0xba, 0x53, 0x1e, 0xc2, 0x09, 0x1e, 0xb5, 0x9f, 0xe0,
+	0xc8, 0xe8, 0xbe, 0x63, 0x4a, 0x4c, 0xcd, 0x09, 0x2c, 0x67, 0x7c, 0x80, 0xd6, 0x8a, 0xff, 0x7a,
+	0xdf, 0xc7, 0xb0, 0xaf, 0xbd, 0x98, 0x37, 0x12, 0x8c, 0xff, 0x9f, 0x32, 0x2e, 0x32, 0x5d, 0x57,
+	0xb8, 0xbd, 0x91, 0x8e, 0x2a, 0x69, 0xd1, 0x48, 0x33, 0xde, 0x79, 0x28, 0x61, 0xe9, 0xdc, 0x3c,
+	0x27, 0x00, 0xf9, 0x64, 0xce, 0x45, 0xea, 0xe2, 0x22, 0x94, 0x0d, 0x42, 0x1e, 0x4a, 0x6b, 0x51,
+	0x5f, 0x69, 0x43, 0x87, 0xb2, 0x69, 0xc1, 0x01, 0x7a, 0x5a, 0x0e, 0x97, 0x71, 0x31, 0x5b, 0xe0,
+	0x2c, 0x5f, 0xc0, 0x59, 0x9e, 0x3b, 0xd3, 0xda, 0xf0, 0x30, 0x07, 0x6a, 0x61, 0xe1, 0x53, 0xf4,
+	0xb0, 0x1a, 0x4d, 0x40, 0x7f, 0x0a, 0x74, 0xcf, 0x45, 0x1f, 0x97, 0x4a, 0x43, 0x9d, 0xf1, 0xc5,
+	0x87, 0x68, 0xbd, 0x5a, 0xd1, 0x17, 0xdc, 0x84, 0x0b, 0xce, 0x2e, 0xe7, 0xa5, 0x9f, 0x77, 0x45,
+	0x3e, 0xb3, 0xf4, 0x77, 0xb8, 0xdb, 0x5e, 0xfa, 0xa3, 0x42, 0x58, 0x94, 0xbe, 0xe5, 0x89, 0x7f,
+	0x45, 0x38, 0x20, 0x51, 0x3c, 0x1d, 0x59, 0xbc, 0x2d, 0xe0, 0xed, 0xbb, 0x78, 0xaf, 0x2d, 0xb5,
+	0x81, 0x3a, 0x18, 0xf8, 0x0d, 0x5a, 0x2d, 0xde, 0x74, 0xc0, 0xec, 0x01, 0x73, 0xc7, 0x59, 0x04,
+	0x46, 0x57, 0x16, 0x72, 0xcd, 0x2f, 0x4f, 0xa5, 0x19, 0xf0, 0x47, 0xd5, 0x7c, 0x07, 0xe2, 0x76,
+	0x7b, 0x2a, 0xc7, 0x0d, 0x8f, 0x22, 0x95, 0x6e, 0x16, 0x7e, 0x85, 0x36, 0x9b, 0x16, 0x9d, 0x84,
+	0x1d, 0x48, 0x42, 0x9b, 0x19, 0x5a, 0xa8, 0x7a, 0xdb, 0xc2, 0xc1, 0x76, 0xe7, 0xb4, 0x50, 0x25,
+	0x2d, 0x5b, 0xc8, 0xf6, 0xb6, 0x81, 0x7a, 0xfc, 0x7b, 0xf7, 0x01, 0x06, 0x4d, 0x60, 0x50, 0xf6,
+	0x64, 0xb1, 0x34, 0xe2, 0x81, 0xee, 0x83, 0xbd, 0x39, 0x3d, 0x59, 0x17, 0x97, 0x3d, 0x39, 0x4b,
+	0xc8, 0x87, 0x92, 0x7e, 0x39, 0x1f, 0xc1, 0xc3, 0x19, 0xa8, 0xfd, 0xf6, 0xa1, 0xf4, 0xa6, 0xa6,
+	0x2d, 0x86, 0xd2, 0xac, 0xff, 0xf1, 0xd9, 0x87, 0x1b, 0xaf, 0xf3, 0xf1, 0xc6, 0xeb, 0xfc, 0x77,
+	0xe3, 0x75, 0xfe, 0xbe, 0xf5, 0x16, 0x3e, 0xde, 0x7a, 0x0b, 0xff, 0xdc, 0x7a, 0x0b, 0xbf, 0xbd,
+	0x0c, 0x23, 0xf5, 0x36, 0xbb, 0x1c, 0x4c, 0x78, 0x32, 0x04, 0xfa, 0x57, 0x44, 0x4a, 0xaa, 0xa4,
+	0xfe, 0x33, 0x7c, 0xff, 0x72, 0xf8, 0xc7, 0xd0, 0x7a, 0xed, 0xa9, 0x69, 0x4a, 0xe5, 0xe5, 0x32,
+	0xbc, 0xf4, 0xbe, 0xfe, 0x7f, 0x00, 0x51, 0x3a, 0x64, 0xbd, 0x39, 0x0d, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -199,235 +409,1307 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MintingDenom != nil {
-		{
-			size, err := m.MintingDenom.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.FrozenAmountList) > 0 {
+		for iNdEx := len(m.FrozenAmountList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAmountList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.AllowlistModeList) > 0 {
+		for iNdEx := len(m.AllowlistModeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowlistModeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.AllowlistedList) > 0 {
+		for iNdEx := len(m.AllowlistedList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowlistedList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.AllowlisterList) > 0 {
+		for iNdEx := len(m.AllowlisterList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowlisterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
 		}
+	}
+	if m.ReserveAttestationCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ReserveAttestationCount))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
 	}
-	if len(m.MinterControllerList) > 0 {
-		for iNdEx := len(m.MinterControllerList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReserveAttestationList) > 0 {
+		for iNdEx := len(m.ReserveAttestationList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MinterControllerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReserveAttestationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.AttestorList) > 0 {
+		for iNdEx := len(m.AttestorList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttestorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.DailyMintStatsList) > 0 {
+		for iNdEx := len(m.DailyMintStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyMintStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.MintStatsList) > 0 {
+		for iNdEx := len(m.MintStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.RedemptionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RedemptionCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.RedemptionList) > 0 {
+		for iNdEx := len(m.RedemptionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.ProcessedReferenceList) > 0 {
+		for iNdEx := len(m.ProcessedReferenceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProcessedReferenceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.AdminProposalCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AdminProposalCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.AdminProposalList) > 0 {
+		for iNdEx := len(m.AdminProposalList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminProposalList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
 				i -= size
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if m.Owner != nil {
-		{
-			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.AdminQuorumList) > 0 {
+		for iNdEx := len(m.AdminQuorumList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminQuorumList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.AuditLogCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuditLogCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.AuditLogList) > 0 {
+		for iNdEx := len(m.AuditLogList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditLogList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.PauseScheduleCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PauseScheduleCount))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.PauseScheduleList) > 0 {
+		for iNdEx := len(m.PauseScheduleList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PauseScheduleList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.SupplyCapList) > 0 {
+		for iNdEx := len(m.SupplyCapList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyCapList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.MintRateLimitWindowList) > 0 {
+		for iNdEx := len(m.MintRateLimitWindowList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintRateLimitWindowList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.MintRateLimitList) > 0 {
+		for iNdEx := len(m.MintRateLimitList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintRateLimitList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.MintingDenomList) > 0 {
+		for iNdEx := len(m.MintingDenomList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintingDenomList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.MinterControllerList) > 0 {
+		for iNdEx := len(m.MinterControllerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterControllerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.OwnerList) > 0 {
+		for iNdEx := len(m.OwnerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.BlacklisterList) > 0 {
+		for iNdEx := len(m.BlacklisterList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlacklisterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PauserList) > 0 {
+		for iNdEx := len(m.PauserList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PauserList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MintersList) > 0 {
+		for iNdEx := len(m.MintersList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MasterMinterList) > 0 {
+		for iNdEx := len(m.MasterMinterList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MasterMinterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PausedList) > 0 {
+		for iNdEx := len(m.PausedList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BlacklistedList) > 0 {
+		for iNdEx := len(m.BlacklistedList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlacklistedList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BlacklistedList) > 0 {
+		for _, e := range m.BlacklistedList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedList) > 0 {
+		for _, e := range m.PausedList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MasterMinterList) > 0 {
+		for _, e := range m.MasterMinterList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintersList) > 0 {
+		for _, e := range m.MintersList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PauserList) > 0 {
+		for _, e := range m.PauserList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlacklisterList) > 0 {
+		for _, e := range m.BlacklisterList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OwnerList) > 0 {
+		for _, e := range m.OwnerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MinterControllerList) > 0 {
+		for _, e := range m.MinterControllerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintingDenomList) > 0 {
+		for _, e := range m.MintingDenomList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintRateLimitList) > 0 {
+		for _, e := range m.MintRateLimitList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintRateLimitWindowList) > 0 {
+		for _, e := range m.MintRateLimitWindowList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplyCapList) > 0 {
+		for _, e := range m.SupplyCapList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PauseScheduleList) > 0 {
+		for _, e := range m.PauseScheduleList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PauseScheduleCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PauseScheduleCount))
+	}
+	if len(m.AuditLogList) > 0 {
+		for _, e := range m.AuditLogList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AuditLogCount != 0 {
+		n += 2 + sovGenesis(uint64(m.AuditLogCount))
+	}
+	if len(m.AdminQuorumList) > 0 {
+		for _, e := range m.AdminQuorumList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdminProposalList) > 0 {
+		for _, e := range m.AdminProposalList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AdminProposalCount != 0 {
+		n += 2 + sovGenesis(uint64(m.AdminProposalCount))
+	}
+	if len(m.ProcessedReferenceList) > 0 {
+		for _, e := range m.ProcessedReferenceList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedemptionList) > 0 {
+		for _, e := range m.RedemptionList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RedemptionCount != 0 {
+		n += 2 + sovGenesis(uint64(m.RedemptionCount))
+	}
+	if len(m.MintStatsList) > 0 {
+		for _, e := range m.MintStatsList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DailyMintStatsList) > 0 {
+		for _, e := range m.DailyMintStatsList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AttestorList) > 0 {
+		for _, e := range m.AttestorList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReserveAttestationList) > 0 {
+		for _, e := range m.ReserveAttestationList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ReserveAttestationCount != 0 {
+		n += 2 + sovGenesis(uint64(m.ReserveAttestationCount))
+	}
+	if len(m.AllowlisterList) > 0 {
+		for _, e := range m.AllowlisterList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowlistedList) > 0 {
+		for _, e := range m.AllowlistedList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowlistModeList) > 0 {
+		for _, e := range m.AllowlistModeList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenAmountList) > 0 {
+		for _, e := range m.FrozenAmountList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlacklistedList = append(m.BlacklistedList, Blacklisted{})
+			if err := m.BlacklistedList[len(m.BlacklistedList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedList = append(m.PausedList, Paused{})
+			if err := m.PausedList[len(m.PausedList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinterList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterMinterList = append(m.MasterMinterList, MasterMinter{})
+			if err := m.MasterMinterList[len(m.MasterMinterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintersList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintersList = append(m.MintersList, Minters{})
+			if err := m.MintersList[len(m.MintersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauserList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauserList = append(m.PauserList, Pauser{})
+			if err := m.PauserList[len(m.PauserList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklisterList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlacklisterList = append(m.BlacklisterList, Blacklister{})
+			if err := m.BlacklisterList[len(m.BlacklisterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerList = append(m.OwnerList, Owner{})
+			if err := m.OwnerList[len(m.OwnerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterControllerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterControllerList = append(m.MinterControllerList, MinterController{})
+			if err := m.MinterControllerList[len(m.MinterControllerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenomList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintingDenomList = append(m.MintingDenomList, MintingDenom{})
+			if err := m.MintingDenomList[len(m.MintingDenomList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRateLimitList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRateLimitList = append(m.MintRateLimitList, MintRateLimit{})
+			if err := m.MintRateLimitList[len(m.MintRateLimitList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRateLimitWindowList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRateLimitWindowList = append(m.MintRateLimitWindowList, MintRateLimitWindow{})
+			if err := m.MintRateLimitWindowList[len(m.MintRateLimitWindowList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCapList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyCapList = append(m.SupplyCapList, SupplyCap{})
+			if err := m.SupplyCapList[len(m.SupplyCapList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseScheduleList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseScheduleList = append(m.PauseScheduleList, PauseSchedule{})
+			if err := m.PauseScheduleList[len(m.PauseScheduleList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseScheduleCount", wireType)
+			}
+			m.PauseScheduleCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseScheduleCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLogList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditLogList = append(m.AuditLogList, AuditLogEntry{})
+			if err := m.AuditLogList[len(m.AuditLogList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLogCount", wireType)
+			}
+			m.AuditLogCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditLogCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminQuorumList", wireType)
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.Blacklister != nil {
-		{
-			size, err := m.Blacklister.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Pauser != nil {
-		{
-			size, err := m.Pauser.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.MintersList) > 0 {
-		for iNdEx := len(m.MintersList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MintersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.MasterMinter != nil {
-		{
-			size, err := m.MasterMinter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Paused != nil {
-		{
-			size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			m.AdminQuorumList = append(m.AdminQuorumList, AdminQuorum{})
+			if err := m.AdminQuorumList[len(m.AdminQuorumList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BlacklistedList) > 0 {
-		for iNdEx := len(m.BlacklistedList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlacklistedList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminProposalList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.BlacklistedList) > 0 {
-		for _, e := range m.BlacklistedList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.Paused != nil {
-		l = m.Paused.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.MasterMinter != nil {
-		l = m.MasterMinter.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.MintersList) > 0 {
-		for _, e := range m.MintersList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.Pauser != nil {
-		l = m.Pauser.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.Blacklister != nil {
-		l = m.Blacklister.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.Owner != nil {
-		l = m.Owner.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.MinterControllerList) > 0 {
-		for _, e := range m.MinterControllerList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.MintingDenom != nil {
-		l = m.MintingDenom.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AdminProposalList = append(m.AdminProposalList, AdminProposal{})
+			if err := m.AdminProposalList[len(m.AdminProposalList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminProposalCount", wireType)
+			}
+			m.AdminProposalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminProposalCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedReferenceList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -454,13 +1736,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProcessedReferenceList = append(m.ProcessedReferenceList, ProcessedReference{})
+			if err := m.ProcessedReferenceList[len(m.ProcessedReferenceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -487,14 +1770,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlacklistedList = append(m.BlacklistedList, Blacklisted{})
-			if err := m.BlacklistedList[len(m.BlacklistedList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RedemptionList = append(m.RedemptionList, Redemption{})
+			if err := m.RedemptionList[len(m.RedemptionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionCount", wireType)
+			}
+			m.RedemptionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintStatsList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -521,16 +1823,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Paused == nil {
-				m.Paused = &Paused{}
-			}
-			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MintStatsList = append(m.MintStatsList, MintStats{})
+			if err := m.MintStatsList[len(m.MintStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyMintStatsList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -557,16 +1857,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MasterMinter == nil {
-				m.MasterMinter = &MasterMinter{}
-			}
-			if err := m.MasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DailyMintStatsList = append(m.DailyMintStatsList, DailyMintStats{})
+			if err := m.DailyMintStatsList[len(m.DailyMintStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintersList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestorList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -593,14 +1891,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintersList = append(m.MintersList, Minters{})
-			if err := m.MintersList[len(m.MintersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AttestorList = append(m.AttestorList, Attestor{})
+			if err := m.AttestorList[len(m.AttestorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAttestationList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -627,16 +1925,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pauser == nil {
-				m.Pauser = &Pauser{}
-			}
-			if err := m.Pauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReserveAttestationList = append(m.ReserveAttestationList, ReserveAttestation{})
+			if err := m.ReserveAttestationList[len(m.ReserveAttestationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAttestationCount", wireType)
+			}
+			m.ReserveAttestationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReserveAttestationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlisterList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -663,16 +1978,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Blacklister == nil {
-				m.Blacklister = &Blacklister{}
-			}
-			if err := m.Blacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllowlisterList = append(m.AllowlisterList, Allowlister{})
+			if err := m.AllowlisterList[len(m.AllowlisterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -699,16 +2012,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Owner == nil {
-				m.Owner = &Owner{}
-			}
-			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllowlistedList = append(m.AllowlistedList, Allowlisted{})
+			if err := m.AllowlistedList[len(m.AllowlistedList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinterControllerList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistModeList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -735,14 +2046,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinterControllerList = append(m.MinterControllerList, MinterController{})
-			if err := m.MinterControllerList[len(m.MinterControllerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllowlistModeList = append(m.AllowlistModeList, AllowlistMode{})
+			if err := m.AllowlistModeList[len(m.AllowlistModeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAmountList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -769,10 +2080,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MintingDenom == nil {
-				m.MintingDenom = &MintingDenom{}
-			}
-			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FrozenAmountList = append(m.FrozenAmountList, FrozenAmount{})
+			if err := m.FrozenAmountList[len(m.FrozenAmountList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,8 +1,11 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/noble-assets/noble/v5/testutil/sample"
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
@@ -12,7 +15,53 @@ import (
 
 var testAddress = sample.AccAddress()
 
+// adminProposalMsg wraps an admin message in an Any.
+func adminProposalMsg(t *testing.T, msg types.AdminMsg) *cdctypes.Any {
+	any, err := cdctypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	return any
+}
+
+// newAdminProposal returns a proposal of the test denom submitted at the unix epoch.
+func newAdminProposal(t *testing.T, id uint64, msg types.AdminMsg, expiry time.Duration) types.AdminProposal {
+	return types.AdminProposal{
+		Id:         id,
+		Denom:      "test",
+		Msg:        adminProposalMsg(t, msg),
+		Proposer:   testAddress,
+		SubmitTime: time.Unix(0, 0).UTC(),
+		Expiry:     time.Unix(0, 0).UTC().Add(expiry),
+	}
+}
+
+// newRedemption returns a redemption of the test denom requested at the unix epoch.
+func newRedemption(id uint64, status types.RedemptionStatus) types.Redemption {
+	timeout := time.Unix(0, 0).UTC().Add(time.Hour)
+	return types.Redemption{
+		Id:                 id,
+		Requester:          testAddress,
+		Amount:             sdk.NewInt64Coin("test", 10),
+		PayoutInstructions: "iban",
+		Status:             status,
+		RequestTime:        time.Unix(0, 0).UTC(),
+		Timeout:            &timeout,
+	}
+}
+
+// newReserveAttestation returns an attestation of the reserves of the test denom at the unix epoch.
+func newReserveAttestation(id uint64) types.ReserveAttestation {
+	return types.ReserveAttestation{
+		Id:         id,
+		Attestor:   testAddress,
+		Reserves:   sdk.NewInt64Coin("test", 100),
+		Timestamp:  time.Unix(0, 0).UTC(),
+		SubmitTime: time.Unix(0, 0).UTC(),
+	}
+}
+
 func TestGenesisState_Validate(t *testing.T) {
+	minterExpiry := time.Unix(0, 0).UTC().Add(time.Hour)
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -26,52 +75,215 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				MintingDenomList: []types.MintingDenom{
+					{
+						Denom: "test",
+					},
+					{
+						Denom: "other",
+					},
+				},
 				BlacklistedList: []types.Blacklisted{
 					{
 						AddressBz: sample.AddressBz(),
+						Denom:     "test",
 					},
 					{
 						AddressBz: sample.AddressBz(),
+						Denom:     "test",
 					},
 				},
-				Paused: &types.Paused{
-					Paused: true,
+				PausedList: []types.Paused{
+					{
+						Paused:   true,
+						Denom:    "test",
+						Transfer: true,
+					},
 				},
-				MasterMinter: &types.MasterMinter{
-					Address: sample.AccAddress(),
+				MasterMinterList: []types.MasterMinter{
+					{
+						Address: sample.AccAddress(),
+						Denom:   "test",
+					},
 				},
 				MintersList: []types.Minters{
 					{
 						Address:   sample.AccAddress(),
 						Allowance: sdk.NewCoin("test", sdk.NewInt(1)),
+						Denom:     "test",
 					},
 					{
 						Address:   sample.AccAddress(),
 						Allowance: sdk.NewCoin("test", sdk.NewInt(1)),
+						Denom:     "test",
+						Expiry:    &minterExpiry,
 					},
 				},
-				Pauser: &types.Pauser{
-					Address: sample.AccAddress(),
+				PauserList: []types.Pauser{
+					{
+						Address: sample.AccAddress(),
+						Denom:   "test",
+					},
 				},
-				Blacklister: &types.Blacklister{
-					Address: sample.AccAddress(),
+				BlacklisterList: []types.Blacklister{
+					{
+						Address: sample.AccAddress(),
+						Denom:   "test",
+					},
 				},
-				Owner: &types.Owner{
-					Address: sample.AccAddress(),
+				AttestorList: []types.Attestor{
+					{
+						Address: sample.AccAddress(),
+						Denom:   "test",
+					},
+				},
+				AllowlisterList: []types.Allowlister{
+					{
+						Address: sample.AccAddress(),
+						Denom:   "test",
+					},
+				},
+				AllowlistedList: []types.Allowlisted{
+					{
+						AddressBz:   sample.AddressBz(),
+						Denom:       "test",
+						Allowlister: testAddress,
+					},
+					{
+						AddressBz: sample.AddressBz(),
+						Denom:     "test",
+					},
+				},
+				AllowlistModeList: []types.AllowlistMode{
+					{
+						Denom:   "test",
+						Enabled: true,
+					},
+				},
+				FrozenAmountList: []types.FrozenAmount{
+					{
+						AddressBz: sample.AddressBz(),
+						Amount:    sdk.NewInt64Coin("test", 10),
+					},
+				},
+				OwnerList: []types.Owner{
+					{
+						Address: sample.AccAddress(),
+						Denom:   "test",
+					},
+					{
+						Address: testAddress,
+						Denom:   "other",
+					},
 				},
 				MinterControllerList: []types.MinterController{
 					{
 						Controller: sample.AccAddress(),
 						Minter:     sample.AccAddress(),
+						Denom:      "test",
 					},
 					{
 						Controller: sample.AccAddress(),
 						Minter:     sample.AccAddress(),
+						Denom:      "test",
+					},
+				},
+				MintRateLimitList: []types.MintRateLimit{
+					{
+						Denom:  "test",
+						Minter: sample.AccAddress(),
+						Window: time.Hour,
+						Limit:  sdk.NewCoin("test", sdk.NewInt(50)),
+					},
+					{
+						Denom:  "test",
+						Window: time.Hour,
+						Limit:  sdk.NewCoin("test", sdk.NewInt(200)),
+					},
+				},
+				MintRateLimitWindowList: []types.MintRateLimitWindow{
+					{
+						Denom: "test",
+						Buckets: []types.MintRateLimitBucket{
+							{Start: time.Unix(0, 0).UTC(), Minted: sdk.NewCoin("test", sdk.NewInt(20))},
+						},
+					},
+				},
+				SupplyCapList: []types.SupplyCap{
+					{
+						Denom:     "test",
+						MaxSupply: sdk.NewCoin("test", sdk.NewInt(1000)),
+					},
+				},
+				PauseScheduleList: []types.PauseSchedule{
+					{
+						Denom:       "test",
+						Id:          0,
+						StartHeight: 10,
+						EndHeight:   20,
+					},
+				},
+				PauseScheduleCount: 1,
+				AuditLogList: []types.AuditLogEntry{
+					{
+						Id:       0,
+						Denom:    "test",
+						Action:   types.AuditActionUpdatePauser,
+						Actor:    sample.AccAddress(),
+						Target:   sample.AccAddress(),
+						Height:   1,
+						Time:     time.Unix(0, 0).UTC(),
+						NewValue: "pauser",
+					},
+				},
+				AuditLogCount: 1,
+				AdminQuorumList: []types.AdminQuorum{
+					{
+						Denom:        "test",
+						Approvers:    []string{testAddress},
+						Threshold:    1,
+						VotingPeriod: time.Hour,
 					},
 				},
-				MintingDenom: &types.MintingDenom{
-					Denom: "test",
+				AdminProposalList: []types.AdminProposal{
+					{
+						Denom:      "test",
+						Id:         0,
+						Msg:        adminProposalMsg(t, types.NewMsgUpdatePauser(sample.AccAddress(), sample.AccAddress(), "test")),
+						Proposer:   testAddress,
+						Approvals:  []string{testAddress},
+						SubmitTime: time.Unix(0, 0).UTC(),
+						Expiry:     time.Unix(0, 0).UTC().Add(time.Hour),
+					},
+				},
+				AdminProposalCount: 1,
+				ProcessedReferenceList: []types.ProcessedReference{
+					{
+						Denom:       "test",
+						Address:     testAddress,
+						ReferenceID: "deposit-1",
+						Height:      1,
+						Time:        time.Unix(0, 0).UTC(),
+						Operation:   types.ReferenceMint,
+					},
+				},
+				RedemptionList: []types.Redemption{
+					newRedemption(0, types.RedemptionStatusPending),
+					newRedemption(1, types.RedemptionStatusFulfilled),
+				},
+				RedemptionCount: 2,
+				ReserveAttestationList: []types.ReserveAttestation{
+					newReserveAttestation(0),
+					newReserveAttestation(1),
+				},
+				ReserveAttestationCount: 2,
+				MintStatsList: []types.MintStats{
+					{Denom: "test", Minter: testAddress, Minted: sdk.NewInt64Coin("test", 10), Burned: sdk.NewInt64Coin("test", 5)},
+					{Denom: "test", Minted: sdk.NewInt64Coin("test", 10), Burned: sdk.NewInt64Coin("test", 5)},
+				},
+				DailyMintStatsList: []types.DailyMintStats{
+					{Denom: "test", Minter: testAddress, Date: "2024-01-01", Minted: sdk.NewInt64Coin("test", 10), Burned: sdk.NewInt64Coin("test", 5)},
+					{Denom: "test", Date: "2024-01-01", Minted: sdk.NewInt64Coin("test", 10), Burned: sdk.NewInt64Coin("test", 5)},
 				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
@@ -80,52 +292,113 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid privilege separation",
 			genState: &types.GenesisState{
-
+				MintingDenomList: []types.MintingDenom{
+					{
+						Denom: "test",
+					},
+					{
+						Denom: "other",
+					},
+				},
 				BlacklistedList: []types.Blacklisted{
 					{
 						AddressBz: sample.AddressBz(),
+						Denom:     "test",
 					},
 					{
-						sample.AddressBz(),
+						AddressBz: sample.AddressBz(),
+						Denom:     "test",
 					},
 				},
-				Paused: &types.Paused{
-					Paused: true,
+				PausedList: []types.Paused{
+					{
+						Paused:   true,
+						Denom:    "test",
+						Transfer: true,
+					},
 				},
-				MasterMinter: &types.MasterMinter{
-					Address: testAddress,
+				MasterMinterList: []types.MasterMinter{
+					{
+						Address: testAddress,
+						Denom:   "test",
+					},
 				},
 				MintersList: []types.Minters{
 					{
 						Address:   sample.AccAddress(),
 						Allowance: sdk.NewCoin("test", sdk.NewInt(1)),
+						Denom:     "test",
 					},
 					{
 						Address:   sample.AccAddress(),
 						Allowance: sdk.NewCoin("test", sdk.NewInt(1)),
+						Denom:     "test",
 					},
 				},
-				Pauser: &types.Pauser{
-					Address: testAddress,
+				PauserList: []types.Pauser{
+					{
+						Address: testAddress,
+						Denom:   "test",
+					},
 				},
-				Blacklister: &types.Blacklister{
-					Address: testAddress,
+				BlacklisterList: []types.Blacklister{
+					{
+						Address: testAddress,
+						Denom:   "test",
+					},
 				},
-				Owner: &types.Owner{
-					Address: testAddress,
+				OwnerList: []types.Owner{
+					{
+						Address: testAddress,
+						Denom:   "test",
+					},
+					{
+						Address: testAddress,
+						Denom:   "other",
+					},
 				},
 				MinterControllerList: []types.MinterController{
 					{
 						Controller: sample.AccAddress(),
 						Minter:     sample.AccAddress(),
+						Denom:      "test",
 					},
 					{
 						Controller: sample.AccAddress(),
 						Minter:     sample.AccAddress(),
+						Denom:      "test",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unregistered denom",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{
+					{
+						Denom: "test",
+					},
+				},
+				OwnerList: []types.Owner{
+					{
+						Address: sample.AccAddress(),
+						Denom:   "other",
 					},
 				},
-				MintingDenom: &types.MintingDenom{
-					Denom: "test",
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated minting denom",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{
+					{
+						Denom: "test",
+					},
+					{
+						Denom: "test",
+					},
 				},
 			},
 			valid: false,
@@ -172,6 +445,643 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "minter controller with multiple minters",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				MinterControllerList: []types.MinterController{
+					{
+						Minter:     sample.AccAddress(),
+						Controller: testAddress,
+						Denom:      "test",
+					},
+					{
+						Minter:     sample.AccAddress(),
+						Controller: testAddress,
+						Denom:      "test",
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated minterController pair",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				MinterControllerList: []types.MinterController{
+					{
+						Minter:     testAddress,
+						Controller: testAddress,
+						Denom:      "test",
+					},
+					{
+						Minter:     testAddress,
+						Controller: testAddress,
+						Denom:      "test",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "minter with multiple minterControllers",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				MinterControllerList: []types.MinterController{
+					{
+						Minter:     testAddress,
+						Controller: testAddress,
+						Denom:      "test",
+					},
+					{
+						Minter:     testAddress,
+						Controller: sample.AccAddress(),
+						Denom:      "test",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated mintRateLimit",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				MintRateLimitList: []types.MintRateLimit{
+					{
+						Denom:  "test",
+						Window: time.Hour,
+						Limit:  sdk.NewCoin("test", sdk.NewInt(50)),
+					},
+					{
+						Denom:  "test",
+						Window: time.Hour,
+						Limit:  sdk.NewCoin("test", sdk.NewInt(200)),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid mintRateLimit window",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				MintRateLimitList: []types.MintRateLimit{
+					{
+						Denom: "test",
+						Limit: sdk.NewCoin("test", sdk.NewInt(50)),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "mintRateLimitWindow without mintRateLimit",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				MintRateLimitWindowList: []types.MintRateLimitWindow{
+					{
+						Denom: "test",
+						Buckets: []types.MintRateLimitBucket{
+							{Start: time.Unix(0, 0).UTC(), Minted: sdk.NewCoin("test", sdk.NewInt(20))},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unordered mintRateLimitWindow buckets",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				MintRateLimitList: []types.MintRateLimit{
+					{
+						Denom:  "test",
+						Window: time.Hour,
+						Limit:  sdk.NewCoin("test", sdk.NewInt(200)),
+					},
+				},
+				MintRateLimitWindowList: []types.MintRateLimitWindow{
+					{
+						Denom: "test",
+						Buckets: []types.MintRateLimitBucket{
+							{Start: time.Unix(3600, 0).UTC(), Minted: sdk.NewCoin("test", sdk.NewInt(20))},
+							{Start: time.Unix(0, 0).UTC(), Minted: sdk.NewCoin("test", sdk.NewInt(20))},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated supplyCap",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				SupplyCapList: []types.SupplyCap{
+					{
+						Denom:     "test",
+						MaxSupply: sdk.NewCoin("test", sdk.NewInt(1000)),
+					},
+					{
+						Denom:     "test",
+						MaxSupply: sdk.NewCoin("test", sdk.NewInt(2000)),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "supplyCap with mismatched denom",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				SupplyCapList: []types.SupplyCap{
+					{
+						Denom:     "test",
+						MaxSupply: sdk.NewCoin("other", sdk.NewInt(1000)),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "paused without paused operations",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				PausedList:       []types.Paused{{Paused: true, Denom: "test"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated pauseSchedule",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				PauseScheduleList: []types.PauseSchedule{
+					{Denom: "test", Id: 0, StartHeight: 10, EndHeight: 20},
+					{Denom: "test", Id: 0, StartHeight: 30, EndHeight: 40},
+				},
+				PauseScheduleCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "pauseSchedule id above count",
+			genState: &types.GenesisState{
+				MintingDenomList:   []types.MintingDenom{{Denom: "test"}},
+				PauseScheduleList:  []types.PauseSchedule{{Denom: "test", Id: 1, StartHeight: 10, EndHeight: 20}},
+				PauseScheduleCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid pauseSchedule window",
+			genState: &types.GenesisState{
+				MintingDenomList:   []types.MintingDenom{{Denom: "test"}},
+				PauseScheduleList:  []types.PauseSchedule{{Denom: "test", Id: 0, StartHeight: 20, EndHeight: 10}},
+				PauseScheduleCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "blacklisted with invalid blacklister",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				BlacklistedList: []types.Blacklisted{
+					{AddressBz: sample.AddressBz(), Denom: "test", Blacklister: "invalid"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated auditLogEntry",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				AuditLogList: []types.AuditLogEntry{
+					{Id: 0, Denom: "test", Action: types.AuditActionPause, Actor: sample.AccAddress()},
+					{Id: 0, Denom: "test", Action: types.AuditActionUnpause, Actor: sample.AccAddress()},
+				},
+				AuditLogCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "auditLogEntry id above count",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				AuditLogList:     []types.AuditLogEntry{{Id: 1, Denom: "test", Action: types.AuditActionPause, Actor: sample.AccAddress()}},
+				AuditLogCount:    1,
+			},
+			valid: false,
+		},
+		{
+			desc: "auditLogEntry without action",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				AuditLogList:     []types.AuditLogEntry{{Id: 0, Denom: "test", Actor: sample.AccAddress()}},
+				AuditLogCount:    1,
+			},
+			valid: false,
+		},
+		{
+			desc: "auditLogEntry with invalid actor",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				AuditLogList:     []types.AuditLogEntry{{Id: 0, Denom: "test", Action: types.AuditActionPause, Actor: "invalid"}},
+				AuditLogCount:    1,
+			},
+			valid: false,
+		},
+		{
+			desc: "negative audit log retention",
+			genState: &types.GenesisState{
+				Params: types.NewParams(-time.Hour, types.DefaultReferenceIDRetention, types.DefaultRedemptionTimeout, types.DefaultMaxAttestationAge, types.DefaultMinRedemptionAmount, types.DefaultRedemptionRetention),
+			},
+			valid: false,
+		},
+		{
+			desc: "negative reference id retention",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultAuditLogRetention, -time.Hour, types.DefaultRedemptionTimeout, types.DefaultMaxAttestationAge, types.DefaultMinRedemptionAmount, types.DefaultRedemptionRetention),
+			},
+			valid: false,
+		},
+		{
+			desc: "negative redemption timeout",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultAuditLogRetention, types.DefaultReferenceIDRetention, -time.Hour, types.DefaultMaxAttestationAge, types.DefaultMinRedemptionAmount, types.DefaultRedemptionRetention),
+			},
+			valid: false,
+		},
+		{
+			desc: "negative max attestation age",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultAuditLogRetention, types.DefaultReferenceIDRetention, types.DefaultRedemptionTimeout, -time.Hour, types.DefaultMinRedemptionAmount, types.DefaultRedemptionRetention),
+			},
+			valid: false,
+		},
+		{
+			desc: "negative min redemption amount",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultAuditLogRetention, types.DefaultReferenceIDRetention, types.DefaultRedemptionTimeout, types.DefaultMaxAttestationAge, sdk.NewInt(-1), types.DefaultRedemptionRetention),
+			},
+			valid: false,
+		},
+		{
+			desc: "negative redemption retention",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultAuditLogRetention, types.DefaultReferenceIDRetention, types.DefaultRedemptionTimeout, types.DefaultMaxAttestationAge, types.DefaultMinRedemptionAmount, -time.Hour),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated adminQuorum",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				AdminQuorumList: []types.AdminQuorum{
+					{Denom: "test", Approvers: []string{testAddress}, Threshold: 1, VotingPeriod: time.Hour},
+					{Denom: "test", Approvers: []string{testAddress}, Threshold: 1, VotingPeriod: time.Hour},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "adminQuorum with unreachable threshold",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				AdminQuorumList:  []types.AdminQuorum{{Denom: "test", Approvers: []string{testAddress}, Threshold: 2, VotingPeriod: time.Hour}},
+			},
+			valid: false,
+		},
+		{
+			desc: "adminProposal without adminQuorum",
+			genState: &types.GenesisState{
+				MintingDenomList:   []types.MintingDenom{{Denom: "test"}},
+				AdminProposalList:  []types.AdminProposal{newAdminProposal(t, 0, types.NewMsgUpdatePauser(testAddress, testAddress, "test"), time.Hour)},
+				AdminProposalCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated adminProposal",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				AdminQuorumList:  []types.AdminQuorum{{Denom: "test", Approvers: []string{testAddress}, Threshold: 1, VotingPeriod: time.Hour}},
+				AdminProposalList: []types.AdminProposal{
+					newAdminProposal(t, 0, types.NewMsgUpdatePauser(testAddress, testAddress, "test"), time.Hour),
+					newAdminProposal(t, 0, types.NewMsgUpdateBlacklister(testAddress, testAddress, "test"), time.Hour),
+				},
+				AdminProposalCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "adminProposal id above count",
+			genState: &types.GenesisState{
+				MintingDenomList:   []types.MintingDenom{{Denom: "test"}},
+				AdminQuorumList:    []types.AdminQuorum{{Denom: "test", Approvers: []string{testAddress}, Threshold: 1, VotingPeriod: time.Hour}},
+				AdminProposalList:  []types.AdminProposal{newAdminProposal(t, 1, types.NewMsgUpdatePauser(testAddress, testAddress, "test"), time.Hour)},
+				AdminProposalCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "adminProposal of a message that is not an admin message",
+			genState: &types.GenesisState{
+				MintingDenomList:   []types.MintingDenom{{Denom: "test"}},
+				AdminQuorumList:    []types.AdminQuorum{{Denom: "test", Approvers: []string{testAddress}, Threshold: 1, VotingPeriod: time.Hour}},
+				AdminProposalList:  []types.AdminProposal{newAdminProposal(t, 0, types.NewMsgPause(testAddress, "test", nil), time.Hour)},
+				AdminProposalCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "adminProposal of a message of another denom",
+			genState: &types.GenesisState{
+				MintingDenomList:   []types.MintingDenom{{Denom: "test"}},
+				AdminQuorumList:    []types.AdminQuorum{{Denom: "test", Approvers: []string{testAddress}, Threshold: 1, VotingPeriod: time.Hour}},
+				AdminProposalList:  []types.AdminProposal{newAdminProposal(t, 0, types.NewMsgUpdatePauser(testAddress, testAddress, "other"), time.Hour)},
+				AdminProposalCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "adminProposal expiring when submitted",
+			genState: &types.GenesisState{
+				MintingDenomList:   []types.MintingDenom{{Denom: "test"}},
+				AdminQuorumList:    []types.AdminQuorum{{Denom: "test", Approvers: []string{testAddress}, Threshold: 1, VotingPeriod: time.Hour}},
+				AdminProposalList:  []types.AdminProposal{newAdminProposal(t, 0, types.NewMsgUpdatePauser(testAddress, testAddress, "test"), 0)},
+				AdminProposalCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated processedReference",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				ProcessedReferenceList: []types.ProcessedReference{
+					{Denom: "test", Address: testAddress, ReferenceID: "deposit-1", Height: 1, Operation: types.ReferenceMint},
+					{Denom: "test", Address: testAddress, ReferenceID: "deposit-1", Height: 2, Operation: types.ReferenceMint},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "processedReference with unknown operation",
+			genState: &types.GenesisState{
+				MintingDenomList:       []types.MintingDenom{{Denom: "test"}},
+				ProcessedReferenceList: []types.ProcessedReference{{Denom: "test", Address: testAddress, ReferenceID: "deposit-1"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "processedReference with invalid address",
+			genState: &types.GenesisState{
+				MintingDenomList:       []types.MintingDenom{{Denom: "test"}},
+				ProcessedReferenceList: []types.ProcessedReference{{Denom: "test", Address: "invalid", ReferenceID: "deposit-1", Operation: types.ReferenceMint}},
+			},
+			valid: false,
+		},
+		{
+			desc: "processedReference with empty reference id",
+			genState: &types.GenesisState{
+				MintingDenomList:       []types.MintingDenom{{Denom: "test"}},
+				ProcessedReferenceList: []types.ProcessedReference{{Denom: "test", Address: testAddress, Operation: types.ReferenceMint}},
+			},
+			valid: false,
+		},
+		{
+			desc: "processedReference with too long reference id",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				ProcessedReferenceList: []types.ProcessedReference{
+					{Denom: "test", Address: testAddress, ReferenceID: strings.Repeat("a", types.MaxReferenceIDLength+1), Operation: types.ReferenceMint},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated redemption",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				RedemptionList: []types.Redemption{
+					newRedemption(0, types.RedemptionStatusPending),
+					newRedemption(0, types.RedemptionStatusRejected),
+				},
+				RedemptionCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "redemption id above count",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				RedemptionList:   []types.Redemption{newRedemption(1, types.RedemptionStatusPending)},
+				RedemptionCount:  1,
+			},
+			valid: false,
+		},
+		{
+			desc: "redemption with unspecified status",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				RedemptionList:   []types.Redemption{newRedemption(0, types.RedemptionStatusUnspecified)},
+				RedemptionCount:  1,
+			},
+			valid: false,
+		},
+		{
+			desc: "redemption of a denom that is not a minting denom",
+			genState: &types.GenesisState{
+				RedemptionList:  []types.Redemption{newRedemption(0, types.RedemptionStatusPending)},
+				RedemptionCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated attestor",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				AttestorList: []types.Attestor{
+					{Address: sample.AccAddress(), Denom: "test"},
+					{Address: sample.AccAddress(), Denom: "test"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "attestor with another privileged role",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				PauserList:       []types.Pauser{{Address: testAddress, Denom: "test"}},
+				AttestorList:     []types.Attestor{{Address: testAddress, Denom: "test"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated allowlister",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				AllowlisterList: []types.Allowlister{
+					{Address: sample.AccAddress(), Denom: "test"},
+					{Address: sample.AccAddress(), Denom: "test"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "allowlister with another privileged role",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				BlacklisterList:  []types.Blacklister{{Address: testAddress, Denom: "test"}},
+				AllowlisterList:  []types.Allowlister{{Address: testAddress, Denom: "test"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated allowlisted",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				AllowlistedList: []types.Allowlisted{
+					{AddressBz: []byte("address"), Denom: "test"},
+					{AddressBz: []byte("address"), Denom: "test"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "allowlisted without address",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				AllowlistedList:  []types.Allowlisted{{Denom: "test"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "allowlisted with invalid allowlister",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				AllowlistedList:  []types.Allowlisted{{AddressBz: sample.AddressBz(), Denom: "test", Allowlister: "invalid"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "allowlisted of unknown denom",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				AllowlistedList:  []types.Allowlisted{{AddressBz: sample.AddressBz(), Denom: "other"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated allowlistMode",
+			genState: &types.GenesisState{
+				MintingDenomList:  []types.MintingDenom{{Denom: "test"}},
+				AllowlistModeList: []types.AllowlistMode{{Denom: "test", Enabled: true}, {Denom: "test"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated frozenAmount",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				FrozenAmountList: []types.FrozenAmount{
+					{AddressBz: []byte("address"), Amount: sdk.NewInt64Coin("test", 1)},
+					{AddressBz: []byte("address"), Amount: sdk.NewInt64Coin("test", 2)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "frozenAmount without address",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				FrozenAmountList: []types.FrozenAmount{{Amount: sdk.NewInt64Coin("test", 1)}},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero frozenAmount",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				FrozenAmountList: []types.FrozenAmount{{AddressBz: sample.AddressBz(), Amount: sdk.NewInt64Coin("test", 0)}},
+			},
+			valid: false,
+		},
+		{
+			desc: "frozenAmount of unknown denom",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				FrozenAmountList: []types.FrozenAmount{{AddressBz: sample.AddressBz(), Amount: sdk.NewInt64Coin("other", 1)}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated reserveAttestation",
+			genState: &types.GenesisState{
+				MintingDenomList:        []types.MintingDenom{{Denom: "test"}},
+				ReserveAttestationList:  []types.ReserveAttestation{newReserveAttestation(0), newReserveAttestation(0)},
+				ReserveAttestationCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "reserveAttestation id above count",
+			genState: &types.GenesisState{
+				MintingDenomList:        []types.MintingDenom{{Denom: "test"}},
+				ReserveAttestationList:  []types.ReserveAttestation{newReserveAttestation(1)},
+				ReserveAttestationCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "reserveAttestation without timestamp",
+			genState: &types.GenesisState{
+				MintingDenomList:        []types.MintingDenom{{Denom: "test"}},
+				ReserveAttestationList:  []types.ReserveAttestation{{Attestor: testAddress, Reserves: sdk.NewInt64Coin("test", 100)}},
+				ReserveAttestationCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated mintStats",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				MintStatsList: []types.MintStats{
+					{Denom: "test", Minter: testAddress, Minted: sdk.NewInt64Coin("test", 10), Burned: sdk.NewInt64Coin("test", 0)},
+					{Denom: "test", Minter: testAddress, Minted: sdk.NewInt64Coin("test", 5), Burned: sdk.NewInt64Coin("test", 0)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "mintStats of another denom",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				MintStatsList: []types.MintStats{
+					{Denom: "test", Minted: sdk.NewInt64Coin("other", 10), Burned: sdk.NewInt64Coin("test", 0)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated dailyMintStats",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				DailyMintStatsList: []types.DailyMintStats{
+					{Denom: "test", Date: "2024-01-01", Minted: sdk.NewInt64Coin("test", 10), Burned: sdk.NewInt64Coin("test", 0)},
+					{Denom: "test", Date: "2024-01-01", Minted: sdk.NewInt64Coin("test", 5), Burned: sdk.NewInt64Coin("test", 0)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "dailyMintStats with invalid date",
+			genState: &types.GenesisState{
+				MintingDenomList: []types.MintingDenom{{Denom: "test"}},
+				DailyMintStatsList: []types.DailyMintStats{
+					{Denom: "test", Date: "01/01/2024", Minted: sdk.NewInt64Coin("test", 10), Burned: sdk.NewInt64Coin("test", 0)},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
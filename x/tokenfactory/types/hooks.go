@@ -0,0 +1,89 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TokenFactoryHooks lets other modules act on changes to tokenfactory minting denoms. A hook that
+// returns an error aborts the transaction that caused the change.
+type TokenFactoryHooks interface {
+	// BeforeMint is called once a mint has passed all checks, before any coins are minted.
+	BeforeMint(ctx sdk.Context, minter string, recipient string, amount sdk.Coin) error
+	// AfterMint is called once minted coins have been sent to the recipient.
+	AfterMint(ctx sdk.Context, minter string, recipient string, amount sdk.Coin) error
+	// AfterBurn is called once coins have been burned by a minter, either directly or by fulfilling a
+	// redemption, or by the owner wiping a blacklisted balance.
+	AfterBurn(ctx sdk.Context, burner string, amount sdk.Coin) error
+	// AfterBlacklist is called once an address has been blacklisted for a denom.
+	AfterBlacklist(ctx sdk.Context, denom string, address string) error
+	// AfterUnblacklist is called once an address has been removed from the blacklist of a denom,
+	// including when its blacklisting expires.
+	AfterUnblacklist(ctx sdk.Context, denom string, address string) error
+	// AfterPauseChange is called once operations of a denom have been paused or unpaused, with the
+	// resulting paused state.
+	AfterPauseChange(ctx sdk.Context, denom string, paused Paused) error
+}
+
+var _ TokenFactoryHooks = MultiTokenFactoryHooks{}
+
+// MultiTokenFactoryHooks combines multiple hooks, which are called in order until one of them
+// returns an error.
+type MultiTokenFactoryHooks []TokenFactoryHooks
+
+func NewMultiTokenFactoryHooks(hooks ...TokenFactoryHooks) MultiTokenFactoryHooks {
+	return hooks
+}
+
+func (h MultiTokenFactoryHooks) BeforeMint(ctx sdk.Context, minter string, recipient string, amount sdk.Coin) error {
+	for i := range h {
+		if err := h[i].BeforeMint(ctx, minter, recipient, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTokenFactoryHooks) AfterMint(ctx sdk.Context, minter string, recipient string, amount sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterMint(ctx, minter, recipient, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTokenFactoryHooks) AfterBurn(ctx sdk.Context, burner string, amount sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterBurn(ctx, burner, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTokenFactoryHooks) AfterBlacklist(ctx sdk.Context, denom string, address string) error {
+	for i := range h {
+		if err := h[i].AfterBlacklist(ctx, denom, address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTokenFactoryHooks) AfterUnblacklist(ctx sdk.Context, denom string, address string) error {
+	for i := range h {
+		if err := h[i].AfterUnblacklist(ctx, denom, address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTokenFactoryHooks) AfterPauseChange(ctx sdk.Context, denom string, paused Paused) error {
+	for i := range h {
+		if err := h[i].AfterPauseChange(ctx, denom, paused); err != nil {
+			return err
+		}
+	}
+	return nil
+}
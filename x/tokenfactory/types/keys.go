@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "tokenfactory"
@@ -22,26 +28,234 @@ const (
 	BlacklistedKeyPrefix      = "Blacklisted/value/"
 	MintersKeyPrefix          = "Minters/value/"
 	MinterControllerKeyPrefix = "MinterController/value/"
+
+	MinterControllerByMinterKeyPrefix = "MinterControllerByMinter/value/"
+
+	MintersExpiryKeyPrefix = "MintersExpiry/value/"
+
+	BlacklistedExpiryKeyPrefix = "BlacklistedExpiry/value/"
+
+	MintRateLimitKeyPrefix       = "MintRateLimit/value/"
+	MintRateLimitWindowKeyPrefix = "MintRateLimitWindow/value/"
+
+	SupplyCapKey = "SupplyCap/value/"
+
+	PauseScheduleKeyPrefix         = "PauseSchedule/value/"
+	PauseScheduleCountKey          = "PauseSchedule/count/"
+	PauseScheduleByHeightKeyPrefix = "PauseScheduleByHeight/value/"
+	PauseScheduleByTimeKeyPrefix   = "PauseScheduleByTime/value/"
+
+	AuditLogKeyPrefix = "AuditLog/value/"
+	AuditLogCountKey  = "AuditLog/count/"
+
+	AdminQuorumKeyPrefix   = "AdminQuorum/value/"
+	AdminProposalKeyPrefix = "AdminProposal/value/"
+	AdminProposalCountKey  = "AdminProposal/count/"
+
+	AdminProposalExpiryKeyPrefix = "AdminProposalExpiry/value/"
+
+	ProcessedReferenceKeyPrefix       = "ProcessedReference/value/"
+	ProcessedReferenceByTimeKeyPrefix = "ProcessedReferenceByTime/value/"
+
+	RedemptionKeyPrefix            = "Redemption/value/"
+	RedemptionCountKey             = "Redemption/count/"
+	RedemptionByRequesterKeyPrefix = "RedemptionByRequester/value/"
+	RedemptionByStatusKeyPrefix    = "RedemptionByStatus/value/"
+	RedemptionTimeoutKeyPrefix     = "RedemptionTimeout/value/"
+	RedemptionResolvedKeyPrefix    = "RedemptionResolved/value/"
+
+	AttestorKey = "Attestor/value/"
+
+	ReserveAttestationKeyPrefix = "ReserveAttestation/value/"
+	ReserveAttestationCountKey  = "ReserveAttestation/count/"
+
+	MintStatsKeyPrefix      = "MintStats/value/"
+	DailyMintStatsKeyPrefix = "DailyMintStats/value/"
+
+	AllowlisterKey         = "Allowlister/value/"
+	AllowlistedKeyPrefix   = "Allowlisted/value/"
+	AllowlistModeKeyPrefix = "AllowlistMode/value/"
+
+	FrozenAmountKeyPrefix = "FrozenAmount/value/"
+	TotalFrozenKeyPrefix  = "TotalFrozen/value/"
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
 
+// DenomKey returns the length prefixed store key segment that scopes a value to a specific denom.
+// The length prefix ensures that denoms containing a "/" can not collide with each other.
+func DenomKey(denom string) []byte {
+	return append([]byte{byte(len(denom))}, []byte(denom)...)
+}
+
+// DenomPrefix returns the store key prefix of all values stored under p for a specific denom
+func DenomPrefix(p string, denom string) []byte {
+	return append(KeyPrefix(p), DenomKey(denom)...)
+}
+
 // BlacklistedKey returns the store key to retrieve a Blacklisted from the index fields
-func BlacklistedKey(addressBz []byte) []byte {
-	return append(addressBz, []byte("/")...)
+func BlacklistedKey(denom string, addressBz []byte) []byte {
+	key := append(DenomKey(denom), addressBz...)
+	return append(key, []byte("/")...)
+}
+
+// BlacklistedExpiryKey returns the store key of the expiry index entry of a Blacklisted. Keys are
+// ordered by expiry so that expired entries can be iterated in order.
+func BlacklistedExpiryKey(expiry time.Time, denom string, addressBz []byte) []byte {
+	return append(sdk.FormatTimeBytes(expiry), BlacklistedKey(denom, addressBz)...)
+}
+
+// AllowlistedKey returns the store key to retrieve an Allowlisted from the index fields
+func AllowlistedKey(denom string, addressBz []byte) []byte {
+	key := append(DenomKey(denom), addressBz...)
+	return append(key, []byte("/")...)
+}
+
+// FrozenAmountKey returns the store key to retrieve a FrozenAmount from the index fields
+func FrozenAmountKey(denom string, addressBz []byte) []byte {
+	key := append(DenomKey(denom), addressBz...)
+	return append(key, []byte("/")...)
 }
 
 // MintersKey returns the store key to retrieve a Minters from the index fields
-func MintersKey(address string) []byte {
-	return append([]byte(address), []byte("/")...)
+func MintersKey(denom string, address string) []byte {
+	key := append(DenomKey(denom), []byte(address)...)
+	return append(key, []byte("/")...)
+}
+
+// MintersExpiryKey returns the store key of the expiry index entry of a Minters. Keys are ordered
+// by expiry so that expired allowances can be iterated in order.
+func MintersExpiryKey(expiry time.Time, denom string, address string) []byte {
+	return append(sdk.FormatTimeBytes(expiry), MintersKey(denom, address)...)
+}
+
+// ReserveAttestationKey returns the store key to retrieve a ReserveAttestation from the index fields.
+// Keys of the same denom are ordered by id, so that the last one is the latest attestation.
+func ReserveAttestationKey(denom string, id uint64) []byte {
+	return append(DenomKey(denom), sdk.Uint64ToBigEndian(id)...)
+}
+
+// MintStatsKey returns the store key to retrieve a MintStats from the index fields. The counters
+// across all minters of a denom are stored under an empty minter address.
+func MintStatsKey(denom string, minter string) []byte {
+	key := append(DenomKey(denom), []byte(minter)...)
+	return append(key, []byte("/")...)
+}
+
+// DailyMintStatsKey returns the store key to retrieve a DailyMintStats from the index fields. Keys
+// of the same minter are ordered by date so that a date range can be iterated in order.
+func DailyMintStatsKey(denom string, minter string, date string) []byte {
+	return append(MintStatsKey(denom, minter), []byte(date)...)
+}
+
+// MintRateLimitKey returns the store key to retrieve a MintRateLimit or MintRateLimitWindow from the index fields.
+// The limit across all minters of a denom is stored under an empty minter address.
+func MintRateLimitKey(denom string, minter string) []byte {
+	key := append(DenomKey(denom), []byte(minter)...)
+	return append(key, []byte("/")...)
+}
+
+// PauseScheduleKey returns the store key to retrieve a PauseSchedule from the index fields
+func PauseScheduleKey(denom string, id uint64) []byte {
+	return append(DenomKey(denom), sdk.Uint64ToBigEndian(id)...)
+}
+
+// PauseScheduleByHeightKey returns the store key of the height index entry of a PauseSchedule, under
+// the height its window starts at if it is pending or ends at if it is active.
+func PauseScheduleByHeightKey(height int64, denom string, id uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), PauseScheduleKey(denom, id)...)
+}
+
+// PauseScheduleByTimeKey returns the store key of the time index entry of a PauseSchedule, under
+// the time its window starts at if it is pending or ends at if it is active.
+func PauseScheduleByTimeKey(t time.Time, denom string, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(t), PauseScheduleKey(denom, id)...)
+}
+
+// AdminProposalKey returns the store key to retrieve an AdminProposal from the index fields
+func AdminProposalKey(denom string, id uint64) []byte {
+	return append(DenomKey(denom), sdk.Uint64ToBigEndian(id)...)
+}
+
+// AdminProposalExpiryKey returns the store key of the expiry index entry of an AdminProposal. Keys
+// are ordered by expiry so that expired proposals can be iterated in order.
+func AdminProposalExpiryKey(expiry time.Time, denom string, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(expiry), AdminProposalKey(denom, id)...)
+}
+
+// ProcessedReferenceKey returns the store key to retrieve a ProcessedReference from the index fields
+func ProcessedReferenceKey(denom string, address string, operation ReferenceOperation, referenceID string) []byte {
+	key := append(DenomKey(denom), []byte(address)...)
+	key = append(key, []byte("/")...)
+	key = append(key, byte(operation))
+	return append(key, []byte(referenceID)...)
+}
+
+// ProcessedReferenceByTimeKey returns the store key of the time index entry of a ProcessedReference.
+// Keys are ordered by the time the reference was processed so that they can be pruned in order.
+func ProcessedReferenceByTimeKey(processed time.Time, denom string, address string, operation ReferenceOperation, referenceID string) []byte {
+	return append(sdk.FormatTimeBytes(processed), ProcessedReferenceKey(denom, address, operation, referenceID)...)
+}
+
+// RedemptionKey returns the store key to retrieve a Redemption from its id
+func RedemptionKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// RedemptionRequesterPrefix returns the prefix of the requester index entries of the redemptions of a requester
+func RedemptionRequesterPrefix(requester string) []byte {
+	return append(KeyPrefix(RedemptionByRequesterKeyPrefix), []byte(requester+"/")...)
+}
+
+// RedemptionStatusPrefix returns the prefix of the status index entries of the redemptions with a status
+func RedemptionStatusPrefix(status RedemptionStatus) []byte {
+	return append(KeyPrefix(RedemptionByStatusKeyPrefix), sdk.Uint64ToBigEndian(uint64(status))...)
+}
+
+// RedemptionTimeoutKey returns the store key of the timeout index entry of a pending Redemption.
+// Keys are ordered by timeout so that the timed out redemptions can be iterated in order.
+func RedemptionTimeoutKey(timeout time.Time, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(timeout), RedemptionKey(id)...)
+}
+
+// RedemptionResolvedKey returns the store key of the resolve time index entry of a resolved Redemption.
+// Keys are ordered by resolve time so that resolved redemptions can be pruned in order.
+func RedemptionResolvedKey(resolveTime time.Time, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(resolveTime), RedemptionKey(id)...)
+}
+
+// AuditLogKey returns the store key to retrieve an AuditLogEntry from its id. Entries are appended
+// in order, so iterating the keys returns the oldest entries first.
+func AuditLogKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
 }
 
 // MinterControllerKey returns the store key to retrieve a MinterController from the index fields
-func MinterControllerKey(controllerAddress string) []byte {
-	return append([]byte(controllerAddress), []byte("/")...)
+func MinterControllerKey(denom string, controllerAddress string, minterAddress string) []byte {
+	key := MinterControllerPrefix(denom, controllerAddress)
+	key = append(key, []byte(minterAddress)...)
+	return append(key, []byte("/")...)
+}
+
+// MinterControllerPrefix returns the store key prefix of all MinterControllers of a controller
+func MinterControllerPrefix(denom string, controllerAddress string) []byte {
+	key := append(DenomKey(denom), []byte(controllerAddress)...)
+	return append(key, []byte("/")...)
+}
+
+// MinterControllerByMinterKey returns the store key to retrieve a MinterController from the minter index
+func MinterControllerByMinterKey(denom string, minterAddress string, controllerAddress string) []byte {
+	key := MinterControllerByMinterPrefix(denom, minterAddress)
+	key = append(key, []byte(controllerAddress)...)
+	return append(key, []byte("/")...)
+}
 
+// MinterControllerByMinterPrefix returns the store key prefix of all MinterControllers of a minter
+func MinterControllerByMinterPrefix(denom string, minterAddress string) []byte {
+	key := append(DenomKey(denom), []byte(minterAddress)...)
+	return append(key, []byte("/")...)
 }
 
 const (
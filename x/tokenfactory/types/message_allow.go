@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAllow = "allow"
+
+var _ sdk.Msg = &MsgAllow{}
+
+func NewMsgAllow(from, address, denom string) *MsgAllow {
+	return &MsgAllow{
+		From:    from,
+		Address: address,
+		Denom:   denom,
+	}
+}
+
+func (msg *MsgAllow) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAllow) Type() string {
+	return TypeMsgAllow
+}
+
+func (msg *MsgAllow) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgAllow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAllow) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if len(msg.Address) <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address length cannot be less than or equal to 0")
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAllow_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAllow
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgAllow{
+				From:    "invalid_address",
+				Address: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid address",
+			msg: MsgAllow{
+				From:    sample.AccAddress(),
+				Address: "",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			msg: MsgAllow{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Denom:   "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid address and from",
+			msg: MsgAllow{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Denom:   "utoken",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgApprove = "approve"
+
+var _ sdk.Msg = &MsgApprove{}
+
+func NewMsgApprove(from string, denom string, id uint64) *MsgApprove {
+	return &MsgApprove{
+		From:  from,
+		Denom: denom,
+		Id:    id,
+	}
+}
+
+func (msg *MsgApprove) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgApprove) Type() string {
+	return TypeMsgApprove
+}
+
+func (msg *MsgApprove) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgApprove) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgApprove) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgApprove_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgApprove
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgApprove{
+				From: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid denom",
+			msg: MsgApprove{
+				From:  sample.AccAddress(),
+				Denom: "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid address",
+			msg: MsgApprove{
+				From:  sample.AccAddress(),
+				Denom: "utoken",
+				Id:    1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAttestReserves = "attest_reserves"
+
+var _ sdk.Msg = &MsgAttestReserves{}
+
+func NewMsgAttestReserves(from string, reserves sdk.Coin, timestamp time.Time) *MsgAttestReserves {
+	return &MsgAttestReserves{
+		From:      from,
+		Reserves:  reserves,
+		Timestamp: timestamp,
+	}
+}
+
+func (msg *MsgAttestReserves) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAttestReserves) Type() string {
+	return TypeMsgAttestReserves
+}
+
+func (msg *MsgAttestReserves) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgAttestReserves) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAttestReserves) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if msg.Reserves.IsNil() || msg.Reserves.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "reserves cannot be nil or negative")
+	}
+
+	if err := sdk.ValidateDenom(msg.Reserves.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+
+	if msg.Timestamp.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "attestation timestamp cannot be empty")
+	}
+
+	return nil
+}
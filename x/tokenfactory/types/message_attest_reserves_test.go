@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAttestReserves_ValidateBasic(t *testing.T) {
+	timestamp := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		msg  MsgAttestReserves
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAttestReserves{
+				From: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "nil reserves",
+			msg: MsgAttestReserves{
+				From:      sample.AccAddress(),
+				Timestamp: timestamp,
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "invalid denom",
+			msg: MsgAttestReserves{
+				From:      sample.AccAddress(),
+				Reserves:  sdk.Coin{Denom: "1", Amount: sdk.NewInt(1)},
+				Timestamp: timestamp,
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "empty timestamp",
+			msg: MsgAttestReserves{
+				From:     sample.AccAddress(),
+				Reserves: sdk.NewCoin("test", sdk.NewInt(1)),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero reserves",
+			msg: MsgAttestReserves{
+				From:      sample.AccAddress(),
+				Reserves:  sdk.NewCoin("test", sdk.ZeroInt()),
+				Timestamp: timestamp,
+			},
+		}, {
+			name: "valid address",
+			msg: MsgAttestReserves{
+				From:      sample.AccAddress(),
+				Reserves:  sdk.NewCoin("test", sdk.NewInt(1)),
+				Timestamp: timestamp,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
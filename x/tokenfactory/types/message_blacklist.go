@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -9,10 +11,14 @@ const TypeMsgBlacklist = "blacklist"
 
 var _ sdk.Msg = &MsgBlacklist{}
 
-func NewMsgBlacklist(from, address string) *MsgBlacklist {
+func NewMsgBlacklist(from, address, denom, reason, caseID string, expiry *time.Time) *MsgBlacklist {
 	return &MsgBlacklist{
 		From:    from,
 		Address: address,
+		Denom:   denom,
+		Reason:  reason,
+		CaseID:  caseID,
+		Expiry:  expiry,
 	}
 }
 
@@ -46,5 +52,10 @@ func (msg *MsgBlacklist) ValidateBasic() error {
 	if len(msg.Address) <= 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address length cannot be less than or equal to 0")
 	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgBlacklistBatch = "blacklist_batch"
+
+	// MaxBatchSize is the maximum number of addresses in a single MsgBlacklistBatch or MsgUnblacklistBatch.
+	MaxBatchSize = 500
+)
+
+var _ sdk.Msg = &MsgBlacklistBatch{}
+
+func NewMsgBlacklistBatch(from string, addresses []string, denom, reason, caseID string, expiry *time.Time, idempotent bool) *MsgBlacklistBatch {
+	return &MsgBlacklistBatch{
+		From:       from,
+		Addresses:  addresses,
+		Denom:      denom,
+		Reason:     reason,
+		CaseID:     caseID,
+		Expiry:     expiry,
+		Idempotent: idempotent,
+	}
+}
+
+func (msg *MsgBlacklistBatch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBlacklistBatch) Type() string {
+	return TypeMsgBlacklistBatch
+}
+
+func (msg *MsgBlacklistBatch) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgBlacklistBatch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBlacklistBatch) ValidateBasic() error {
+	return validateBatch(msg.From, msg.Addresses, msg.Denom)
+}
+
+// validateBatch checks the fields shared by MsgBlacklistBatch and MsgUnblacklistBatch.
+func validateBatch(from string, addresses []string, denom string) error {
+	_, err := sdk.AccAddressFromBech32(from)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if len(addresses) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "addresses cannot be empty")
+	}
+
+	if len(addresses) > MaxBatchSize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "batch cannot contain more than %d addresses", MaxBatchSize)
+	}
+
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		if len(address) <= 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address length cannot be less than or equal to 0")
+		}
+		if seen[address] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate address %s", address)
+		}
+		seen[address] = true
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBlacklistBatch_ValidateBasic(t *testing.T) {
+	address := sample.AccAddress()
+	tooMany := make([]string, MaxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = sample.AccAddress()
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgBlacklistBatch
+		err  error
+	}{
+		{
+			name: "invalid from address",
+			msg: MsgBlacklistBatch{
+				From:      "invalid_address",
+				Addresses: []string{address},
+				Denom:     "utoken",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no addresses",
+			msg: MsgBlacklistBatch{
+				From:  sample.AccAddress(),
+				Denom: "utoken",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "too many addresses",
+			msg: MsgBlacklistBatch{
+				From:      sample.AccAddress(),
+				Addresses: tooMany,
+				Denom:     "utoken",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty address",
+			msg: MsgBlacklistBatch{
+				From:      sample.AccAddress(),
+				Addresses: []string{address, ""},
+				Denom:     "utoken",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "duplicate address",
+			msg: MsgBlacklistBatch{
+				From:      sample.AccAddress(),
+				Addresses: []string{address, address},
+				Denom:     "utoken",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid denom",
+			msg: MsgBlacklistBatch{
+				From:      sample.AccAddress(),
+				Addresses: []string{address},
+				Denom:     "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid",
+			msg: MsgBlacklistBatch{
+				From:      sample.AccAddress(),
+				Addresses: []string{address, sample.AccAddress()},
+				Denom:     "utoken",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
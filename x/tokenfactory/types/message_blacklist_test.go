@@ -30,11 +30,21 @@ func TestMsgBlacklist_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgBlacklist{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Denom:   "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
 		{
 			name: "valid block and from address",
 			msg: MsgBlacklist{
 				From:    sample.AccAddress(),
 				Address: sample.AccAddress(),
+				Denom:   "utoken",
 			},
 		},
 	}
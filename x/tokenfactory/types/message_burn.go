@@ -55,5 +55,5 @@ func (msg *MsgBurn) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "burn amount cannot be zero")
 	}
 
-	return nil
+	return ValidateReferenceID(msg.ReferenceID)
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,6 +28,14 @@ func TestMsgBurn_ValidateBasic(t *testing.T) {
 				From:   sample.AccAddress(),
 				Amount: sdk.NewCoin("test", sdk.NewInt(1)),
 			},
+		}, {
+			name: "too long reference id",
+			msg: MsgBurn{
+				From:        sample.AccAddress(),
+				Amount:      sdk.NewCoin("test", sdk.NewInt(1)),
+				ReferenceID: strings.Repeat("a", MaxReferenceIDLength+1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
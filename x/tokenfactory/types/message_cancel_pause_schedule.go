@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelPauseSchedule = "cancel_pause_schedule"
+
+var _ sdk.Msg = &MsgCancelPauseSchedule{}
+
+func NewMsgCancelPauseSchedule(from string, denom string, id uint64) *MsgCancelPauseSchedule {
+	return &MsgCancelPauseSchedule{
+		From:  from,
+		Denom: denom,
+		Id:    id,
+	}
+}
+
+func (msg *MsgCancelPauseSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelPauseSchedule) Type() string {
+	return TypeMsgCancelPauseSchedule
+}
+
+func (msg *MsgCancelPauseSchedule) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgCancelPauseSchedule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelPauseSchedule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+	return nil
+}
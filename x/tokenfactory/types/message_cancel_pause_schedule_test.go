@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCancelPauseSchedule_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCancelPauseSchedule
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCancelPauseSchedule{
+				From: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid denom",
+			msg: MsgCancelPauseSchedule{
+				From:  sample.AccAddress(),
+				Denom: "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid address",
+			msg: MsgCancelPauseSchedule{
+				From:  sample.AccAddress(),
+				Denom: "utoken",
+				Id:    1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
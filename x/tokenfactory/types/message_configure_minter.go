@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -9,11 +11,12 @@ const TypeMsgConfigureMinter = "configure_minter"
 
 var _ sdk.Msg = &MsgConfigureMinter{}
 
-func NewMsgConfigureMinter(from string, address string, allowance sdk.Coin) *MsgConfigureMinter {
+func NewMsgConfigureMinter(from string, address string, allowance sdk.Coin, expiry *time.Time) *MsgConfigureMinter {
 	return &MsgConfigureMinter{
 		From:      from,
 		Address:   address,
 		Allowance: allowance,
+		Expiry:    expiry,
 	}
 }
 
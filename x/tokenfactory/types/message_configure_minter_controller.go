@@ -9,11 +9,12 @@ const TypeMsgConfigureMinterController = "configure_minter_controller"
 
 var _ sdk.Msg = &MsgConfigureMinterController{}
 
-func NewMsgConfigureMinterController(from string, controller string, minter string) *MsgConfigureMinterController {
+func NewMsgConfigureMinterController(from string, controller string, minter string, denom string) *MsgConfigureMinterController {
 	return &MsgConfigureMinterController{
 		From:       from,
 		Controller: controller,
 		Minter:     minter,
+		Denom:      denom,
 	}
 }
 
@@ -51,5 +52,8 @@ func (msg *MsgConfigureMinterController) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
@@ -41,12 +41,23 @@ func TestMsgConfigureMinterController_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgConfigureMinterController{
+				From:       sample.AccAddress(),
+				Controller: sample.AccAddress(),
+				Minter:     sample.AccAddress(),
+				Denom:      "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
 		{
 			name: "valid address, minter, and controller",
 			msg: MsgConfigureMinterController{
 				From:       sample.AccAddress(),
 				Controller: sample.AccAddress(),
 				Minter:     sample.AccAddress(),
+				Denom:      "utoken",
 			},
 		},
 	}
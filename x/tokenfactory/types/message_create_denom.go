@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const TypeMsgCreateDenom = "create_denom"
+
+var _ sdk.Msg = &MsgCreateDenom{}
+
+func NewMsgCreateDenom(from string, metadata banktypes.Metadata, owner string) *MsgCreateDenom {
+	return &MsgCreateDenom{
+		From:     from,
+		Metadata: metadata,
+		Owner:    owner,
+	}
+}
+
+func (msg *MsgCreateDenom) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateDenom) Type() string {
+	return TypeMsgCreateDenom
+}
+
+func (msg *MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgCreateDenom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateDenom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom metadata (%s)", err)
+	}
+
+	return nil
+}
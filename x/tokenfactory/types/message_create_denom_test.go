@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateDenom_ValidateBasic(t *testing.T) {
+	metadata := banktypes.Metadata{
+		Description: "test token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "utoken", Exponent: 0},
+			{Denom: "token", Exponent: 6},
+		},
+		Base:    "utoken",
+		Display: "token",
+		Name:    "token",
+		Symbol:  "TOKEN",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgCreateDenom
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgCreateDenom{
+				From:     "invalid_address",
+				Metadata: metadata,
+				Owner:    sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid owner",
+			msg: MsgCreateDenom{
+				From:     sample.AccAddress(),
+				Metadata: metadata,
+				Owner:    "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid metadata",
+			msg: MsgCreateDenom{
+				From:     sample.AccAddress(),
+				Metadata: banktypes.Metadata{Base: "utoken"},
+				Owner:    sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid",
+			msg: MsgCreateDenom{
+				From:     sample.AccAddress(),
+				Metadata: metadata,
+				Owner:    sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDecreaseMinterAllowance = "decrease_minter_allowance"
+
+var _ sdk.Msg = &MsgDecreaseMinterAllowance{}
+
+func NewMsgDecreaseMinterAllowance(from string, address string, decrement sdk.Coin) *MsgDecreaseMinterAllowance {
+	return &MsgDecreaseMinterAllowance{
+		From:      from,
+		Address:   address,
+		Decrement: decrement,
+	}
+}
+
+func (msg *MsgDecreaseMinterAllowance) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDecreaseMinterAllowance) Type() string {
+	return TypeMsgDecreaseMinterAllowance
+}
+
+func (msg *MsgDecreaseMinterAllowance) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgDecreaseMinterAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDecreaseMinterAllowance) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
+	}
+
+	if msg.Decrement.IsNil() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "decrement amount cannot be nil")
+	}
+
+	if err := msg.Decrement.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid decrement (%s)", err)
+	}
+
+	if !msg.Decrement.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "decrement amount must be positive")
+	}
+
+	return nil
+}
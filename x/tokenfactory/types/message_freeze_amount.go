@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgFreezeAmount = "freeze_amount"
+
+var _ sdk.Msg = &MsgFreezeAmount{}
+
+func NewMsgFreezeAmount(from string, address string, amount sdk.Coin) *MsgFreezeAmount {
+	return &MsgFreezeAmount{
+		From:    from,
+		Address: address,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgFreezeAmount) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFreezeAmount) Type() string {
+	return TypeMsgFreezeAmount
+}
+
+func (msg *MsgFreezeAmount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgFreezeAmount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFreezeAmount) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+
+	if msg.Amount.IsNil() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "freeze amount cannot be nil")
+	}
+
+	if msg.Amount.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "freeze amount cannot be negative")
+	}
+
+	if msg.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "freeze amount cannot be zero")
+	}
+
+	return nil
+}
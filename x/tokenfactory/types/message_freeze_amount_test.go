@@ -0,0 +1,81 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgFreezeAmount_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgFreezeAmount
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgFreezeAmount{
+				From:    "invalid_address",
+				Address: sample.AccAddress(),
+				Amount:  sdk.NewInt64Coin("utoken", 1),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid address",
+			msg: MsgFreezeAmount{
+				From:    sample.AccAddress(),
+				Address: "invalid_address",
+				Amount:  sdk.NewInt64Coin("utoken", 1),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "nil amount",
+			msg: MsgFreezeAmount{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "negative amount",
+			msg: MsgFreezeAmount{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Amount:  sdk.Coin{Denom: "utoken", Amount: sdk.NewInt(-1)},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "zero amount",
+			msg: MsgFreezeAmount{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Amount:  sdk.NewInt64Coin("utoken", 0),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid address and amount",
+			msg: MsgFreezeAmount{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Amount:  sdk.NewInt64Coin("utoken", 1),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
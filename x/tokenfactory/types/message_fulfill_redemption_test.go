@@ -0,0 +1,49 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgFulfillRedemption_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgFulfillRedemption
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgFulfillRedemption{
+				From: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "too long payout reference",
+			msg: MsgFulfillRedemption{
+				From:            sample.AccAddress(),
+				PayoutReference: strings.Repeat("a", MaxReferenceIDLength+1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgFulfillRedemption{
+				From: sample.AccAddress(),
+				Id:   1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
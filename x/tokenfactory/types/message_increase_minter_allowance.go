@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgIncreaseMinterAllowance = "increase_minter_allowance"
+
+var _ sdk.Msg = &MsgIncreaseMinterAllowance{}
+
+func NewMsgIncreaseMinterAllowance(from string, address string, increment sdk.Coin) *MsgIncreaseMinterAllowance {
+	return &MsgIncreaseMinterAllowance{
+		From:      from,
+		Address:   address,
+		Increment: increment,
+	}
+}
+
+func (msg *MsgIncreaseMinterAllowance) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgIncreaseMinterAllowance) Type() string {
+	return TypeMsgIncreaseMinterAllowance
+}
+
+func (msg *MsgIncreaseMinterAllowance) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgIncreaseMinterAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgIncreaseMinterAllowance) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
+	}
+
+	if msg.Increment.IsNil() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "increment amount cannot be nil")
+	}
+
+	if err := msg.Increment.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid increment (%s)", err)
+	}
+
+	if !msg.Increment.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "increment amount must be positive")
+	}
+
+	return nil
+}
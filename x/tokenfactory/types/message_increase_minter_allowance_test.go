@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgIncreaseMinterAllowance_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgIncreaseMinterAllowance
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgIncreaseMinterAllowance{
+				From:    "invalid_address",
+				Address: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid address",
+			msg: MsgIncreaseMinterAllowance{
+				From:    sample.AccAddress(),
+				Address: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "nil amount",
+			msg: MsgIncreaseMinterAllowance{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "zero amount",
+			msg: MsgIncreaseMinterAllowance{
+				From:      sample.AccAddress(),
+				Address:   sample.AccAddress(),
+				Increment: sdk.NewCoin("test", sdk.ZeroInt()),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid address and from",
+			msg: MsgIncreaseMinterAllowance{
+				From:      sample.AccAddress(),
+				Address:   sample.AccAddress(),
+				Increment: sdk.NewCoin("test", sdk.NewInt(1)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
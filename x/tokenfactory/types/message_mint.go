@@ -61,5 +61,5 @@ func (msg *MsgMint) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "mint amount cannot be zero")
 	}
 
-	return nil
+	return ValidateReferenceID(msg.ReferenceID)
 }
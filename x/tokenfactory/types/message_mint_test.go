@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,6 +40,25 @@ func TestMsgMint_ValidateBasic(t *testing.T) {
 				Amount:  sdk.NewCoin("test", sdk.NewInt(1)),
 			},
 		},
+		{
+			name: "too long reference id",
+			msg: MsgMint{
+				From:        sample.AccAddress(),
+				Address:     sample.AccAddress(),
+				Amount:      sdk.NewCoin("test", sdk.NewInt(1)),
+				ReferenceID: strings.Repeat("a", MaxReferenceIDLength+1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid reference id",
+			msg: MsgMint{
+				From:        sample.AccAddress(),
+				Address:     sample.AccAddress(),
+				Amount:      sdk.NewCoin("test", sdk.NewInt(1)),
+				ReferenceID: "deposit-1",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -9,9 +9,11 @@ const TypeMsgPause = "pause"
 
 var _ sdk.Msg = &MsgPause{}
 
-func NewMsgPause(from string) *MsgPause {
+func NewMsgPause(from string, denom string, operations []PauseOperation) *MsgPause {
 	return &MsgPause{
-		From: from,
+		From:       from,
+		Denom:      denom,
+		Operations: operations,
 	}
 }
 
@@ -41,5 +43,11 @@ func (msg *MsgPause) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+	if err := ValidatePauseOperations(msg.Operations); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 	return nil
 }
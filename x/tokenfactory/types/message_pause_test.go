@@ -20,10 +20,41 @@ func TestMsgPause_ValidateBasic(t *testing.T) {
 				From: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid denom",
+			msg: MsgPause{
+				From:  sample.AccAddress(),
+				Denom: "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "unspecified operation",
+			msg: MsgPause{
+				From:       sample.AccAddress(),
+				Denom:      "utoken",
+				Operations: []PauseOperation{PauseOperationUnspecified},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate operation",
+			msg: MsgPause{
+				From:       sample.AccAddress(),
+				Denom:      "utoken",
+				Operations: []PauseOperation{PauseMint, PauseMint},
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		}, {
 			name: "valid address",
 			msg: MsgPause{
-				From: sample.AccAddress(),
+				From:  sample.AccAddress(),
+				Denom: "utoken",
+			},
+		}, {
+			name: "valid operations",
+			msg: MsgPause{
+				From:       sample.AccAddress(),
+				Denom:      "utoken",
+				Operations: []PauseOperation{PauseMint, PauseIBCOutbound},
 			},
 		},
 	}
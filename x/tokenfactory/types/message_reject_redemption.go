@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRejectRedemption = "reject_redemption"
+
+var _ sdk.Msg = &MsgRejectRedemption{}
+
+func NewMsgRejectRedemption(from string, id uint64, reason string) *MsgRejectRedemption {
+	return &MsgRejectRedemption{
+		From:   from,
+		Id:     id,
+		Reason: reason,
+	}
+}
+
+func (msg *MsgRejectRedemption) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRejectRedemption) Type() string {
+	return TypeMsgRejectRedemption
+}
+
+func (msg *MsgRejectRedemption) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgRejectRedemption) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRejectRedemption) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if msg.Reason == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "rejection reason can not be empty")
+	}
+
+	return nil
+}
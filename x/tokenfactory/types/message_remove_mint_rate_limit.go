@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRemoveMintRateLimit = "remove_mint_rate_limit"
+
+var _ sdk.Msg = &MsgRemoveMintRateLimit{}
+
+func NewMsgRemoveMintRateLimit(from string, minter string, denom string) *MsgRemoveMintRateLimit {
+	return &MsgRemoveMintRateLimit{
+		From:   from,
+		Minter: minter,
+		Denom:  denom,
+	}
+}
+
+func (msg *MsgRemoveMintRateLimit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveMintRateLimit) Type() string {
+	return TypeMsgRemoveMintRateLimit
+}
+
+func (msg *MsgRemoveMintRateLimit) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgRemoveMintRateLimit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveMintRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if msg.Minter != "" {
+		_, err = sdk.AccAddressFromBech32(msg.Minter)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
+		}
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+
+	return nil
+}
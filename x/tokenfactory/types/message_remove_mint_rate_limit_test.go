@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRemoveMintRateLimit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRemoveMintRateLimit
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgRemoveMintRateLimit{
+				From:  "invalid_address",
+				Denom: "utoken",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid minter",
+			msg: MsgRemoveMintRateLimit{
+				From:   sample.AccAddress(),
+				Minter: "invalid_address",
+				Denom:  "utoken",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			msg: MsgRemoveMintRateLimit{
+				From:  sample.AccAddress(),
+				Denom: "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid",
+			msg: MsgRemoveMintRateLimit{
+				From:   sample.AccAddress(),
+				Minter: sample.AccAddress(),
+				Denom:  "utoken",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
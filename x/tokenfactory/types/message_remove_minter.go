@@ -9,10 +9,11 @@ const TypeMsgRemoveMinter = "remove_minter"
 
 var _ sdk.Msg = &MsgRemoveMinter{}
 
-func NewMsgRemoveMinter(from string, address string) *MsgRemoveMinter {
+func NewMsgRemoveMinter(from string, address string, denom string) *MsgRemoveMinter {
 	return &MsgRemoveMinter{
 		From:    from,
 		Address: address,
+		Denom:   denom,
 	}
 }
 
@@ -46,5 +47,8 @@ func (msg *MsgRemoveMinter) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
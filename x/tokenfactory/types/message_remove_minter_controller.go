@@ -9,10 +9,12 @@ const TypeMsgRemoveMinterController = "remove_minter_controller"
 
 var _ sdk.Msg = &MsgRemoveMinterController{}
 
-func NewMsgRemoveMinterController(from string, address string) *MsgRemoveMinterController {
+func NewMsgRemoveMinterController(from string, address string, denom string, minter string) *MsgRemoveMinterController {
 	return &MsgRemoveMinterController{
 		From:       from,
 		Controller: address,
+		Denom:      denom,
+		Minter:     minter,
 	}
 }
 
@@ -46,5 +48,14 @@ func (msg *MsgRemoveMinterController) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter controller address (%s)", err)
 	}
+	if msg.Minter != "" {
+		_, err = sdk.AccAddressFromBech32(msg.Minter)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
+		}
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
@@ -30,11 +30,40 @@ func TestMsgRemoveMinterController_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgRemoveMinterController{
+				From:       sample.AccAddress(),
+				Controller: sample.AccAddress(),
+				Denom:      "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "invalid minter",
+			msg: MsgRemoveMinterController{
+				From:       sample.AccAddress(),
+				Controller: sample.AccAddress(),
+				Denom:      "utoken",
+				Minter:     "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "valid controller, minter and from",
+			msg: MsgRemoveMinterController{
+				From:       sample.AccAddress(),
+				Controller: sample.AccAddress(),
+				Denom:      "utoken",
+				Minter:     sample.AccAddress(),
+			},
+		},
 		{
 			name: "valid controller and from",
 			msg: MsgRemoveMinterController{
 				From:       sample.AccAddress(),
 				Controller: sample.AccAddress(),
+				Denom:      "utoken",
 			},
 		},
 	}
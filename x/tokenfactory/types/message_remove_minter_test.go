@@ -30,11 +30,21 @@ func TestMsgRemoveMinter_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgRemoveMinter{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Denom:   "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
 		{
 			name: "valid address and from",
 			msg: MsgRemoveMinter{
 				From:    sample.AccAddress(),
 				Address: sample.AccAddress(),
+				Denom:   "utoken",
 			},
 		},
 	}
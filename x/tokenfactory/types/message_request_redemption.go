@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRequestRedemption = "request_redemption"
+
+var _ sdk.Msg = &MsgRequestRedemption{}
+
+func NewMsgRequestRedemption(from string, amount sdk.Coin, payoutInstructions string) *MsgRequestRedemption {
+	return &MsgRequestRedemption{
+		From:               from,
+		Amount:             amount,
+		PayoutInstructions: payoutInstructions,
+	}
+}
+
+func (msg *MsgRequestRedemption) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRequestRedemption) Type() string {
+	return TypeMsgRequestRedemption
+}
+
+func (msg *MsgRequestRedemption) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgRequestRedemption) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRequestRedemption) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if msg.Amount.IsNil() || msg.Amount.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "redemption amount cannot be nil or negative")
+	}
+
+	if msg.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "redemption amount cannot be zero")
+	}
+
+	return ValidatePayoutInstructions(msg.PayoutInstructions)
+}
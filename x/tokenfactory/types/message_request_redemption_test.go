@@ -0,0 +1,67 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRequestRedemption_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRequestRedemption
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRequestRedemption{
+				From: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg: MsgRequestRedemption{
+				From:               sample.AccAddress(),
+				Amount:             sdk.NewCoin("test", sdk.ZeroInt()),
+				PayoutInstructions: "iban",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "empty payout instructions",
+			msg: MsgRequestRedemption{
+				From:   sample.AccAddress(),
+				Amount: sdk.NewCoin("test", sdk.NewInt(1)),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "too long payout instructions",
+			msg: MsgRequestRedemption{
+				From:               sample.AccAddress(),
+				Amount:             sdk.NewCoin("test", sdk.NewInt(1)),
+				PayoutInstructions: strings.Repeat("a", MaxPayoutInstructionsLength+1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgRequestRedemption{
+				From:               sample.AccAddress(),
+				Amount:             sdk.NewCoin("test", sdk.NewInt(1)),
+				PayoutInstructions: "iban",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSchedulePause = "schedule_pause"
+
+var _ sdk.Msg = &MsgSchedulePause{}
+
+func NewMsgSchedulePause(
+	from string,
+	denom string,
+	operations []PauseOperation,
+	startHeight int64,
+	startTime *time.Time,
+	endHeight int64,
+	endTime *time.Time,
+) *MsgSchedulePause {
+	return &MsgSchedulePause{
+		From:        from,
+		Denom:       denom,
+		Operations:  operations,
+		StartHeight: startHeight,
+		StartTime:   startTime,
+		EndHeight:   endHeight,
+		EndTime:     endTime,
+	}
+}
+
+func (msg *MsgSchedulePause) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSchedulePause) Type() string {
+	return TypeMsgSchedulePause
+}
+
+func (msg *MsgSchedulePause) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgSchedulePause) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// PauseSchedule returns the schedule described by the message, without an id.
+func (msg *MsgSchedulePause) PauseSchedule() PauseSchedule {
+	return PauseSchedule{
+		Denom:       msg.Denom,
+		Operations:  msg.Operations,
+		StartHeight: msg.StartHeight,
+		StartTime:   msg.StartTime,
+		EndHeight:   msg.EndHeight,
+		EndTime:     msg.EndTime,
+	}
+}
+
+func (msg *MsgSchedulePause) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+	if err := msg.PauseSchedule().ValidateWindow(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSchedulePause_ValidateBasic(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	tests := []struct {
+		name string
+		msg  MsgSchedulePause
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSchedulePause{
+				From: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid denom",
+			msg: MsgSchedulePause{
+				From:  sample.AccAddress(),
+				Denom: "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "missing start",
+			msg: MsgSchedulePause{
+				From:      sample.AccAddress(),
+				Denom:     "utoken",
+				EndHeight: 20,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "start height and time",
+			msg: MsgSchedulePause{
+				From:        sample.AccAddress(),
+				Denom:       "utoken",
+				StartHeight: 10,
+				StartTime:   &start,
+				EndHeight:   20,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "end height before start height",
+			msg: MsgSchedulePause{
+				From:        sample.AccAddress(),
+				Denom:       "utoken",
+				StartHeight: 20,
+				EndHeight:   20,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "end time before start time",
+			msg: MsgSchedulePause{
+				From:      sample.AccAddress(),
+				Denom:     "utoken",
+				StartTime: &end,
+				EndTime:   &start,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid operation",
+			msg: MsgSchedulePause{
+				From:        sample.AccAddress(),
+				Denom:       "utoken",
+				Operations:  []PauseOperation{PauseOperationUnspecified},
+				StartHeight: 10,
+				EndHeight:   20,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid heights",
+			msg: MsgSchedulePause{
+				From:        sample.AccAddress(),
+				Denom:       "utoken",
+				StartHeight: 10,
+				EndHeight:   20,
+			},
+		}, {
+			name: "valid height and time",
+			msg: MsgSchedulePause{
+				From:        sample.AccAddress(),
+				Denom:       "utoken",
+				Operations:  []PauseOperation{PauseTransfer},
+				StartHeight: 10,
+				EndTime:     &end,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
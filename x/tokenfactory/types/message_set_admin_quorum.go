@@ -0,0 +1,64 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetAdminQuorum = "set_admin_quorum"
+
+var _ sdk.Msg = &MsgSetAdminQuorum{}
+
+func NewMsgSetAdminQuorum(from string, denom string, approvers []string, threshold uint32, votingPeriod time.Duration) *MsgSetAdminQuorum {
+	return &MsgSetAdminQuorum{
+		From:         from,
+		Denom:        denom,
+		Approvers:    approvers,
+		Threshold:    threshold,
+		VotingPeriod: votingPeriod,
+	}
+}
+
+func (msg *MsgSetAdminQuorum) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetAdminQuorum) Type() string {
+	return TypeMsgSetAdminQuorum
+}
+
+func (msg *MsgSetAdminQuorum) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgSetAdminQuorum) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetAdminQuorum) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+	return ValidateAdminQuorum(msg.Approvers, msg.Threshold, msg.VotingPeriod)
+}
+
+// AdminQuorum returns the quorum set by the message.
+func (msg *MsgSetAdminQuorum) AdminQuorum() AdminQuorum {
+	return AdminQuorum{
+		Denom:        msg.Denom,
+		Approvers:    msg.Approvers,
+		Threshold:    msg.Threshold,
+		VotingPeriod: msg.VotingPeriod,
+	}
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetAdminQuorum_ValidateBasic(t *testing.T) {
+	approver := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgSetAdminQuorum
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetAdminQuorum{
+				From: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid denom",
+			msg: MsgSetAdminQuorum{
+				From:  sample.AccAddress(),
+				Denom: "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "invalid approver",
+			msg: MsgSetAdminQuorum{
+				From:         sample.AccAddress(),
+				Denom:        "utoken",
+				Approvers:    []string{"invalid_address"},
+				Threshold:    1,
+				VotingPeriod: time.Hour,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "duplicate approver",
+			msg: MsgSetAdminQuorum{
+				From:         sample.AccAddress(),
+				Denom:        "utoken",
+				Approvers:    []string{approver, approver},
+				Threshold:    1,
+				VotingPeriod: time.Hour,
+			},
+			err: ErrAdminProposal,
+		}, {
+			name: "unreachable threshold",
+			msg: MsgSetAdminQuorum{
+				From:         sample.AccAddress(),
+				Denom:        "utoken",
+				Approvers:    []string{sample.AccAddress()},
+				Threshold:    2,
+				VotingPeriod: time.Hour,
+			},
+			err: ErrAdminProposal,
+		}, {
+			name: "zero threshold",
+			msg: MsgSetAdminQuorum{
+				From:         sample.AccAddress(),
+				Denom:        "utoken",
+				Approvers:    []string{sample.AccAddress()},
+				VotingPeriod: time.Hour,
+			},
+			err: ErrAdminProposal,
+		}, {
+			name: "no voting period",
+			msg: MsgSetAdminQuorum{
+				From:      sample.AccAddress(),
+				Denom:     "utoken",
+				Approvers: []string{sample.AccAddress()},
+				Threshold: 1,
+			},
+			err: ErrAdminProposal,
+		}, {
+			name: "remove quorum",
+			msg: MsgSetAdminQuorum{
+				From:  sample.AccAddress(),
+				Denom: "utoken",
+			},
+		}, {
+			name: "valid quorum",
+			msg: MsgSetAdminQuorum{
+				From:         sample.AccAddress(),
+				Denom:        "utoken",
+				Approvers:    []string{sample.AccAddress(), sample.AccAddress()},
+				Threshold:    2,
+				VotingPeriod: time.Hour,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
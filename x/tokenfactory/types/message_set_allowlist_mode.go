@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetAllowlistMode = "set_allowlist_mode"
+
+var _ sdk.Msg = &MsgSetAllowlistMode{}
+
+func NewMsgSetAllowlistMode(from string, denom string, enabled bool) *MsgSetAllowlistMode {
+	return &MsgSetAllowlistMode{
+		From:    from,
+		Denom:   denom,
+		Enabled: enabled,
+	}
+}
+
+func (msg *MsgSetAllowlistMode) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetAllowlistMode) Type() string {
+	return TypeMsgSetAllowlistMode
+}
+
+func (msg *MsgSetAllowlistMode) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgSetAllowlistMode) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetAllowlistMode) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+	return nil
+}
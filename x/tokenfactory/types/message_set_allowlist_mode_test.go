@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetAllowlistMode_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetAllowlistMode
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgSetAllowlistMode{
+				From:  "invalid_address",
+				Denom: "utoken",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			msg: MsgSetAllowlistMode{
+				From:  sample.AccAddress(),
+				Denom: "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid",
+			msg: MsgSetAllowlistMode{
+				From:    sample.AccAddress(),
+				Denom:   "utoken",
+				Enabled: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
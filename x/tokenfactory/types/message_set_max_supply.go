@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetMaxSupply = "set_max_supply"
+
+var _ sdk.Msg = &MsgSetMaxSupply{}
+
+func NewMsgSetMaxSupply(from string, maxSupply sdk.Coin) *MsgSetMaxSupply {
+	return &MsgSetMaxSupply{
+		From:      from,
+		MaxSupply: maxSupply,
+	}
+}
+
+func (msg *MsgSetMaxSupply) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetMaxSupply) Type() string {
+	return TypeMsgSetMaxSupply
+}
+
+func (msg *MsgSetMaxSupply) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgSetMaxSupply) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetMaxSupply) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if msg.MaxSupply.IsNil() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "max supply amount cannot be nil")
+	}
+
+	if err := msg.MaxSupply.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid max supply (%s)", err)
+	}
+
+	return nil
+}
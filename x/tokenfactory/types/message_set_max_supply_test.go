@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetMaxSupply_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetMaxSupply
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgSetMaxSupply{
+				From:      "invalid_address",
+				MaxSupply: sdk.NewCoin("utoken", sdk.NewInt(1)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "nil max supply",
+			msg: MsgSetMaxSupply{
+				From: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "negative max supply",
+			msg: MsgSetMaxSupply{
+				From:      sample.AccAddress(),
+				MaxSupply: sdk.Coin{Denom: "utoken", Amount: sdk.NewInt(-1)},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid",
+			msg: MsgSetMaxSupply{
+				From:      sample.AccAddress(),
+				MaxSupply: sdk.NewCoin("utoken", sdk.NewInt(1)),
+			},
+		},
+		{
+			name: "valid removal",
+			msg: MsgSetMaxSupply{
+				From:      sample.AccAddress(),
+				MaxSupply: sdk.NewCoin("utoken", sdk.ZeroInt()),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetMintRateLimit = "set_mint_rate_limit"
+
+var _ sdk.Msg = &MsgSetMintRateLimit{}
+
+func NewMsgSetMintRateLimit(from string, minter string, window time.Duration, limit sdk.Coin) *MsgSetMintRateLimit {
+	return &MsgSetMintRateLimit{
+		From:   from,
+		Minter: minter,
+		Window: window,
+		Limit:  limit,
+	}
+}
+
+func (msg *MsgSetMintRateLimit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetMintRateLimit) Type() string {
+	return TypeMsgSetMintRateLimit
+}
+
+func (msg *MsgSetMintRateLimit) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgSetMintRateLimit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetMintRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if msg.Minter != "" {
+		_, err = sdk.AccAddressFromBech32(msg.Minter)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
+		}
+	}
+
+	if msg.Window <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "window must be positive")
+	}
+
+	if msg.Limit.IsNil() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "limit amount cannot be nil")
+	}
+
+	if err := msg.Limit.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid limit (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetMintRateLimit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetMintRateLimit
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgSetMintRateLimit{
+				From:   "invalid_address",
+				Window: time.Hour,
+				Limit:  sdk.NewCoin("utoken", sdk.NewInt(1)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid minter",
+			msg: MsgSetMintRateLimit{
+				From:   sample.AccAddress(),
+				Minter: "invalid_address",
+				Window: time.Hour,
+				Limit:  sdk.NewCoin("utoken", sdk.NewInt(1)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid window",
+			msg: MsgSetMintRateLimit{
+				From:  sample.AccAddress(),
+				Limit: sdk.NewCoin("utoken", sdk.NewInt(1)),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "nil limit",
+			msg: MsgSetMintRateLimit{
+				From:   sample.AccAddress(),
+				Window: time.Hour,
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "negative limit",
+			msg: MsgSetMintRateLimit{
+				From:   sample.AccAddress(),
+				Window: time.Hour,
+				Limit:  sdk.Coin{Denom: "utoken", Amount: sdk.NewInt(-1)},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid minter limit",
+			msg: MsgSetMintRateLimit{
+				From:   sample.AccAddress(),
+				Minter: sample.AccAddress(),
+				Window: time.Hour,
+				Limit:  sdk.NewCoin("utoken", sdk.NewInt(1)),
+			},
+		},
+		{
+			name: "valid limit across all minters",
+			msg: MsgSetMintRateLimit{
+				From:   sample.AccAddress(),
+				Window: time.Hour,
+				Limit:  sdk.NewCoin("utoken", sdk.NewInt(1)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
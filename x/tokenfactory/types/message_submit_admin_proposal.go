@@ -0,0 +1,81 @@
+package types
+
+import (
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSubmitAdminProposal = "submit_admin_proposal"
+
+var (
+	_ sdk.Msg                          = &MsgSubmitAdminProposal{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitAdminProposal{}
+)
+
+func NewMsgSubmitAdminProposal(from string, denom string, msg sdk.Msg) (*MsgSubmitAdminProposal, error) {
+	any, err := cdctypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitAdminProposal{
+		From:  from,
+		Denom: denom,
+		Msg:   any,
+	}, nil
+}
+
+func (msg *MsgSubmitAdminProposal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitAdminProposal) Type() string {
+	return TypeMsgSubmitAdminProposal
+}
+
+func (msg *MsgSubmitAdminProposal) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes encodes the message with amino, which encodes the proposed message from its cached
+// value instead of resolving its type url.
+func (msg *MsgSubmitAdminProposal) GetSignBytes() []byte {
+	bz := aminoCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitAdminProposal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+
+	adminMsg, err := msg.GetAdminMsg()
+	if err != nil {
+		return err
+	}
+	if adminMsg.GetDenom() != msg.Denom {
+		return sdkerrors.Wrapf(ErrAdminProposal, "proposed message is for denom %s", adminMsg.GetDenom())
+	}
+
+	return adminMsg.ValidateBasic()
+}
+
+// GetAdminMsg returns the proposed administrative message.
+func (msg *MsgSubmitAdminProposal) GetAdminMsg() (AdminMsg, error) {
+	return unpackAdminMsg(msg.Msg)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg *MsgSubmitAdminProposal) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var adminMsg sdk.Msg
+	return unpacker.UnpackAny(msg.Msg, &adminMsg)
+}
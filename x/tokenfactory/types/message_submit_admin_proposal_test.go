@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSubmitAdminProposal_ValidateBasic(t *testing.T) {
+	newMsg := func(from string, denom string, msg sdk.Msg) MsgSubmitAdminProposal {
+		submitMsg, err := NewMsgSubmitAdminProposal(from, denom, msg)
+		require.NoError(t, err)
+		return *submitMsg
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgSubmitAdminProposal
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  newMsg("invalid_address", "utoken", NewMsgUpdatePauser(sample.AccAddress(), sample.AccAddress(), "utoken")),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid denom",
+			msg:  newMsg(sample.AccAddress(), "1", NewMsgUpdatePauser(sample.AccAddress(), sample.AccAddress(), "1")),
+			err:  sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "no message",
+			msg: MsgSubmitAdminProposal{
+				From:  sample.AccAddress(),
+				Denom: "utoken",
+			},
+			err: ErrAdminProposal,
+		}, {
+			name: "not an admin message",
+			msg:  newMsg(sample.AccAddress(), "utoken", NewMsgPause(sample.AccAddress(), "utoken", nil)),
+			err:  ErrAdminProposal,
+		}, {
+			name: "message of another denom",
+			msg:  newMsg(sample.AccAddress(), "utoken", NewMsgUpdatePauser(sample.AccAddress(), sample.AccAddress(), "uother")),
+			err:  ErrAdminProposal,
+		}, {
+			name: "invalid admin message",
+			msg:  newMsg(sample.AccAddress(), "utoken", NewMsgUpdatePauser(sample.AccAddress(), "invalid_address", "utoken")),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid message",
+			msg:  newMsg(sample.AccAddress(), "utoken", NewMsgUpdatePauser(sample.AccAddress(), sample.AccAddress(), "utoken")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotPanics(t, func() { tt.msg.GetSignBytes() })
+		})
+	}
+}
@@ -9,10 +9,11 @@ const TypeMsgUnblacklist = "unblacklist"
 
 var _ sdk.Msg = &MsgUnblacklist{}
 
-func NewMsgUnblacklist(from, address string) *MsgUnblacklist {
+func NewMsgUnblacklist(from, address, denom string) *MsgUnblacklist {
 	return &MsgUnblacklist{
 		From:    from,
 		Address: address,
+		Denom:   denom,
 	}
 }
 
@@ -46,5 +47,10 @@ func (msg *MsgUnblacklist) ValidateBasic() error {
 	if len(msg.Address) <= 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address length cannot be less than or equal to 0")
 	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+
 	return nil
 }
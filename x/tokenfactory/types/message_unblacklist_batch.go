@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgUnblacklistBatch = "unblacklist_batch"
+
+var _ sdk.Msg = &MsgUnblacklistBatch{}
+
+func NewMsgUnblacklistBatch(from string, addresses []string, denom string, idempotent bool) *MsgUnblacklistBatch {
+	return &MsgUnblacklistBatch{
+		From:       from,
+		Addresses:  addresses,
+		Denom:      denom,
+		Idempotent: idempotent,
+	}
+}
+
+func (msg *MsgUnblacklistBatch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnblacklistBatch) Type() string {
+	return TypeMsgUnblacklistBatch
+}
+
+func (msg *MsgUnblacklistBatch) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgUnblacklistBatch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnblacklistBatch) ValidateBasic() error {
+	return validateBatch(msg.From, msg.Addresses, msg.Denom)
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUnblacklistBatch_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUnblacklistBatch
+		err  error
+	}{
+		{
+			name: "invalid from address",
+			msg: MsgUnblacklistBatch{
+				From:      "invalid_address",
+				Addresses: []string{sample.AccAddress()},
+				Denom:     "utoken",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no addresses",
+			msg: MsgUnblacklistBatch{
+				From:  sample.AccAddress(),
+				Denom: "utoken",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgUnblacklistBatch{
+				From:      sample.AccAddress(),
+				Addresses: []string{sample.AccAddress()},
+				Denom:     "utoken",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
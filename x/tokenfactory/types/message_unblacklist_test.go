@@ -30,11 +30,21 @@ func TestMsgUnblacklist_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgUnblacklist{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Denom:   "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
 		{
 			name: "valid block address and from",
 			msg: MsgUnblacklist{
 				From:    sample.AccAddress(),
 				Address: sample.AccAddress(),
+				Denom:   "utoken",
 			},
 		},
 	}
@@ -9,9 +9,11 @@ const TypeMsgUnpause = "unpause"
 
 var _ sdk.Msg = &MsgUnpause{}
 
-func NewMsgUnpause(from string) *MsgUnpause {
+func NewMsgUnpause(from string, denom string, operations []PauseOperation) *MsgUnpause {
 	return &MsgUnpause{
-		From: from,
+		From:       from,
+		Denom:      denom,
+		Operations: operations,
 	}
 }
 
@@ -41,5 +43,11 @@ func (msg *MsgUnpause) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+	if err := ValidatePauseOperations(msg.Operations); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 	return nil
 }
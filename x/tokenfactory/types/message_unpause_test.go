@@ -20,10 +20,18 @@ func TestMsgUnpause_ValidateBasic(t *testing.T) {
 				From: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid denom",
+			msg: MsgUnpause{
+				From:  sample.AccAddress(),
+				Denom: "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
 		}, {
 			name: "valid address",
 			msg: MsgUnpause{
-				From: sample.AccAddress(),
+				From:  sample.AccAddress(),
+				Denom: "utoken",
 			},
 		},
 	}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateAllowlister = "update_allowlister"
+
+var _ sdk.Msg = &MsgUpdateAllowlister{}
+
+func NewMsgUpdateAllowlister(from string, address string, denom string) *MsgUpdateAllowlister {
+	return &MsgUpdateAllowlister{
+		From:    from,
+		Address: address,
+		Denom:   denom,
+	}
+}
+
+func (msg *MsgUpdateAllowlister) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateAllowlister) Type() string {
+	return TypeMsgUpdateAllowlister
+}
+
+func (msg *MsgUpdateAllowlister) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgUpdateAllowlister) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateAllowlister) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowlister address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+	return nil
+}
@@ -9,10 +9,11 @@ const TypeMsgUpdateBlacklister = "update_blacklister"
 
 var _ sdk.Msg = &MsgUpdateBlacklister{}
 
-func NewMsgUpdateBlacklister(from string, address string) *MsgUpdateBlacklister {
+func NewMsgUpdateBlacklister(from string, address string, denom string) *MsgUpdateBlacklister {
 	return &MsgUpdateBlacklister{
 		From:    from,
 		Address: address,
+		Denom:   denom,
 	}
 }
 
@@ -46,5 +47,8 @@ func (msg *MsgUpdateBlacklister) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid blacklister address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
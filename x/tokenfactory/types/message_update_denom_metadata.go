@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const TypeMsgUpdateDenomMetadata = "update_denom_metadata"
+
+var _ sdk.Msg = &MsgUpdateDenomMetadata{}
+
+func NewMsgUpdateDenomMetadata(from string, metadata banktypes.Metadata) *MsgUpdateDenomMetadata {
+	return &MsgUpdateDenomMetadata{
+		From:     from,
+		Metadata: metadata,
+	}
+}
+
+func (msg *MsgUpdateDenomMetadata) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateDenomMetadata) Type() string {
+	return TypeMsgUpdateDenomMetadata
+}
+
+func (msg *MsgUpdateDenomMetadata) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgUpdateDenomMetadata) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateDenomMetadata) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom metadata (%s)", err)
+	}
+
+	return nil
+}
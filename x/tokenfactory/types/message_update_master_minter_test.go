@@ -30,11 +30,21 @@ func TestMsgUpdateMasterMinter_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgUpdateMasterMinter{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Denom:   "1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
 		{
 			name: "valid address and from",
 			msg: MsgUpdateMasterMinter{
 				From:    sample.AccAddress(),
 				Address: sample.AccAddress(),
+				Denom:   "utoken",
 			},
 		},
 	}
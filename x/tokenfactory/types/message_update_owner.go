@@ -9,10 +9,11 @@ const TypeMsgUpdateOwner = "update_owner"
 
 var _ sdk.Msg = &MsgUpdateOwner{}
 
-func NewMsgUpdateOwner(from string, address string) *MsgUpdateOwner {
+func NewMsgUpdateOwner(from string, address string, denom string) *MsgUpdateOwner {
 	return &MsgUpdateOwner{
 		From:    from,
 		Address: address,
+		Denom:   denom,
 	}
 }
 
@@ -46,5 +47,8 @@ func (msg *MsgUpdateOwner) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
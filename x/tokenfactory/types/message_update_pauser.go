@@ -9,10 +9,11 @@ const TypeMsgUpdatePauser = "update_pauser"
 
 var _ sdk.Msg = &MsgUpdatePauser{}
 
-func NewMsgUpdatePauser(from string, address string) *MsgUpdatePauser {
+func NewMsgUpdatePauser(from string, address string, denom string) *MsgUpdatePauser {
 	return &MsgUpdatePauser{
 		From:    from,
 		Address: address,
+		Denom:   denom,
 	}
 }
 
@@ -46,5 +47,8 @@ func (msg *MsgUpdatePauser) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pauser address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
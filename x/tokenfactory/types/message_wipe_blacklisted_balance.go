@@ -0,0 +1,64 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgWipeBlacklistedBalance = "wipe_blacklisted_balance"
+
+var _ sdk.Msg = &MsgWipeBlacklistedBalance{}
+
+func NewMsgWipeBlacklistedBalance(from string, address string, denom string, caseReference string) *MsgWipeBlacklistedBalance {
+	return &MsgWipeBlacklistedBalance{
+		From:          from,
+		Address:       address,
+		Denom:         denom,
+		CaseReference: caseReference,
+	}
+}
+
+func (msg *MsgWipeBlacklistedBalance) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWipeBlacklistedBalance) Type() string {
+	return TypeMsgWipeBlacklistedBalance
+}
+
+func (msg *MsgWipeBlacklistedBalance) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgWipeBlacklistedBalance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgWipeBlacklistedBalance) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid blacklisted address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+
+	if strings.TrimSpace(msg.CaseReference) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "case reference cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgWipeBlacklistedBalance_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgWipeBlacklistedBalance
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgWipeBlacklistedBalance{
+				From:          "invalid_address",
+				Address:       sample.AccAddress(),
+				Denom:         "utoken",
+				CaseReference: "case-1",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid address",
+			msg: MsgWipeBlacklistedBalance{
+				From:          sample.AccAddress(),
+				Address:       "invalid_address",
+				Denom:         "utoken",
+				CaseReference: "case-1",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			msg: MsgWipeBlacklistedBalance{
+				From:          sample.AccAddress(),
+				Address:       sample.AccAddress(),
+				Denom:         "1",
+				CaseReference: "case-1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "empty case reference",
+			msg: MsgWipeBlacklistedBalance{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Denom:   "utoken",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid",
+			msg: MsgWipeBlacklistedBalance{
+				From:          sample.AccAddress(),
+				Address:       sample.AccAddress(),
+				Denom:         "utoken",
+				CaseReference: "case-1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// MintStatsDateLayout is the layout of the date of a DailyMintStats.
+const MintStatsDateLayout = "2006-01-02"
+
+// MintStatsDate returns the UTC date of a block time that its mints and burns are counted under.
+func MintStatsDate(t time.Time) string {
+	return t.UTC().Format(MintStatsDateLayout)
+}
+
+// ValidateMintStatsDate checks that a date is formatted as YYYY-MM-DD.
+func ValidateMintStatsDate(date string) error {
+	if _, err := time.Parse(MintStatsDateLayout, date); err != nil {
+		return fmt.Errorf("invalid date %q, expected YYYY-MM-DD", date)
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MinterController struct {
 	Minter     string `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter,omitempty"`
 	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	Denom      string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MinterController) Reset()         { *m = MinterController{} }
@@ -74,6 +75,13 @@ func (m *MinterController) GetController() string {
 	return ""
 }
 
+func (m *MinterController) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MinterController)(nil), "noble.tokenfactory.MinterController")
 }
@@ -83,19 +91,19 @@ func init() {
 }
 
 var fileDescriptor_08f20cf60d2060bd = []byte{
-	// 179 bytes of a gzipped FileDescriptorProto
+	// 191 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0xc9, 0xcf, 0x4e,
 	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0xcf, 0xcd, 0xcc, 0x2b, 0x49, 0x2d, 0x8a,
 	0x4f, 0xce, 0xcf, 0x2b, 0x29, 0xca, 0xcf, 0xc9, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0xca, 0xcb, 0x4f, 0xca, 0x49, 0xd5, 0x43, 0x56, 0xab, 0xe4, 0xc5, 0x25, 0xe0, 0x0b,
+	0x17, 0x12, 0xca, 0xcb, 0x4f, 0xca, 0x49, 0xd5, 0x43, 0x56, 0xab, 0x94, 0xc0, 0x25, 0xe0, 0x0b,
 	0x56, 0xee, 0x0c, 0x57, 0x2d, 0x24, 0xc6, 0xc5, 0x06, 0x31, 0x42, 0x82, 0x51, 0x81, 0x51, 0x83,
-	0x33, 0x08, 0xca, 0x13, 0x92, 0xe3, 0xe2, 0x42, 0x98, 0x29, 0xc1, 0x04, 0x96, 0x43, 0x12, 0x71,
-	0xf2, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd3, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xb0, 0x23, 0x74, 0x13, 0x8b, 0x8b, 0x53, 0x4b,
-	0x8a, 0x21, 0x1c, 0xfd, 0x32, 0x53, 0xfd, 0x0a, 0x7d, 0x14, 0x2f, 0x94, 0x54, 0x16, 0xa4, 0x16,
-	0x27, 0xb1, 0x81, 0xdd, 0x6d, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x35, 0xb3, 0xd2, 0xdf,
-	0x00, 0x00, 0x00,
+	0x33, 0x08, 0xca, 0x13, 0x92, 0xe3, 0xe2, 0x42, 0x98, 0x29, 0xc1, 0x04, 0x96, 0x43, 0x12, 0x11,
+	0x12, 0xe1, 0x62, 0x4d, 0x49, 0xcd, 0xcb, 0xcf, 0x95, 0x60, 0x06, 0x4b, 0x41, 0x38, 0x4e, 0xfe,
+	0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
+	0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x9a, 0x9e, 0x59, 0x92, 0x51,
+	0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x76, 0x9a, 0x6e, 0x62, 0x71, 0x71, 0x6a, 0x49, 0x31,
+	0x84, 0xa3, 0x5f, 0x66, 0xaa, 0x5f, 0xa1, 0x8f, 0xe2, 0xb1, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24,
+	0x36, 0xb0, 0x6f, 0x8c, 0x01, 0x03, 0x00, 0x51, 0x9a, 0xb2, 0x87, 0xf5, 0x00, 0x00, 0x00,
 }
 
 func (m *MinterController) Marshal() (dAtA []byte, err error) {
@@ -118,6 +126,13 @@ func (m *MinterController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMinterController(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Controller) > 0 {
 		i -= len(m.Controller)
 		copy(dAtA[i:], m.Controller)
@@ -160,6 +175,10 @@ func (m *MinterController) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMinterController(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMinterController(uint64(l))
+	}
 	return n
 }
 
@@ -262,6 +281,38 @@ func (m *MinterController) Unmarshal(dAtA []byte) error {
 			}
 			m.Controller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinterController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinterController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMinterController(dAtA[iNdEx:])
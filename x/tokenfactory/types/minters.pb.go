@@ -8,15 +8,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +31,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Minters struct {
 	Address   string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Allowance types.Coin `protobuf:"bytes,2,opt,name=allowance,proto3" json:"allowance"`
+	Denom     string     `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// expiry is the time after which the allowance is treated as zero. Unset means the allowance
+	// never expires.
+	Expiry *time.Time `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
 }
 
 func (m *Minters) Reset()         { *m = Minters{} }
@@ -76,6 +84,20 @@ func (m *Minters) GetAllowance() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Minters) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Minters) GetExpiry() *time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Minters)(nil), "noble.tokenfactory.Minters")
 }
@@ -83,23 +105,26 @@ func init() {
 func init() { proto.RegisterFile("tokenfactory/minters.proto", fileDescriptor_ac9d7080b5299f2f) }
 
 var fileDescriptor_ac9d7080b5299f2f = []byte{
-	// 243 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x63, 0x84, 0xa8, 0x1a, 0xb6, 0x88, 0x21, 0x64, 0x30, 0x15, 0x53, 0x17, 0x7c, 0x2a,
-	0xa8, 0x23, 0x4b, 0x99, 0x11, 0x52, 0x47, 0x36, 0xdb, 0x3d, 0x42, 0x44, 0xe2, 0xab, 0x7c, 0xa6,
-	0xd0, 0xb7, 0xe0, 0xb1, 0x3a, 0x76, 0x64, 0x42, 0x28, 0x79, 0x11, 0x94, 0x18, 0x04, 0x6c, 0xff,
-	0x7f, 0xba, 0xff, 0xbe, 0xbb, 0x4b, 0x8b, 0x40, 0x4f, 0xe8, 0x1e, 0xb4, 0x0d, 0xe4, 0xb7, 0xd0,
-	0x54, 0x2e, 0xa0, 0x67, 0xb5, 0xf6, 0x14, 0x28, 0xcb, 0x1c, 0x99, 0x1a, 0xd5, 0xdf, 0x8e, 0x42,
-	0x5a, 0xe2, 0x86, 0x18, 0x8c, 0x66, 0x84, 0xcd, 0xcc, 0x60, 0xd0, 0x33, 0xb0, 0x54, 0xb9, 0x98,
-	0x29, 0x4e, 0x4a, 0x2a, 0x69, 0x90, 0xd0, 0xab, 0x58, 0x3d, 0x37, 0xe9, 0xe8, 0x36, 0x8e, 0xce,
-	0xf2, 0x74, 0xa4, 0x57, 0x2b, 0x8f, 0xcc, 0xb9, 0x98, 0x88, 0xe9, 0x78, 0xf9, 0x63, 0xb3, 0xeb,
-	0x74, 0xac, 0xeb, 0x9a, 0x5e, 0xb4, 0xb3, 0x98, 0x1f, 0x4c, 0xc4, 0xf4, 0xf8, 0xf2, 0x54, 0x45,
-	0x9c, 0xea, 0x71, 0xea, 0x1b, 0xa7, 0x6e, 0xa8, 0x72, 0x8b, 0xc3, 0xdd, 0xc7, 0x59, 0xb2, 0xfc,
-	0x4d, 0x2c, 0xee, 0x76, 0xad, 0x14, 0xfb, 0x56, 0x8a, 0xcf, 0x56, 0x8a, 0xb7, 0x4e, 0x26, 0xfb,
-	0x4e, 0x26, 0xef, 0x9d, 0x4c, 0xee, 0xe7, 0x65, 0x15, 0x1e, 0x9f, 0x8d, 0xb2, 0xd4, 0xc0, 0x70,
-	0xd2, 0x85, 0x66, 0xc6, 0xc0, 0xd1, 0xc0, 0x66, 0x0e, 0xaf, 0xf0, 0xef, 0x0d, 0x61, 0xbb, 0x46,
-	0x36, 0x47, 0xc3, 0xee, 0x57, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc2, 0xb2, 0x1d, 0xf2, 0x23,
-	0x01, 0x00, 0x00,
+	// 302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xb1, 0x4e, 0x02, 0x41,
+	0x10, 0x86, 0x6f, 0x15, 0x21, 0x9c, 0xdd, 0x85, 0xe2, 0xbc, 0x62, 0x21, 0x56, 0x34, 0xee, 0x06,
+	0x0d, 0x89, 0x8d, 0x0d, 0xd6, 0xc6, 0x84, 0x58, 0xd9, 0xed, 0x1e, 0xc3, 0xb9, 0xf1, 0x76, 0xe7,
+	0x72, 0xbb, 0x20, 0xbc, 0x05, 0xcf, 0xe2, 0x53, 0x50, 0x52, 0x5a, 0xa9, 0x81, 0x17, 0x31, 0xec,
+	0x42, 0xd4, 0x6e, 0xbe, 0xc9, 0x3f, 0x33, 0x5f, 0x26, 0xce, 0x1c, 0xbe, 0x82, 0x99, 0x8a, 0xdc,
+	0x61, 0xbd, 0xe4, 0x5a, 0x19, 0x07, 0xb5, 0x65, 0x55, 0x8d, 0x0e, 0x93, 0xc4, 0xa0, 0x2c, 0x81,
+	0xfd, 0x4d, 0x64, 0x34, 0x47, 0xab, 0xd1, 0x72, 0x29, 0x2c, 0xf0, 0xf9, 0x40, 0x82, 0x13, 0x03,
+	0x9e, 0xa3, 0x32, 0x61, 0x26, 0xeb, 0x14, 0x58, 0xa0, 0x2f, 0xf9, 0xbe, 0x3a, 0x74, 0xbb, 0x05,
+	0x62, 0x51, 0x02, 0xf7, 0x24, 0x67, 0x53, 0xee, 0x94, 0x06, 0xeb, 0x84, 0xae, 0x42, 0xe0, 0xf2,
+	0x9d, 0xc4, 0xad, 0x87, 0x70, 0x3c, 0x49, 0xe3, 0x96, 0x98, 0x4c, 0x6a, 0xb0, 0x36, 0x25, 0x3d,
+	0xd2, 0x6f, 0x8f, 0x8f, 0x98, 0xdc, 0xc5, 0x6d, 0x51, 0x96, 0xf8, 0x26, 0x4c, 0x0e, 0xe9, 0x49,
+	0x8f, 0xf4, 0xcf, 0xaf, 0x2f, 0x58, 0x10, 0x62, 0x7b, 0x21, 0x76, 0x10, 0x62, 0xf7, 0xa8, 0xcc,
+	0xa8, 0xb1, 0xfe, 0xec, 0x46, 0xe3, 0xdf, 0x89, 0xa4, 0x13, 0x9f, 0x4d, 0xc0, 0xa0, 0x4e, 0x4f,
+	0xfd, 0xda, 0x00, 0xc9, 0x6d, 0xdc, 0x84, 0x45, 0xa5, 0xea, 0x65, 0xda, 0xf0, 0x1b, 0x33, 0x16,
+	0x64, 0xd9, 0x51, 0x96, 0x3d, 0x1d, 0x65, 0x47, 0x8d, 0xd5, 0x57, 0x97, 0x8c, 0x0f, 0xf9, 0xd1,
+	0xe3, 0x7a, 0x4b, 0xc9, 0x66, 0x4b, 0xc9, 0xf7, 0x96, 0x92, 0xd5, 0x8e, 0x46, 0x9b, 0x1d, 0x8d,
+	0x3e, 0x76, 0x34, 0x7a, 0x1e, 0x16, 0xca, 0xbd, 0xcc, 0x24, 0xcb, 0x51, 0x73, 0xff, 0xc4, 0x2b,
+	0x61, 0x2d, 0x38, 0x1b, 0x80, 0xcf, 0x87, 0x7c, 0xc1, 0xff, 0x3d, 0xde, 0x2d, 0x2b, 0xb0, 0xb2,
+	0xe9, 0x4f, 0xde, 0xfc, 0x0c, 0x00, 0x5d, 0xfa, 0x2b, 0xb0, 0x95, 0x01, 0x00, 0x00,
 }
 
 func (m *Minters) Marshal() (dAtA []byte, err error) {
@@ -122,6 +147,23 @@ func (m *Minters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiry != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiry):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMinters(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMinters(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -165,6 +207,14 @@ func (m *Minters) Size() (n int) {
 	}
 	l = m.Allowance.Size()
 	n += 1 + l + sovMinters(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMinters(uint64(l))
+	}
+	if m.Expiry != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovMinters(uint64(l))
+	}
 	return n
 }
 
@@ -268,6 +318,74 @@ func (m *Minters) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinters
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinters
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinters
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinters
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMinters
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinters
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMinters(dAtA[iNdEx:])
@@ -1,35 +1,113 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyAuditLogRetention = []byte("AuditLogRetention")
+	// DefaultAuditLogRetention keeps audit log entries forever
+	DefaultAuditLogRetention = time.Duration(0)
+
+	KeyReferenceIDRetention = []byte("ReferenceIDRetention")
+	// DefaultReferenceIDRetention rejects retries of a mint or burn for a week
+	DefaultReferenceIDRetention = 7 * 24 * time.Hour
+
+	KeyRedemptionTimeout = []byte("RedemptionTimeout")
+	// DefaultRedemptionTimeout refunds redemptions that are not resolved within three days
+	DefaultRedemptionTimeout = 3 * 24 * time.Hour
+
+	KeyMaxAttestationAge = []byte("MaxAttestationAge")
+	// DefaultMaxAttestationAge never considers reserve attestations stale
+	DefaultMaxAttestationAge = time.Duration(0)
+
+	KeyMinRedemptionAmount = []byte("MinRedemptionAmount")
+	// DefaultMinRedemptionAmount is one whole token of a denom with 6 decimals
+	DefaultMinRedemptionAmount = sdk.NewInt(1_000_000)
+
+	KeyRedemptionRetention = []byte("RedemptionRetention")
+	// DefaultRedemptionRetention keeps resolved redemptions for 90 days
+	DefaultRedemptionRetention = 90 * 24 * time.Hour
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	auditLogRetention time.Duration,
+	referenceIDRetention time.Duration,
+	redemptionTimeout time.Duration,
+	maxAttestationAge time.Duration,
+	minRedemptionAmount sdk.Int,
+	redemptionRetention time.Duration,
+) Params {
+	return Params{
+		AuditLogRetention:    auditLogRetention,
+		ReferenceIDRetention: referenceIDRetention,
+		RedemptionTimeout:    redemptionTimeout,
+		MaxAttestationAge:    maxAttestationAge,
+		MinRedemptionAmount:  minRedemptionAmount,
+		RedemptionRetention:  redemptionRetention,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultAuditLogRetention,
+		DefaultReferenceIDRetention,
+		DefaultRedemptionTimeout,
+		DefaultMaxAttestationAge,
+		DefaultMinRedemptionAmount,
+		DefaultRedemptionRetention,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAuditLogRetention, &p.AuditLogRetention, validateAuditLogRetention),
+		paramtypes.NewParamSetPair(KeyReferenceIDRetention, &p.ReferenceIDRetention, validateReferenceIDRetention),
+		paramtypes.NewParamSetPair(KeyRedemptionTimeout, &p.RedemptionTimeout, validateRedemptionTimeout),
+		paramtypes.NewParamSetPair(KeyMaxAttestationAge, &p.MaxAttestationAge, validateMaxAttestationAge),
+		paramtypes.NewParamSetPair(KeyMinRedemptionAmount, &p.MinRedemptionAmount, validateMinRedemptionAmount),
+		paramtypes.NewParamSetPair(KeyRedemptionRetention, &p.RedemptionRetention, validateRedemptionRetention),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateAuditLogRetention(p.AuditLogRetention); err != nil {
+		return err
+	}
+
+	if err := validateReferenceIDRetention(p.ReferenceIDRetention); err != nil {
+		return err
+	}
+
+	if err := validateRedemptionTimeout(p.RedemptionTimeout); err != nil {
+		return err
+	}
+
+	if err := validateMaxAttestationAge(p.MaxAttestationAge); err != nil {
+		return err
+	}
+
+	if err := validateMinRedemptionAmount(p.MinRedemptionAmount); err != nil {
+		return err
+	}
+
+	return validateRedemptionRetention(p.RedemptionRetention)
 }
 
 // String implements the Stringer interface.
@@ -37,3 +115,81 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateAuditLogRetention(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("audit log retention can not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateReferenceIDRetention(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("reference id retention can not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateRedemptionTimeout(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("redemption timeout can not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxAttestationAge(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("max attestation age can not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinRedemptionAmount(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("min redemption amount can not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateRedemptionRetention(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("redemption retention can not be negative: %s", v)
+	}
+
+	return nil
+}
@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +30,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// audit_log_retention is how long audit log entries are kept before they are pruned, forever if zero.
+	AuditLogRetention time.Duration `protobuf:"bytes,1,opt,name=audit_log_retention,json=auditLogRetention,proto3,stdduration" json:"audit_log_retention" yaml:"audit_log_retention"`
+	// reference_id_retention is how long the reference ids of mints and burns are kept, and retries
+	// with the same reference id rejected, before they are pruned. They are kept forever if zero.
+	ReferenceIDRetention time.Duration `protobuf:"bytes,2,opt,name=reference_id_retention,json=referenceIdRetention,proto3,stdduration" json:"reference_id_retention" yaml:"reference_id_retention"`
+	// redemption_timeout is how long a redemption request can be pending before its escrowed tokens
+	// are refunded. Requests never time out if zero.
+	RedemptionTimeout time.Duration `protobuf:"bytes,3,opt,name=redemption_timeout,json=redemptionTimeout,proto3,stdduration" json:"redemption_timeout" yaml:"redemption_timeout"`
+	// max_attestation_age is how old the latest reserve attestation of a denom with an attestor can
+	// be before its mints are refused. Attestations never become stale if zero.
+	MaxAttestationAge time.Duration `protobuf:"bytes,4,opt,name=max_attestation_age,json=maxAttestationAge,proto3,stdduration" json:"max_attestation_age" yaml:"max_attestation_age"`
+	// min_redemption_amount is the smallest amount of a redemption request.
+	MinRedemptionAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_redemption_amount,json=minRedemptionAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_redemption_amount" yaml:"min_redemption_amount"`
+	// redemption_retention is how long fulfilled, rejected and expired redemption requests are kept
+	// before they are pruned. They are kept forever if zero.
+	RedemptionRetention time.Duration `protobuf:"bytes,6,opt,name=redemption_retention,json=redemptionRetention,proto3,stdduration" json:"redemption_retention" yaml:"redemption_retention"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +80,41 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAuditLogRetention() time.Duration {
+	if m != nil {
+		return m.AuditLogRetention
+	}
+	return 0
+}
+
+func (m *Params) GetReferenceIDRetention() time.Duration {
+	if m != nil {
+		return m.ReferenceIDRetention
+	}
+	return 0
+}
+
+func (m *Params) GetRedemptionTimeout() time.Duration {
+	if m != nil {
+		return m.RedemptionTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAttestationAge() time.Duration {
+	if m != nil {
+		return m.MaxAttestationAge
+	}
+	return 0
+}
+
+func (m *Params) GetRedemptionRetention() time.Duration {
+	if m != nil {
+		return m.RedemptionRetention
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "noble.tokenfactory.Params")
 }
@@ -66,17 +122,37 @@ func init() {
 func init() { proto.RegisterFile("tokenfactory/params.proto", fileDescriptor_0f39a375875b281a) }
 
 var fileDescriptor_0f39a375875b281a = []byte{
-	// 157 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0xcb, 0x4f, 0xca, 0x49, 0xd5, 0x43, 0x56, 0x20,
-	0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x96, 0xd6, 0x07, 0xb1, 0x20, 0x2a, 0x95, 0xf8, 0xb8, 0xd8,
-	0x02, 0xc0, 0x3a, 0xad, 0x58, 0x66, 0x2c, 0x90, 0x67, 0x70, 0xf2, 0x3f, 0xf1, 0x48, 0x8e, 0xf1,
-	0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc,
-	0x5c, 0x7d, 0xb0, 0xf1, 0xba, 0x89, 0xc5, 0xc5, 0xa9, 0x25, 0xc5, 0x10, 0x8e, 0x7e, 0x99, 0xa9,
-	0x7e, 0x85, 0x3e, 0x8a, 0x8b, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xf6, 0x18, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x88, 0x46, 0x7b, 0xae, 0x00, 0x00, 0x00,
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0xd0, 0x46, 0xc2, 0x4c, 0x38, 0x01, 0x25, 0x01, 0xec, 0x2a, 0x12, 0xa5, 0x4b,
+	0x7d, 0x12, 0xa8, 0x4b, 0xb7, 0x44, 0x5d, 0x22, 0x21, 0x40, 0x16, 0x13, 0x8b, 0x75, 0x89, 0x5f,
+	0x0e, 0xab, 0xb9, 0x7b, 0xc1, 0x77, 0x46, 0xc9, 0xca, 0x27, 0x40, 0x4c, 0x1d, 0xf9, 0x38, 0x1d,
+	0x3b, 0x22, 0x06, 0x83, 0x92, 0x6f, 0x90, 0x81, 0x19, 0xf9, 0xec, 0xc4, 0xa6, 0x44, 0x8a, 0x98,
+	0xec, 0x7b, 0xff, 0xa7, 0xdf, 0xfb, 0xdf, 0xff, 0xe9, 0xec, 0x8e, 0xc6, 0x4b, 0x90, 0x13, 0x36,
+	0xd6, 0x98, 0x2c, 0xe8, 0x8c, 0x25, 0x4c, 0x28, 0x7f, 0x96, 0xa0, 0x46, 0xc7, 0x91, 0x38, 0x9a,
+	0x82, 0x5f, 0x6f, 0xe8, 0xb6, 0x38, 0x72, 0x34, 0x32, 0xcd, 0xff, 0x8a, 0xce, 0xae, 0xcb, 0x11,
+	0xf9, 0x14, 0xa8, 0x39, 0x8d, 0xd2, 0x09, 0x8d, 0xd2, 0x84, 0xe9, 0x18, 0x65, 0xa1, 0xf7, 0x7e,
+	0x1f, 0xda, 0x8d, 0xb7, 0x06, 0xed, 0x7c, 0xb4, 0x9b, 0x2c, 0x8d, 0x62, 0x1d, 0x4e, 0x91, 0x87,
+	0x09, 0x68, 0x90, 0x79, 0x5f, 0x9b, 0x1c, 0x91, 0x93, 0xfb, 0x2f, 0x3a, 0x7e, 0x01, 0xf2, 0x37,
+	0x20, 0xff, 0xa2, 0x04, 0x0d, 0x8e, 0xaf, 0x33, 0xcf, 0x5a, 0x67, 0x5e, 0x77, 0xc1, 0xc4, 0xf4,
+	0xbc, 0xb7, 0x83, 0xd1, 0xbb, 0xfa, 0xe9, 0x91, 0xe0, 0x81, 0x51, 0x5e, 0x21, 0x0f, 0x36, 0x75,
+	0xe7, 0x2b, 0xb1, 0x1f, 0x25, 0x30, 0x81, 0x04, 0xe4, 0x18, 0xc2, 0x38, 0xaa, 0x8d, 0xbd, 0xb3,
+	0x6f, 0x6c, 0x3f, 0x1f, 0xbb, 0xcc, 0xbc, 0x56, 0xb0, 0x01, 0x0c, 0x2f, 0xb6, 0xe4, 0x75, 0xe6,
+	0x3d, 0x2d, 0xec, 0xec, 0xc6, 0x17, 0x8e, 0x5a, 0x5b, 0x71, 0x18, 0x55, 0xa6, 0xd0, 0x76, 0x12,
+	0x88, 0x40, 0xcc, 0xf2, 0x53, 0xa8, 0x63, 0x01, 0x98, 0xea, 0xf6, 0xdd, 0x7d, 0x7e, 0x9e, 0x95,
+	0x31, 0x74, 0x36, 0x73, 0x6f, 0x23, 0xca, 0x14, 0x2a, 0xe1, 0x5d, 0x51, 0xcf, 0x83, 0x17, 0x6c,
+	0x1e, 0x32, 0xad, 0x41, 0x69, 0x03, 0x0b, 0x19, 0x87, 0xf6, 0xc1, 0x7f, 0x06, 0xbf, 0x83, 0x51,
+	0x8e, 0x14, 0x6c, 0xde, 0xaf, 0x84, 0x3e, 0x07, 0xe7, 0x33, 0xb1, 0x1f, 0x8a, 0x58, 0x86, 0x35,
+	0x97, 0x4c, 0x60, 0x2a, 0x75, 0xfb, 0xf0, 0x88, 0x9c, 0xdc, 0x1b, 0xbc, 0xce, 0xd1, 0x3f, 0x32,
+	0xef, 0x98, 0xc7, 0xfa, 0x43, 0x3a, 0xf2, 0xc7, 0x28, 0xe8, 0x18, 0x95, 0x40, 0x55, 0x7e, 0x4e,
+	0x55, 0x74, 0x49, 0xf5, 0x62, 0x06, 0xca, 0x1f, 0x4a, 0xbd, 0xce, 0xbc, 0x27, 0xa5, 0x89, 0x5d,
+	0xd0, 0x5e, 0xd0, 0x14, 0xb1, 0x0c, 0xb6, 0xe5, 0xbe, 0xa9, 0x3a, 0xa9, 0xdd, 0xaa, 0xb5, 0x56,
+	0xab, 0x6f, 0xec, 0xbb, 0xf8, 0xf3, 0xf2, 0xe2, 0x8f, 0xff, 0x89, 0xfa, 0xd6, 0x82, 0x9b, 0x95,
+	0xb4, 0xdd, 0xef, 0xf9, 0xc1, 0xd5, 0x37, 0xcf, 0x1a, 0xbc, 0xb9, 0x5e, 0xba, 0xe4, 0x66, 0xe9,
+	0x92, 0x5f, 0x4b, 0x97, 0x7c, 0x59, 0xb9, 0xd6, 0xcd, 0xca, 0xb5, 0xbe, 0xaf, 0x5c, 0xeb, 0xfd,
+	0x59, 0xed, 0xce, 0xe6, 0x9d, 0x9d, 0x32, 0xa5, 0x40, 0xab, 0xe2, 0x40, 0x3f, 0x9d, 0xd1, 0x39,
+	0xfd, 0xeb, 0x69, 0x9a, 0x18, 0x46, 0x0d, 0xe3, 0xf3, 0xe5, 0x9f, 0x01, 0x00, 0x6d, 0x1c, 0x54,
+	0x9a, 0xb7, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -99,6 +175,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RedemptionRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RedemptionRetention):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinRedemptionAmount.Size()
+		i -= size
+		if _, err := m.MinRedemptionAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAttestationAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAttestationAge):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RedemptionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RedemptionTimeout):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ReferenceIDRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReferenceIDRetention):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AuditLogRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AuditLogRetention):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -119,6 +245,18 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AuditLogRetention)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReferenceIDRetention)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RedemptionTimeout)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAttestationAge)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinRedemptionAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RedemptionRetention)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -157,6 +295,205 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLogRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AuditLogRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceIDRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ReferenceIDRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RedemptionTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttestationAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxAttestationAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRedemptionAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRedemptionAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RedemptionRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ValidateWindow checks that exactly one start and one end of the window are set and, when both
+// are given in the same unit, that the window ends after it starts.
+func (s PauseSchedule) ValidateWindow() error {
+	if (s.StartHeight > 0) == (s.StartTime != nil) {
+		return errors.New("exactly one of start height and start time must be set")
+	}
+	if (s.EndHeight > 0) == (s.EndTime != nil) {
+		return errors.New("exactly one of end height and end time must be set")
+	}
+	if s.StartHeight < 0 || s.EndHeight < 0 {
+		return errors.New("heights can not be negative")
+	}
+
+	if s.StartHeight > 0 && s.EndHeight > 0 && s.EndHeight <= s.StartHeight {
+		return fmt.Errorf("end height %d must be after start height %d", s.EndHeight, s.StartHeight)
+	}
+	if s.StartTime != nil && s.EndTime != nil && !s.EndTime.After(*s.StartTime) {
+		return fmt.Errorf("end time %s must be after start time %s", s.EndTime.Format(time.RFC3339), s.StartTime.Format(time.RFC3339))
+	}
+
+	return ValidatePauseOperations(s.Operations)
+}
+
+// StartReached returns true once the block at height and time is at or past the start of the window.
+func (s PauseSchedule) StartReached(height int64, t time.Time) bool {
+	return reached(s.StartHeight, s.StartTime, height, t)
+}
+
+// EndReached returns true once the block at height and time is at or past the end of the window.
+func (s PauseSchedule) EndReached(height int64, t time.Time) bool {
+	return reached(s.EndHeight, s.EndTime, height, t)
+}
+
+func reached(targetHeight int64, targetTime *time.Time, height int64, t time.Time) bool {
+	if targetTime != nil {
+		return !t.Before(*targetTime)
+	}
+
+	return height >= targetHeight
+}
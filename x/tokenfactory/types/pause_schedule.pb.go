@@ -0,0 +1,805 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/pause_schedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PauseSchedule pauses operations of a minting denom for a window that starts at start_height or
+// start_time and ends at end_height or end_time, whichever of each pair is set.
+type PauseSchedule struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Id    uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// operations paused during the window, every operation if empty.
+	Operations  []PauseOperation `protobuf:"varint,3,rep,packed,name=operations,proto3,enum=noble.tokenfactory.PauseOperation" json:"operations,omitempty"`
+	StartHeight int64            `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	StartTime   *time.Time       `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	EndHeight   int64            `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	EndTime     *time.Time       `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// active is true once the window has started and its operations are paused.
+	Active bool `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	// paused_operations are the operations that the schedule paused when its window started, which
+	// excludes those that were already paused. Only these are unpaused when the window ends.
+	PausedOperations []PauseOperation `protobuf:"varint,9,rep,packed,name=paused_operations,json=pausedOperations,proto3,enum=noble.tokenfactory.PauseOperation" json:"paused_operations,omitempty"`
+}
+
+func (m *PauseSchedule) Reset()         { *m = PauseSchedule{} }
+func (m *PauseSchedule) String() string { return proto.CompactTextString(m) }
+func (*PauseSchedule) ProtoMessage()    {}
+func (*PauseSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd3e41a6e0c5089, []int{0}
+}
+func (m *PauseSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseSchedule.Merge(m, src)
+}
+func (m *PauseSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseSchedule proto.InternalMessageInfo
+
+func (m *PauseSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PauseSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PauseSchedule) GetOperations() []PauseOperation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+func (m *PauseSchedule) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *PauseSchedule) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *PauseSchedule) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *PauseSchedule) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *PauseSchedule) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *PauseSchedule) GetPausedOperations() []PauseOperation {
+	if m != nil {
+		return m.PausedOperations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PauseSchedule)(nil), "noble.tokenfactory.PauseSchedule")
+}
+
+func init() { proto.RegisterFile("tokenfactory/pause_schedule.proto", fileDescriptor_afd3e41a6e0c5089) }
+
+var fileDescriptor_afd3e41a6e0c5089 = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xc1, 0xae, 0xd2, 0x40,
+	0x14, 0x65, 0x28, 0x8f, 0x47, 0xe7, 0xe9, 0x8b, 0x4e, 0x5e, 0x4c, 0x25, 0xb1, 0x14, 0x56, 0xdd,
+	0x38, 0x93, 0x60, 0x58, 0xb9, 0x30, 0x61, 0xe5, 0x0e, 0x53, 0x5d, 0xb9, 0x21, 0x6d, 0xe7, 0xd2,
+	0x36, 0xd2, 0x4e, 0xd3, 0x99, 0x12, 0xf9, 0x0b, 0xbe, 0xc1, 0xaf, 0x71, 0xc9, 0xd2, 0x9d, 0x06,
+	0x7e, 0xc4, 0x74, 0xa6, 0x35, 0x18, 0x36, 0xec, 0x7a, 0xce, 0x3d, 0xf7, 0x9c, 0x9e, 0x3b, 0x78,
+	0xaa, 0xc4, 0x37, 0x28, 0x36, 0x61, 0xac, 0x44, 0xb5, 0x67, 0x65, 0x58, 0x4b, 0x58, 0xcb, 0x38,
+	0x05, 0x5e, 0x6f, 0x81, 0x96, 0x95, 0x50, 0x82, 0x90, 0x42, 0x44, 0x5b, 0xa0, 0x97, 0xc2, 0xf1,
+	0x53, 0x22, 0x12, 0xa1, 0xc7, 0xac, 0xf9, 0x32, 0xca, 0xf1, 0x24, 0x11, 0x22, 0xd9, 0x02, 0xd3,
+	0x28, 0xaa, 0x37, 0x4c, 0x65, 0x39, 0x48, 0x15, 0xe6, 0x65, 0x2b, 0x78, 0x7d, 0x9d, 0xc6, 0xcd,
+	0x68, 0xf6, 0xc3, 0xc2, 0xcf, 0x3f, 0x35, 0xc4, 0xe7, 0x36, 0x9d, 0x3c, 0xe1, 0x3b, 0x0e, 0x85,
+	0xc8, 0x1d, 0xe4, 0x21, 0xdf, 0x0e, 0x0c, 0x20, 0x8f, 0xb8, 0x9f, 0x71, 0xa7, 0xef, 0x21, 0x7f,
+	0x10, 0xf4, 0x33, 0x4e, 0x96, 0x18, 0x8b, 0x12, 0xaa, 0x50, 0x65, 0xa2, 0x90, 0x8e, 0xe5, 0x59,
+	0xfe, 0xe3, 0x7c, 0x46, 0xaf, 0x7f, 0x99, 0x6a, 0xf3, 0x55, 0x27, 0x0d, 0x2e, 0xb6, 0xc8, 0x14,
+	0x3f, 0x93, 0x2a, 0xac, 0xd4, 0x3a, 0x85, 0x2c, 0x49, 0x95, 0x33, 0xf0, 0x90, 0x6f, 0x05, 0x0f,
+	0x9a, 0xfb, 0xa8, 0x29, 0xf2, 0x01, 0x63, 0x23, 0x69, 0x2a, 0x39, 0x77, 0x1e, 0xf2, 0x1f, 0xe6,
+	0x63, 0x6a, 0xfa, 0xd2, 0xae, 0x2f, 0xfd, 0xd2, 0xf5, 0x5d, 0x0e, 0x0e, 0xbf, 0x27, 0x28, 0xb0,
+	0xf5, 0x4e, 0xc3, 0x92, 0x37, 0x18, 0x43, 0xc1, 0xbb, 0x84, 0xa1, 0x4e, 0xb0, 0xa1, 0xe0, 0xad,
+	0xff, 0x7b, 0x3c, 0x6a, 0xc6, 0xda, 0xfd, 0xfe, 0x46, 0xf7, 0x7b, 0x28, 0xb8, 0xf6, 0x7e, 0x85,
+	0x87, 0x61, 0xac, 0xb2, 0x1d, 0x38, 0x23, 0x0f, 0xf9, 0xa3, 0xa0, 0x45, 0x64, 0x85, 0x5f, 0x9a,
+	0x1b, 0xaf, 0x2f, 0x4e, 0x64, 0xdf, 0x7c, 0xa2, 0x17, 0x66, 0xf9, 0x1f, 0x21, 0x97, 0xab, 0x9f,
+	0x27, 0x17, 0x1d, 0x4f, 0x2e, 0xfa, 0x73, 0x72, 0xd1, 0xe1, 0xec, 0xf6, 0x8e, 0x67, 0xb7, 0xf7,
+	0xeb, 0xec, 0xf6, 0xbe, 0x2e, 0x92, 0x4c, 0xa5, 0x75, 0x44, 0x63, 0x91, 0x33, 0xed, 0xfc, 0x36,
+	0x94, 0x12, 0x94, 0x34, 0x80, 0xed, 0x16, 0xec, 0x3b, 0xfb, 0xef, 0xf1, 0xd5, 0xbe, 0x04, 0x19,
+	0x0d, 0x75, 0xb9, 0x77, 0x7f, 0x07, 0x00, 0x02, 0x12, 0xba, 0x02, 0x87, 0x02, 0x00, 0x00,
+}
+
+func (m *PauseSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedOperations) > 0 {
+		dAtA2 := make([]byte, len(m.PausedOperations)*10)
+		var j1 int
+		for _, num := range m.PausedOperations {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintPauseSchedule(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EndTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintPauseSchedule(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintPauseSchedule(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintPauseSchedule(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintPauseSchedule(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Operations) > 0 {
+		dAtA6 := make([]byte, len(m.Operations)*10)
+		var j5 int
+		for _, num := range m.Operations {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintPauseSchedule(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintPauseSchedule(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPauseSchedule(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPauseSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPauseSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PauseSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPauseSchedule(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovPauseSchedule(uint64(m.Id))
+	}
+	if len(m.Operations) > 0 {
+		l = 0
+		for _, e := range m.Operations {
+			l += sovPauseSchedule(uint64(e))
+		}
+		n += 1 + sovPauseSchedule(uint64(l)) + l
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovPauseSchedule(uint64(m.StartHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovPauseSchedule(uint64(l))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovPauseSchedule(uint64(m.EndHeight))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovPauseSchedule(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if len(m.PausedOperations) > 0 {
+		l = 0
+		for _, e := range m.PausedOperations {
+			l += sovPauseSchedule(uint64(e))
+		}
+		n += 1 + sovPauseSchedule(uint64(l)) + l
+	}
+	return n
+}
+
+func sovPauseSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPauseSchedule(x uint64) (n int) {
+	return sovPauseSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PauseSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPauseSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPauseSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPauseSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPauseSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPauseSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v PauseOperation
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPauseSchedule
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PauseOperation(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Operations = append(m.Operations, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPauseSchedule
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPauseSchedule
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPauseSchedule
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Operations) == 0 {
+					m.Operations = make([]PauseOperation, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PauseOperation
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPauseSchedule
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PauseOperation(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Operations = append(m.Operations, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPauseSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPauseSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPauseSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPauseSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPauseSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPauseSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPauseSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPauseSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPauseSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 9:
+			if wireType == 0 {
+				var v PauseOperation
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPauseSchedule
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PauseOperation(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PausedOperations = append(m.PausedOperations, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPauseSchedule
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPauseSchedule
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPauseSchedule
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PausedOperations) == 0 {
+					m.PausedOperations = make([]PauseOperation, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PauseOperation
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPauseSchedule
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PauseOperation(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedOperations = append(m.PausedOperations, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedOperations", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPauseSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPauseSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPauseSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPauseSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPauseSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPauseSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPauseSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPauseSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPauseSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPauseSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPauseSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPauseSchedule = fmt.Errorf("proto: unexpected end of group")
+)
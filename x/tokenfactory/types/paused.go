@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AllPauseOperations are the operations changed by a MsgPause or MsgUnpause that does not list any.
+var AllPauseOperations = []PauseOperation{PauseMint, PauseBurn, PauseTransfer, PauseIBCOutbound}
+
+// ParsePauseOperation parses an operation from its short name (mint, burn, transfer or ibc-outbound)
+// or its full enum name.
+func ParsePauseOperation(s string) (PauseOperation, error) {
+	name := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(s), "-", "_"))
+	if !strings.HasPrefix(name, "PAUSE_OPERATION_") {
+		name = "PAUSE_OPERATION_" + name
+	}
+
+	op, ok := PauseOperation_value[name]
+	if !ok || PauseOperation(op) == PauseOperationUnspecified {
+		return PauseOperationUnspecified, fmt.Errorf("unknown pause operation %q", s)
+	}
+
+	return PauseOperation(op), nil
+}
+
+// ValidatePauseOperations checks that every operation is known and listed at most once.
+func ValidatePauseOperations(ops []PauseOperation) error {
+	seen := make(map[PauseOperation]bool)
+	for _, op := range ops {
+		if _, ok := PauseOperation_name[int32(op)]; !ok || op == PauseOperationUnspecified {
+			return fmt.Errorf("unknown pause operation %d", op)
+		}
+		if seen[op] {
+			return fmt.Errorf("duplicate pause operation %s", op)
+		}
+		seen[op] = true
+	}
+
+	return nil
+}
+
+// IsPaused returns true if the operation is paused.
+func (p Paused) IsPaused(op PauseOperation) bool {
+	switch op {
+	case PauseMint:
+		return p.Mint
+	case PauseBurn:
+		return p.Burn
+	case PauseTransfer:
+		return p.Transfer
+	case PauseIBCOutbound:
+		return p.IBCOutbound
+	default:
+		return false
+	}
+}
+
+// SetOperations pauses or unpauses the operations, or every operation if none are given, and keeps
+// the aggregate Paused flag in sync.
+func (p *Paused) SetOperations(ops []PauseOperation, paused bool) {
+	if len(ops) == 0 {
+		ops = AllPauseOperations
+	}
+
+	for _, op := range ops {
+		switch op {
+		case PauseMint:
+			p.Mint = paused
+		case PauseBurn:
+			p.Burn = paused
+		case PauseTransfer:
+			p.Transfer = paused
+		case PauseIBCOutbound:
+			p.IBCOutbound = paused
+		}
+	}
+
+	p.Paused = p.Mint || p.Burn || p.Transfer || p.IBCOutbound
+}
+
+// PausedOperations returns the operations that are currently paused.
+func (p Paused) PausedOperations() (ops []PauseOperation) {
+	for _, op := range AllPauseOperations {
+		if p.IsPaused(op) {
+			ops = append(ops, op)
+		}
+	}
+
+	return ops
+}
+
+// FormatPauseOperations returns a comma separated list of the operations.
+func FormatPauseOperations(ops []PauseOperation) string {
+	names := make([]string, len(ops))
+	for i, op := range ops {
+		names[i] = op.String()
+	}
+
+	return strings.Join(names, ",")
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -22,8 +23,49 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PauseOperation is an operation on a minting denom that the pauser can pause on its own.
+type PauseOperation int32
+
+const (
+	PauseOperationUnspecified PauseOperation = 0
+	PauseMint                 PauseOperation = 1
+	PauseBurn                 PauseOperation = 2
+	PauseTransfer             PauseOperation = 3
+	PauseIBCOutbound          PauseOperation = 4
+)
+
+var PauseOperation_name = map[int32]string{
+	0: "PAUSE_OPERATION_UNSPECIFIED",
+	1: "PAUSE_OPERATION_MINT",
+	2: "PAUSE_OPERATION_BURN",
+	3: "PAUSE_OPERATION_TRANSFER",
+	4: "PAUSE_OPERATION_IBC_OUTBOUND",
+}
+
+var PauseOperation_value = map[string]int32{
+	"PAUSE_OPERATION_UNSPECIFIED":  0,
+	"PAUSE_OPERATION_MINT":         1,
+	"PAUSE_OPERATION_BURN":         2,
+	"PAUSE_OPERATION_TRANSFER":     3,
+	"PAUSE_OPERATION_IBC_OUTBOUND": 4,
+}
+
+func (x PauseOperation) String() string {
+	return proto.EnumName(PauseOperation_name, int32(x))
+}
+
+func (PauseOperation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f80e08031f66ef0e, []int{0}
+}
+
 type Paused struct {
-	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// paused is true while any operation of the denom is paused.
+	Paused      bool   `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Mint        bool   `protobuf:"varint,3,opt,name=mint,proto3" json:"mint,omitempty"`
+	Burn        bool   `protobuf:"varint,4,opt,name=burn,proto3" json:"burn,omitempty"`
+	Transfer    bool   `protobuf:"varint,5,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	IBCOutbound bool   `protobuf:"varint,6,opt,name=ibc_outbound,json=ibcOutbound,proto3" json:"ibc_outbound,omitempty"`
 }
 
 func (m *Paused) Reset()         { *m = Paused{} }
@@ -66,24 +108,77 @@ func (m *Paused) GetPaused() bool {
 	return false
 }
 
+func (m *Paused) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Paused) GetMint() bool {
+	if m != nil {
+		return m.Mint
+	}
+	return false
+}
+
+func (m *Paused) GetBurn() bool {
+	if m != nil {
+		return m.Burn
+	}
+	return false
+}
+
+func (m *Paused) GetTransfer() bool {
+	if m != nil {
+		return m.Transfer
+	}
+	return false
+}
+
+func (m *Paused) GetIBCOutbound() bool {
+	if m != nil {
+		return m.IBCOutbound
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("noble.tokenfactory.PauseOperation", PauseOperation_name, PauseOperation_value)
 	proto.RegisterType((*Paused)(nil), "noble.tokenfactory.Paused")
 }
 
 func init() { proto.RegisterFile("tokenfactory/paused.proto", fileDescriptor_f80e08031f66ef0e) }
 
 var fileDescriptor_f80e08031f66ef0e = []byte{
-	// 153 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x48, 0x2c, 0x2d, 0x4e, 0x4d, 0xd1,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0xcb, 0x4f, 0xca, 0x49, 0xd5, 0x43, 0x56, 0xa0,
-	0xa4, 0xc0, 0xc5, 0x16, 0x00, 0x56, 0x23, 0x24, 0xc6, 0xc5, 0x06, 0x51, 0x2d, 0xc1, 0xa8, 0xc0,
-	0xa8, 0xc1, 0x11, 0x04, 0xe5, 0x39, 0xf9, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
-	0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c,
-	0x43, 0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd8, 0x68,
-	0xdd, 0xc4, 0xe2, 0xe2, 0xd4, 0x92, 0x62, 0x08, 0x47, 0xbf, 0xcc, 0x54, 0xbf, 0x42, 0x1f, 0xc5,
-	0x35, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xd7, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x2c, 0xdf, 0xcd, 0xef, 0xaa, 0x00, 0x00, 0x00,
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x6f, 0x94, 0x40,
+	0x18, 0xc6, 0x99, 0xed, 0x96, 0xb4, 0x53, 0xab, 0x38, 0x21, 0x86, 0xa2, 0x22, 0xf1, 0x62, 0x63,
+	0xe2, 0x92, 0x68, 0xea, 0xd1, 0x64, 0xd9, 0xd2, 0x84, 0x43, 0x81, 0xb0, 0x70, 0xf1, 0x42, 0xf8,
+	0x33, 0xbb, 0x12, 0xdd, 0x19, 0x02, 0x83, 0xb1, 0xdf, 0xc0, 0x70, 0xd2, 0x0f, 0xc0, 0xc9, 0xbb,
+	0x9f, 0xc3, 0x63, 0x8f, 0x9e, 0x8c, 0x61, 0xbf, 0x88, 0x61, 0x68, 0xcd, 0xee, 0xa6, 0xb7, 0xf7,
+	0x79, 0xde, 0xdf, 0x33, 0x99, 0xf7, 0xcd, 0x0b, 0x4f, 0x18, 0xfd, 0x88, 0xc9, 0x22, 0x4e, 0x19,
+	0x2d, 0xaf, 0x8c, 0x22, 0xae, 0x2b, 0x9c, 0x4d, 0x8a, 0x92, 0x32, 0x8a, 0x10, 0xa1, 0xc9, 0x27,
+	0x3c, 0xd9, 0x04, 0x54, 0x79, 0x49, 0x97, 0x94, 0xb7, 0x8d, 0xbe, 0x1a, 0xc8, 0xe7, 0x3f, 0x01,
+	0x14, 0x3d, 0x1e, 0x45, 0x8f, 0xa0, 0x38, 0x3c, 0xa2, 0x00, 0x1d, 0x9c, 0x1e, 0xf8, 0x37, 0x0a,
+	0xc9, 0x70, 0x3f, 0xc3, 0x84, 0xae, 0x94, 0x91, 0x0e, 0x4e, 0x0f, 0xfd, 0x41, 0x20, 0x04, 0xc7,
+	0xab, 0x9c, 0x30, 0x65, 0x8f, 0xb3, 0xbc, 0xee, 0xbd, 0xa4, 0x2e, 0x89, 0x32, 0x1e, 0xbc, 0xbe,
+	0x46, 0x2a, 0x3c, 0x60, 0x65, 0x4c, 0xaa, 0x05, 0x2e, 0x95, 0x7d, 0xee, 0xff, 0xd7, 0xe8, 0x35,
+	0xbc, 0x97, 0x27, 0x69, 0x44, 0x6b, 0x96, 0xd0, 0x9a, 0x64, 0x8a, 0xd8, 0xf7, 0xcd, 0x07, 0xdd,
+	0x9f, 0x67, 0x47, 0xb6, 0x39, 0x73, 0x6f, 0x6c, 0xff, 0x28, 0x4f, 0xd2, 0x5b, 0xf1, 0xf2, 0xfb,
+	0x08, 0xde, 0xe7, 0x1f, 0x76, 0x0b, 0x5c, 0xc6, 0x2c, 0xa7, 0x04, 0xbd, 0x83, 0x8f, 0xbd, 0x69,
+	0x38, 0xb7, 0x22, 0xd7, 0xb3, 0xfc, 0x69, 0x60, 0xbb, 0x4e, 0x14, 0x3a, 0x73, 0xcf, 0x9a, 0xd9,
+	0x17, 0xb6, 0x75, 0x2e, 0x09, 0xea, 0xd3, 0xa6, 0xd5, 0x4f, 0xb6, 0x43, 0x21, 0xa9, 0x0a, 0x9c,
+	0xe6, 0x8b, 0x1c, 0x67, 0xe8, 0x05, 0x94, 0x77, 0xf3, 0x97, 0xb6, 0x13, 0x48, 0x40, 0x3d, 0x6e,
+	0x5a, 0xfd, 0x90, 0x07, 0x2f, 0xfb, 0xf9, 0xee, 0x00, 0xcd, 0xd0, 0x77, 0xa4, 0xd1, 0x06, 0x68,
+	0xf6, 0x43, 0x1b, 0x50, 0xd9, 0x05, 0x03, 0x7f, 0xea, 0xcc, 0x2f, 0x2c, 0x5f, 0xda, 0x53, 0x1f,
+	0x36, 0xad, 0x7e, 0xcc, 0xe1, 0xe0, 0x76, 0x13, 0x6f, 0xe1, 0x93, 0xdd, 0x80, 0x6d, 0xce, 0x22,
+	0x37, 0x0c, 0x4c, 0x37, 0x74, 0xce, 0xa5, 0xb1, 0x2a, 0x37, 0xad, 0x2e, 0xf1, 0xd0, 0xc6, 0x6a,
+	0xd4, 0xf1, 0xd7, 0x1f, 0x9a, 0x60, 0xba, 0xbf, 0x3a, 0x0d, 0x5c, 0x77, 0x1a, 0xf8, 0xdb, 0x69,
+	0xe0, 0xdb, 0x5a, 0x13, 0xae, 0xd7, 0x9a, 0xf0, 0x7b, 0xad, 0x09, 0xef, 0xcf, 0x96, 0x39, 0xfb,
+	0x50, 0x27, 0x93, 0x94, 0xae, 0x0c, 0x7e, 0x13, 0xaf, 0xe2, 0xaa, 0xc2, 0xac, 0x1a, 0x84, 0xf1,
+	0xf9, 0xcc, 0xf8, 0x62, 0x6c, 0x9d, 0x11, 0xbb, 0x2a, 0x70, 0x95, 0x88, 0xfc, 0x38, 0xde, 0xfc,
+	0x1b, 0x00, 0xcd, 0x10, 0xd0, 0xfb, 0x63, 0x02, 0x00, 0x00,
 }
 
 func (m *Paused) Marshal() (dAtA []byte, err error) {
@@ -106,6 +201,53 @@ func (m *Paused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IBCOutbound {
+		i--
+		if m.IBCOutbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Transfer {
+		i--
+		if m.Transfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Burn {
+		i--
+		if m.Burn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Mint {
+		i--
+		if m.Mint {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPaused(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Paused {
 		i--
 		if m.Paused {
@@ -139,6 +281,22 @@ func (m *Paused) Size() (n int) {
 	if m.Paused {
 		n += 2
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPaused(uint64(l))
+	}
+	if m.Mint {
+		n += 2
+	}
+	if m.Burn {
+		n += 2
+	}
+	if m.Transfer {
+		n += 2
+	}
+	if m.IBCOutbound {
+		n += 2
+	}
 	return n
 }
 
@@ -197,6 +355,118 @@ func (m *Paused) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaused
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaused
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mint = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Burn = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Transfer = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCOutbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IBCOutbound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPaused(dAtA[iNdEx:])
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxReferenceIDLength is the maximum length of the reference id of a mint or burn.
+const MaxReferenceIDLength = 128
+
+// ValidateReferenceID checks the optional reference id of a mint or burn.
+func ValidateReferenceID(referenceID string) error {
+	if len(referenceID) > MaxReferenceIDLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "reference id can not be longer than %d characters", MaxReferenceIDLength)
+	}
+
+	return nil
+}
+
+// ParseReferenceOperation parses a reference operation from its short name (e.g. mint) or its full enum name.
+func ParseReferenceOperation(s string) (ReferenceOperation, error) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	if !strings.HasPrefix(name, "REFERENCE_OPERATION_") {
+		name = "REFERENCE_OPERATION_" + name
+	}
+
+	op, ok := ReferenceOperation_value[name]
+	if !ok || ReferenceOperation(op) == ReferenceOperationUnspecified {
+		return ReferenceOperationUnspecified, fmt.Errorf("unknown reference operation %q", s)
+	}
+
+	return ReferenceOperation(op), nil
+}
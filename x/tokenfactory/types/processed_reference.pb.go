@@ -0,0 +1,598 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/processed_reference.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReferenceOperation is the operation a reference id was processed for.
+type ReferenceOperation int32
+
+const (
+	ReferenceOperationUnspecified ReferenceOperation = 0
+	ReferenceMint                 ReferenceOperation = 1
+	ReferenceBurn                 ReferenceOperation = 2
+)
+
+var ReferenceOperation_name = map[int32]string{
+	0: "REFERENCE_OPERATION_UNSPECIFIED",
+	1: "REFERENCE_OPERATION_MINT",
+	2: "REFERENCE_OPERATION_BURN",
+}
+
+var ReferenceOperation_value = map[string]int32{
+	"REFERENCE_OPERATION_UNSPECIFIED": 0,
+	"REFERENCE_OPERATION_MINT":        1,
+	"REFERENCE_OPERATION_BURN":        2,
+}
+
+func (x ReferenceOperation) String() string {
+	return proto.EnumName(ReferenceOperation_name, int32(x))
+}
+
+func (ReferenceOperation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c699c7ecb3641794, []int{0}
+}
+
+// ProcessedReference records that a mint or burn carrying an off-chain reference id was processed,
+// so that a retry of the same message is rejected. Reference ids are unique per minter and operation
+// of a denom, so a mint and a burn can carry the same reference id, and are pruned once the reference
+// id retention has passed.
+type ProcessedReference struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// address is the minter that sent the mint or burn.
+	Address     string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ReferenceID string `protobuf:"bytes,3,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	// height is the height of the block that included the mint or burn.
+	Height    int64              `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Time      time.Time          `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	Operation ReferenceOperation `protobuf:"varint,6,opt,name=operation,proto3,enum=noble.tokenfactory.ReferenceOperation" json:"operation,omitempty"`
+}
+
+func (m *ProcessedReference) Reset()         { *m = ProcessedReference{} }
+func (m *ProcessedReference) String() string { return proto.CompactTextString(m) }
+func (*ProcessedReference) ProtoMessage()    {}
+func (*ProcessedReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c699c7ecb3641794, []int{0}
+}
+func (m *ProcessedReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProcessedReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProcessedReference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProcessedReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessedReference.Merge(m, src)
+}
+func (m *ProcessedReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProcessedReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessedReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessedReference proto.InternalMessageInfo
+
+func (m *ProcessedReference) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ProcessedReference) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ProcessedReference) GetReferenceID() string {
+	if m != nil {
+		return m.ReferenceID
+	}
+	return ""
+}
+
+func (m *ProcessedReference) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ProcessedReference) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ProcessedReference) GetOperation() ReferenceOperation {
+	if m != nil {
+		return m.Operation
+	}
+	return ReferenceOperationUnspecified
+}
+
+func init() {
+	proto.RegisterEnum("noble.tokenfactory.ReferenceOperation", ReferenceOperation_name, ReferenceOperation_value)
+	proto.RegisterType((*ProcessedReference)(nil), "noble.tokenfactory.ProcessedReference")
+}
+
+func init() {
+	proto.RegisterFile("tokenfactory/processed_reference.proto", fileDescriptor_c699c7ecb3641794)
+}
+
+var fileDescriptor_c699c7ecb3641794 = []byte{
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x3d, 0x69, 0x1a, 0xe8, 0x84, 0x4b, 0x18, 0x55, 0xc8, 0xb2, 0x84, 0x6d, 0x58, 0x54,
+	0x11, 0x12, 0x1e, 0x29, 0xa8, 0x12, 0x5b, 0xdc, 0x38, 0x92, 0x17, 0x75, 0xa2, 0x21, 0xd9, 0xb0,
+	0x89, 0x1c, 0xfb, 0xc4, 0xb1, 0x68, 0x3c, 0x96, 0x67, 0x82, 0xe8, 0x1b, 0xa0, 0xae, 0xf2, 0x02,
+	0x5d, 0xf1, 0x2a, 0x2c, 0xba, 0xec, 0x92, 0x55, 0x40, 0xce, 0x8b, 0xa0, 0xd8, 0xd8, 0x5c, 0x42,
+	0x77, 0xf3, 0xcf, 0xf9, 0xfe, 0x73, 0x9b, 0xc1, 0x27, 0x92, 0x7f, 0x80, 0x64, 0xee, 0x07, 0x92,
+	0x67, 0x97, 0x34, 0xcd, 0x78, 0x00, 0x42, 0x40, 0x38, 0xcd, 0x60, 0x0e, 0x19, 0x24, 0x01, 0x58,
+	0x69, 0xc6, 0x25, 0x27, 0x24, 0xe1, 0xb3, 0x0b, 0xb0, 0xfe, 0xa4, 0xb5, 0xe3, 0x88, 0x47, 0xbc,
+	0x08, 0xd3, 0xdd, 0xa9, 0x24, 0x35, 0x23, 0xe2, 0x3c, 0xba, 0x00, 0x5a, 0xa8, 0xd9, 0x6a, 0x4e,
+	0x65, 0xbc, 0x04, 0x21, 0xfd, 0x65, 0x5a, 0x02, 0x2f, 0xd6, 0x0d, 0x4c, 0x46, 0x55, 0x21, 0x56,
+	0xd5, 0x21, 0xc7, 0xf8, 0x30, 0x84, 0x84, 0x2f, 0x55, 0x64, 0xa2, 0xee, 0x11, 0x2b, 0x05, 0x51,
+	0xf1, 0x3d, 0x3f, 0x0c, 0x33, 0x10, 0x42, 0x6d, 0x14, 0xf7, 0x95, 0x24, 0x3d, 0xfc, 0xa0, 0x6e,
+	0x72, 0x1a, 0x87, 0xea, 0xc1, 0x2e, 0x6c, 0x3f, 0xce, 0x37, 0x46, 0xbb, 0x4e, 0xea, 0xf6, 0x59,
+	0xbb, 0x86, 0xdc, 0x90, 0x3c, 0xc5, 0xad, 0x05, 0xc4, 0xd1, 0x42, 0xaa, 0x4d, 0x13, 0x75, 0x0f,
+	0xd8, 0x2f, 0x45, 0xde, 0xe0, 0xe6, 0xae, 0x4b, 0xf5, 0xd0, 0x44, 0xdd, 0x76, 0x4f, 0xb3, 0xca,
+	0x11, 0xac, 0x6a, 0x04, 0x6b, 0x5c, 0x8d, 0x60, 0xdf, 0xbf, 0xd9, 0x18, 0xca, 0xfa, 0xbb, 0x81,
+	0x58, 0xe1, 0x20, 0x7d, 0x7c, 0xc4, 0x53, 0xc8, 0x7c, 0x19, 0xf3, 0x44, 0x6d, 0x99, 0xa8, 0xfb,
+	0xa8, 0x77, 0x62, 0xed, 0xef, 0xca, 0xaa, 0x5b, 0x1a, 0x56, 0x34, 0xfb, 0x6d, 0x7c, 0xf9, 0x15,
+	0x61, 0xb2, 0x4f, 0x90, 0x01, 0x36, 0x98, 0x33, 0x70, 0x98, 0xe3, 0x9d, 0x39, 0xd3, 0xe1, 0xc8,
+	0x61, 0x6f, 0xc7, 0xee, 0xd0, 0x9b, 0x4e, 0xbc, 0x77, 0x23, 0xe7, 0xcc, 0x1d, 0xb8, 0x4e, 0xbf,
+	0xa3, 0x68, 0xcf, 0xaf, 0xae, 0xcd, 0x67, 0xfb, 0xe6, 0x49, 0x22, 0x52, 0x08, 0xe2, 0x79, 0x0c,
+	0x21, 0xa1, 0x58, 0xfd, 0x5f, 0x9e, 0x73, 0xd7, 0x1b, 0x77, 0x90, 0xf6, 0xe4, 0xea, 0xda, 0x7c,
+	0x58, 0x27, 0x38, 0x8f, 0x13, 0x79, 0x97, 0xc1, 0x9e, 0x30, 0xaf, 0xd3, 0xf8, 0xc7, 0x60, 0xaf,
+	0xb2, 0x44, 0x6b, 0x7e, 0xfe, 0xa2, 0x2b, 0xf6, 0xf0, 0x26, 0xd7, 0xd1, 0x6d, 0xae, 0xa3, 0x1f,
+	0xb9, 0x8e, 0xd6, 0x5b, 0x5d, 0xb9, 0xdd, 0xea, 0xca, 0xb7, 0xad, 0xae, 0xbc, 0x3f, 0x8d, 0x62,
+	0xb9, 0x58, 0xcd, 0xac, 0x80, 0x2f, 0x69, 0xb1, 0x9d, 0x57, 0xbe, 0x10, 0x20, 0x45, 0x29, 0xe8,
+	0xc7, 0x53, 0xfa, 0x89, 0xfe, 0xf5, 0x13, 0xe5, 0x65, 0x0a, 0x62, 0xd6, 0x2a, 0x5e, 0xe0, 0xf5,
+	0xcf, 0x01, 0x00, 0x70, 0x2c, 0x4f, 0xf2, 0xa6, 0x02, 0x00, 0x00,
+}
+
+func (m *ProcessedReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessedReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcessedReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		i = encodeVarintProcessedReference(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintProcessedReference(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintProcessedReference(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ReferenceID) > 0 {
+		i -= len(m.ReferenceID)
+		copy(dAtA[i:], m.ReferenceID)
+		i = encodeVarintProcessedReference(dAtA, i, uint64(len(m.ReferenceID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProcessedReference(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProcessedReference(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProcessedReference(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProcessedReference(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProcessedReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProcessedReference(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProcessedReference(uint64(l))
+	}
+	l = len(m.ReferenceID)
+	if l > 0 {
+		n += 1 + l + sovProcessedReference(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProcessedReference(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovProcessedReference(uint64(l))
+	if m.Operation != 0 {
+		n += 1 + sovProcessedReference(uint64(m.Operation))
+	}
+	return n
+}
+
+func sovProcessedReference(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProcessedReference(x uint64) (n int) {
+	return sovProcessedReference(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProcessedReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProcessedReference
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessedReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessedReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProcessedReference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProcessedReference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProcessedReference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProcessedReference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProcessedReference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProcessedReference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProcessedReference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProcessedReference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProcessedReference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProcessedReference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProcessedReference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProcessedReference
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProcessedReference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProcessedReference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= ReferenceOperation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProcessedReference(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProcessedReference
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProcessedReference(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProcessedReference
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProcessedReference
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProcessedReference
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProcessedReference
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProcessedReference
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProcessedReference
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProcessedReference        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProcessedReference          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProcessedReference = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxPayoutInstructionsLength is the maximum length of the payout instructions of a redemption.
+const MaxPayoutInstructionsLength = 256
+
+// ValidatePayoutInstructions checks the payout instructions of a redemption request, which are required.
+func ValidatePayoutInstructions(instructions string) error {
+	if instructions == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "payout instructions can not be empty")
+	}
+
+	if len(instructions) > MaxPayoutInstructionsLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "payout instructions can not be longer than %d characters", MaxPayoutInstructionsLength)
+	}
+
+	return nil
+}
+
+// ParseRedemptionStatus parses a redemption status from its name, with or without the
+// REDEMPTION_STATUS_ prefix and in any case, e.g. "pending".
+func ParseRedemptionStatus(s string) (RedemptionStatus, error) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	if !strings.HasPrefix(name, "REDEMPTION_STATUS_") {
+		name = "REDEMPTION_STATUS_" + name
+	}
+
+	status, ok := RedemptionStatus_value[name]
+	if !ok || RedemptionStatus(status) == RedemptionStatusUnspecified {
+		return RedemptionStatusUnspecified, fmt.Errorf("unknown redemption status %q", s)
+	}
+
+	return RedemptionStatus(status), nil
+}
+
+// IsPending returns true if the redemption waits for a minter to fulfill or reject it.
+func (r Redemption) IsPending() bool {
+	return r.Status == RedemptionStatusPending
+}
+
+// IsEscrowed returns true if the tokens of the redemption are still escrowed, which is while it is
+// pending or held.
+func (r Redemption) IsEscrowed() bool {
+	return r.Status == RedemptionStatusPending || r.Status == RedemptionStatusHeld
+}
+
+// validateRedemption checks a redemption of the genesis state.
+func validateRedemption(redemption Redemption) error {
+	if _, err := sdk.AccAddressFromBech32(redemption.Requester); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "redemption %d has invalid requester address (%s)", redemption.Id, err)
+	}
+
+	if !redemption.Amount.IsValid() || redemption.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "redemption %d has invalid amount %s", redemption.Id, redemption.Amount)
+	}
+
+	if err := ValidatePayoutInstructions(redemption.PayoutInstructions); err != nil {
+		return sdkerrors.Wrapf(err, "redemption %d", redemption.Id)
+	}
+
+	if _, ok := RedemptionStatus_name[int32(redemption.Status)]; !ok || redemption.Status == RedemptionStatusUnspecified {
+		return fmt.Errorf("redemption %d has unknown status %d", redemption.Id, redemption.Status)
+	}
+
+	if redemption.Timeout != nil && !redemption.Timeout.After(redemption.RequestTime) {
+		return fmt.Errorf("redemption %d times out before it was requested", redemption.Id)
+	}
+
+	return nil
+}
@@ -0,0 +1,900 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/redemption.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RedemptionStatus is the state of a redemption request.
+type RedemptionStatus int32
+
+const (
+	RedemptionStatusUnspecified RedemptionStatus = 0
+	// the tokens are escrowed and wait for a minter to fulfill or reject the request.
+	RedemptionStatusPending RedemptionStatus = 1
+	// a minter paid out the redemption off-chain and burned the escrowed tokens.
+	RedemptionStatusFulfilled RedemptionStatus = 2
+	// a minter rejected the request and the escrowed tokens were refunded.
+	RedemptionStatusRejected RedemptionStatus = 3
+	// the request timed out before a minter resolved it and the escrowed tokens were refunded.
+	RedemptionStatusExpired RedemptionStatus = 4
+	// the request was rejected or timed out while the requester was blacklisted. The tokens stay escrowed
+	// until the requester is unblacklisted, at which point they are refunded and the status becomes
+	// rejected, if a minter rejected the request, or expired otherwise.
+	RedemptionStatusHeld RedemptionStatus = 5
+)
+
+var RedemptionStatus_name = map[int32]string{
+	0: "REDEMPTION_STATUS_UNSPECIFIED",
+	1: "REDEMPTION_STATUS_PENDING",
+	2: "REDEMPTION_STATUS_FULFILLED",
+	3: "REDEMPTION_STATUS_REJECTED",
+	4: "REDEMPTION_STATUS_EXPIRED",
+	5: "REDEMPTION_STATUS_HELD",
+}
+
+var RedemptionStatus_value = map[string]int32{
+	"REDEMPTION_STATUS_UNSPECIFIED": 0,
+	"REDEMPTION_STATUS_PENDING":     1,
+	"REDEMPTION_STATUS_FULFILLED":   2,
+	"REDEMPTION_STATUS_REJECTED":    3,
+	"REDEMPTION_STATUS_EXPIRED":     4,
+	"REDEMPTION_STATUS_HELD":        5,
+}
+
+func (x RedemptionStatus) String() string {
+	return proto.EnumName(RedemptionStatus_name, int32(x))
+}
+
+func (RedemptionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c57d77d93e0faa2b, []int{0}
+}
+
+// Redemption is a request of a user to redeem tokens off-chain. The tokens are escrowed in the
+// module account until a minter burns them, or they are refunded.
+type Redemption struct {
+	Id        uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Requester string     `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// payout_instructions tell the minter where to pay out the redemption off-chain.
+	PayoutInstructions string           `protobuf:"bytes,4,opt,name=payout_instructions,json=payoutInstructions,proto3" json:"payout_instructions,omitempty"`
+	Status             RedemptionStatus `protobuf:"varint,5,opt,name=status,proto3,enum=noble.tokenfactory.RedemptionStatus" json:"status,omitempty"`
+	RequestTime        time.Time        `protobuf:"bytes,6,opt,name=request_time,json=requestTime,proto3,stdtime" json:"request_time"`
+	// timeout is when a pending request is refunded automatically, never if unset.
+	Timeout *time.Time `protobuf:"bytes,7,opt,name=timeout,proto3,stdtime" json:"timeout,omitempty"`
+	// resolver is the minter that fulfilled or rejected the request.
+	Resolver string `protobuf:"bytes,8,opt,name=resolver,proto3" json:"resolver,omitempty"`
+	// resolve_time is when the escrow was last settled, which is when a held request was refunded.
+	// Requests are pruned once the redemption retention has passed since their resolve time.
+	ResolveTime *time.Time `protobuf:"bytes,9,opt,name=resolve_time,json=resolveTime,proto3,stdtime" json:"resolve_time,omitempty"`
+	// payout_reference is the off-chain reference of the payout of a fulfilled request.
+	PayoutReference string `protobuf:"bytes,10,opt,name=payout_reference,json=payoutReference,proto3" json:"payout_reference,omitempty"`
+	// reason is why a minter rejected the request.
+	Reason string `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *Redemption) Reset()         { *m = Redemption{} }
+func (m *Redemption) String() string { return proto.CompactTextString(m) }
+func (*Redemption) ProtoMessage()    {}
+func (*Redemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57d77d93e0faa2b, []int{0}
+}
+func (m *Redemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Redemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Redemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Redemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redemption.Merge(m, src)
+}
+func (m *Redemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *Redemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Redemption proto.InternalMessageInfo
+
+func (m *Redemption) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Redemption) GetRequester() string {
+	if m != nil {
+		return m.Requester
+	}
+	return ""
+}
+
+func (m *Redemption) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *Redemption) GetPayoutInstructions() string {
+	if m != nil {
+		return m.PayoutInstructions
+	}
+	return ""
+}
+
+func (m *Redemption) GetStatus() RedemptionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RedemptionStatusUnspecified
+}
+
+func (m *Redemption) GetRequestTime() time.Time {
+	if m != nil {
+		return m.RequestTime
+	}
+	return time.Time{}
+}
+
+func (m *Redemption) GetTimeout() *time.Time {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *Redemption) GetResolver() string {
+	if m != nil {
+		return m.Resolver
+	}
+	return ""
+}
+
+func (m *Redemption) GetResolveTime() *time.Time {
+	if m != nil {
+		return m.ResolveTime
+	}
+	return nil
+}
+
+func (m *Redemption) GetPayoutReference() string {
+	if m != nil {
+		return m.PayoutReference
+	}
+	return ""
+}
+
+func (m *Redemption) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("noble.tokenfactory.RedemptionStatus", RedemptionStatus_name, RedemptionStatus_value)
+	proto.RegisterType((*Redemption)(nil), "noble.tokenfactory.Redemption")
+}
+
+func init() { proto.RegisterFile("tokenfactory/redemption.proto", fileDescriptor_c57d77d93e0faa2b) }
+
+var fileDescriptor_c57d77d93e0faa2b = []byte{
+	// 646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0xe3, 0x10, 0x02, 0x4c, 0x10, 0xd7, 0x9a, 0x8b, 0xb8, 0xc6, 0x80, 0x63, 0x5d, 0xdd,
+	0x45, 0x6e, 0xa5, 0xda, 0x82, 0x16, 0x55, 0x42, 0xa8, 0x52, 0x13, 0x3b, 0xe0, 0x2a, 0x0d, 0x91,
+	0x93, 0x48, 0x55, 0x37, 0x91, 0x63, 0x9f, 0xa4, 0x6e, 0x1d, 0x8f, 0xeb, 0x19, 0x23, 0x78, 0x03,
+	0x94, 0x6e, 0x78, 0x81, 0xac, 0xfa, 0x32, 0x2c, 0x59, 0x76, 0xd5, 0x56, 0xf0, 0x22, 0x95, 0xff,
+	0x04, 0x68, 0xd2, 0xaa, 0xdd, 0xcd, 0x39, 0x73, 0x7e, 0xe7, 0x7c, 0xe7, 0xd3, 0xd8, 0x68, 0x87,
+	0x91, 0xf7, 0xe0, 0x0f, 0x2c, 0x9b, 0x91, 0xf0, 0x5c, 0x0d, 0xc1, 0x81, 0x51, 0xc0, 0x5c, 0xe2,
+	0x2b, 0x41, 0x48, 0x18, 0xc1, 0xd8, 0x27, 0x7d, 0x0f, 0x94, 0x87, 0x45, 0xa2, 0x64, 0x13, 0x3a,
+	0x22, 0x54, 0xed, 0x5b, 0x14, 0xd4, 0xd3, 0xdd, 0x3e, 0x30, 0x6b, 0x57, 0xb5, 0x89, 0x9b, 0x31,
+	0xe2, 0xfa, 0x90, 0x0c, 0x49, 0x72, 0x54, 0xe3, 0x53, 0x96, 0x2d, 0x0f, 0x09, 0x19, 0x7a, 0xa0,
+	0x26, 0x51, 0x3f, 0x1a, 0xa8, 0xcc, 0x1d, 0x01, 0x65, 0xd6, 0x28, 0x48, 0x0b, 0xfe, 0xfd, 0x58,
+	0x40, 0xc8, 0xbc, 0x9b, 0x8f, 0xd7, 0x50, 0xde, 0x75, 0x04, 0x4e, 0xe6, 0x2a, 0x05, 0x33, 0xef,
+	0x3a, 0x78, 0x1b, 0xad, 0x84, 0xf0, 0x21, 0x02, 0xca, 0x20, 0x14, 0xf2, 0x32, 0x57, 0x59, 0x31,
+	0xef, 0x13, 0xf8, 0x19, 0x2a, 0x5a, 0x23, 0x12, 0xf9, 0x4c, 0x58, 0x90, 0xb9, 0x4a, 0x69, 0x6f,
+	0x53, 0x49, 0x45, 0x2a, 0xb1, 0x48, 0x25, 0x13, 0xa9, 0xd4, 0x88, 0xeb, 0x57, 0x0b, 0x57, 0x5f,
+	0xca, 0x39, 0x33, 0x2b, 0xc7, 0x2a, 0xfa, 0x3b, 0xb0, 0xce, 0x49, 0xc4, 0x7a, 0xae, 0x4f, 0x59,
+	0x18, 0xd9, 0xf1, 0x70, 0x2a, 0x14, 0x92, 0x01, 0x38, 0xbd, 0x32, 0x1e, 0xdc, 0xe0, 0x43, 0x54,
+	0xa4, 0xcc, 0x62, 0x11, 0x15, 0x16, 0x65, 0xae, 0xb2, 0xb6, 0xf7, 0x9f, 0x32, 0x6f, 0x91, 0x72,
+	0xbf, 0x47, 0x3b, 0xa9, 0x35, 0x33, 0x06, 0x1f, 0xa1, 0xd5, 0x4c, 0x74, 0x2f, 0xde, 0x5f, 0x28,
+	0x26, 0x6a, 0x45, 0x25, 0x35, 0x47, 0x99, 0x9a, 0xa3, 0x74, 0xa6, 0xe6, 0x54, 0x97, 0x63, 0xb9,
+	0x97, 0x5f, 0xcb, 0x9c, 0x59, 0xca, 0xc8, 0xf8, 0x0e, 0x1f, 0xa0, 0xa5, 0xb8, 0x01, 0x89, 0x98,
+	0xb0, 0xf4, 0xdb, 0x1e, 0x85, 0x84, 0x9f, 0x02, 0x58, 0x44, 0xcb, 0x21, 0x50, 0xe2, 0x9d, 0x42,
+	0x28, 0x2c, 0x27, 0x8b, 0xde, 0xc5, 0xb8, 0x86, 0x56, 0xb3, 0x73, 0x2a, 0x70, 0xe5, 0x0f, 0x9b,
+	0x97, 0x32, 0x2a, 0x11, 0xf7, 0x3f, 0xe2, 0x33, 0x53, 0x43, 0x18, 0x40, 0x08, 0xbe, 0x0d, 0x02,
+	0x4a, 0x06, 0xfd, 0x95, 0xe6, 0xcd, 0x69, 0x1a, 0x6f, 0xa0, 0x62, 0x08, 0x16, 0x25, 0xbe, 0x50,
+	0x4a, 0x0a, 0xb2, 0xe8, 0xd1, 0xc5, 0x02, 0xe2, 0x67, 0x5d, 0xc4, 0x55, 0xb4, 0x63, 0xea, 0x9a,
+	0xfe, 0xaa, 0xd5, 0x31, 0x4e, 0x9a, 0xbd, 0x76, 0xe7, 0x45, 0xa7, 0xdb, 0xee, 0x75, 0x9b, 0xed,
+	0x96, 0x5e, 0x33, 0xea, 0x86, 0xae, 0xf1, 0x39, 0xb1, 0x3c, 0x9e, 0xc8, 0x5b, 0xb3, 0x60, 0xd7,
+	0xa7, 0x01, 0xd8, 0xee, 0xc0, 0x05, 0x07, 0x1f, 0xa0, 0xcd, 0xf9, 0x1e, 0x2d, 0xbd, 0xa9, 0x19,
+	0xcd, 0x23, 0x9e, 0x13, 0xb7, 0xc6, 0x13, 0xf9, 0x9f, 0x59, 0xbe, 0x05, 0xbe, 0xe3, 0xfa, 0x43,
+	0xfc, 0x1c, 0x6d, 0xcd, 0xb3, 0xf5, 0x6e, 0xa3, 0x6e, 0x34, 0x1a, 0xba, 0xc6, 0xe7, 0xc5, 0x9d,
+	0xf1, 0x44, 0xde, 0x9c, 0xa5, 0xeb, 0x91, 0x37, 0x70, 0x3d, 0x0f, 0x1c, 0x7c, 0x88, 0xc4, 0x79,
+	0xde, 0xd4, 0x5f, 0xea, 0xb5, 0x8e, 0xae, 0xf1, 0x0b, 0xe2, 0xf6, 0x78, 0x22, 0x0b, 0x73, 0x6f,
+	0x07, 0xde, 0x81, 0xcd, 0x7e, 0xa5, 0x5c, 0x7f, 0xdd, 0x32, 0x4c, 0x5d, 0xe3, 0x0b, 0x3f, 0x57,
+	0xae, 0x9f, 0x05, 0x6e, 0x08, 0x0e, 0x7e, 0x8a, 0x36, 0xe6, 0xd9, 0x63, 0xbd, 0xa1, 0xf1, 0x8b,
+	0xa2, 0x30, 0x9e, 0xc8, 0xeb, 0xb3, 0xe0, 0x31, 0x78, 0x8e, 0x58, 0xb8, 0xf8, 0x24, 0xe5, 0xaa,
+	0x27, 0x57, 0x37, 0x12, 0x77, 0x7d, 0x23, 0x71, 0xdf, 0x6e, 0x24, 0xee, 0xf2, 0x56, 0xca, 0x5d,
+	0xdf, 0x4a, 0xb9, 0xcf, 0xb7, 0x52, 0xee, 0xcd, 0xfe, 0xd0, 0x65, 0x6f, 0xa3, 0xbe, 0x62, 0x93,
+	0x91, 0x9a, 0x7c, 0x05, 0x8f, 0x2d, 0x4a, 0x81, 0xd1, 0x34, 0x50, 0x4f, 0xf7, 0xd5, 0x33, 0xf5,
+	0x87, 0xff, 0x0b, 0x3b, 0x0f, 0x80, 0xf6, 0x8b, 0xc9, 0x2b, 0x7a, 0xf2, 0x7d, 0x00, 0xc5, 0x01,
+	0xdb, 0x8f, 0x7c, 0x04, 0x00, 0x00,
+}
+
+func (m *Redemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Redemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.PayoutReference) > 0 {
+		i -= len(m.PayoutReference)
+		copy(dAtA[i:], m.PayoutReference)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.PayoutReference)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ResolveTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ResolveTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ResolveTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintRedemption(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Resolver) > 0 {
+		i -= len(m.Resolver)
+		copy(dAtA[i:], m.Resolver)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.Resolver)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Timeout != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timeout):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintRedemption(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RequestTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RequestTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintRedemption(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	if m.Status != 0 {
+		i = encodeVarintRedemption(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PayoutInstructions) > 0 {
+		i -= len(m.PayoutInstructions)
+		copy(dAtA[i:], m.PayoutInstructions)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.PayoutInstructions)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRedemption(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRedemption(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRedemption(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRedemption(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Redemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRedemption(uint64(m.Id))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRedemption(uint64(l))
+	l = len(m.PayoutInstructions)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovRedemption(uint64(m.Status))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RequestTime)
+	n += 1 + l + sovRedemption(uint64(l))
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timeout)
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	l = len(m.Resolver)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	if m.ResolveTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ResolveTime)
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	l = len(m.PayoutReference)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	return n
+}
+
+func sovRedemption(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRedemption(x uint64) (n int) {
+	return sovRedemption(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Redemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRedemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutInstructions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutInstructions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RedemptionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RequestTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resolver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResolveTime == nil {
+				m.ResolveTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ResolveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRedemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRedemption(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRedemption
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRedemption
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRedemption
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRedemption
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRedemption        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRedemption          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRedemption = fmt.Errorf("proto: unexpected end of group")
+)